@@ -653,7 +653,7 @@ func (m *AISbp) GetObjReader(_ context.Context, lom *core.LOM, offset, length in
 }
 
 // TODO: retry upon 'unreachable' or timeout
-func (m *AISbp) PutObj(_ context.Context, r io.ReadCloser, lom *core.LOM, _ *http.Request) (int, error) {
+func (m *AISbp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, _ *http.Request) (int, error) {
 	remoteBck := lom.Bck().Clone()
 	remAis, err := m.getRemAis(remoteBck.Ns.UUID)
 	if err != nil {
@@ -671,6 +671,9 @@ func (m *AISbp) PutObj(_ context.Context, r io.ReadCloser, lom *core.LOM, _ *htt
 		Reader:     r.(cos.ReadOpenCloser),
 		Size:       uint64(size),
 	}
+	if hdr, ok := ctx.Value(cos.CtxRemAisHeader).(http.Header); ok {
+		args.Header = hdr // e.g., replication (see xs/repl)
+	}
 	oah, errV := api.PutObject(&args)
 	if errV != nil {
 		return m.extractErrCode(errV, remAis.uuid)
@@ -687,7 +690,7 @@ func (m *AISbp) PutObj(_ context.Context, r io.ReadCloser, lom *core.LOM, _ *htt
 	return 0, nil
 }
 
-func (m *AISbp) DeleteObj(_ context.Context, lom *core.LOM) (ecode int, err error) {
+func (m *AISbp) DeleteObj(ctx context.Context, lom *core.LOM) (ecode int, err error) {
 	var (
 		remAis    *remAis
 		remoteBck = lom.Bck().Clone()
@@ -696,6 +699,25 @@ func (m *AISbp) DeleteObj(_ context.Context, lom *core.LOM) (ecode int, err erro
 		return
 	}
 	unsetUUID(&remoteBck)
-	err = api.DeleteObject(remAis.bp, remoteBck, lom.ObjName)
+	if hdr, ok := ctx.Value(cos.CtxRemAisHeader).(http.Header); ok {
+		err = remAisDelete(remAis.bp, remoteBck, lom.ObjName, hdr) // e.g., replication (see xs/repl)
+	} else {
+		err = api.DeleteObject(remAis.bp, remoteBck, lom.ObjName)
+	}
 	return m.extractErrCode(err, remAis.uuid)
 }
+
+// compare with api.DeleteObject (that does not take additional headers)
+func remAisDelete(bp api.BaseParams, bck cmn.Bck, objName string, hdr http.Header) error {
+	bp.Method = http.MethodDelete
+	reqParams := api.AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Query = bck.AddToQuery(nil)
+		reqParams.Header = hdr
+	}
+	err := reqParams.DoRequest()
+	api.FreeRp(reqParams)
+	return err
+}
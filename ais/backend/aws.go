@@ -38,6 +38,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
 	s3manager "github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
@@ -51,14 +52,22 @@ type (
 		base
 	}
 	sessConf struct {
-		bck    *cmn.Bck
-		region string
+		bck      *cmn.Bck
+		region   string
+		endpoint string        // overrides bucket's and global (see GetObjReader and cmn.HedgeConf)
+		dialect  cmn.S3Dialect // resolved (see s3client)
+	}
+	// per-bucket static credentials: named backend secret (see secrets.go)
+	awsSecret struct {
+		AccessKeyID     string `json:"access_key_id"`
+		SecretAccessKey string `json:"secret_access_key"`
+		SessionToken    string `json:"session_token,omitempty"`
 	}
 )
 
 var (
 	// map[string]*s3.Client, with one s3.Client a.k.a. "svc"
-	// per (profile, region, endpoint) triplet
+	// per (profile[|secret], region, endpoint) triplet
 	clients sync.Map
 
 	s3Endpoint string
@@ -89,9 +98,21 @@ func NewAWS(t core.TargetPut, tstats stats.Tracker, startingUp bool) (core.Backe
 	bp.base.init(t.Snode(), tstats, startingUp)
 	// reset clients map
 	clients.Clear()
+	secrets.sub(apc.AWS, awsDropClients)
 	return bp, nil
 }
 
+// (secret changed)
+func awsDropClients(secret string) {
+	s := "|" + secret + "@"
+	clients.Range(func(k, _ any) bool {
+		if strings.Contains(k.(string), s) {
+			clients.Delete(k)
+		}
+		return true
+	})
+}
+
 // as core.Backend --------------------------------------------------------------
 
 //
@@ -123,7 +144,7 @@ func (*s3bp) HeadBucket(_ context.Context, bck *meta.Bck) (cos.StrKVs, int, erro
 		var region string
 		if region, err = _location(svc, cloudBck.Name); err != nil {
 			ecode, errV := awsErrorToAISError(err, cloudBck, "")
-			return nil, ecode, errV
+			return nil, ecode, secretErr(cloudBck, sessConf.secret(), ecode, errV)
 		}
 		if cmn.Rom.V(4, cos.ModBackend) {
 			nlog.Infoln("[svc.head_bucket]", cloudBck.Name, "region", region)
@@ -147,7 +168,7 @@ func (*s3bp) HeadBucket(_ context.Context, bck *meta.Bck) (cos.StrKVs, int, erro
 	versioned, errV := _versioning(svc, cloudBck)
 	if errV != nil {
 		ecode, err := awsErrorToAISError(errV, cloudBck, "")
-		return nil, ecode, err
+		return nil, ecode, secretErr(cloudBck, sessConf.secret(), ecode, err)
 	}
 	bckProps[apc.HdrBucketVerEnabled] = strconv.FormatBool(versioned)
 	return bckProps, 0, nil
@@ -225,7 +246,12 @@ func (*s3bp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (ecode
 	}
 	params.MaxKeys = aws.Int32(int32(msg.PageSize))
 
-	resp, err := svc.ListObjectsV2(context.Background(), params)
+	var resp *s3.ListObjectsV2Output
+	if sessConf.dialect.ListV1 {
+		resp, err = _listV1(svc, params)
+	} else {
+		resp, err = svc.ListObjectsV2(context.Background(), params)
+	}
 	if err != nil {
 		if cmn.Rom.V(4, cos.ModBackend) {
 			nlog.Infoln(tag, cloudBck.Name, err)
@@ -311,6 +337,41 @@ func (*s3bp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (ecode
 	return 0, nil
 }
 
+// ListObjects (V1) for S3-compatible stores that do not support (or poorly support) V2
+// - V1 marker serves as the continuation token
+// - NextMarker is returned only when delimiter is specified; otherwise, it's the last key
+func _listV1(svc *s3.Client, v2 *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error) {
+	params := &s3.ListObjectsInput{
+		Bucket:    v2.Bucket,
+		Delimiter: v2.Delimiter,
+		Prefix:    v2.Prefix,
+		Marker:    v2.ContinuationToken,
+		MaxKeys:   v2.MaxKeys,
+	}
+	resp, err := svc.ListObjects(context.Background(), params)
+	if err != nil {
+		return nil, err
+	}
+	out := &s3.ListObjectsV2Output{
+		Contents:       resp.Contents,
+		CommonPrefixes: resp.CommonPrefixes,
+		IsTruncated:    aws.Bool(aws.ToBool(resp.IsTruncated)),
+	}
+	if *out.IsTruncated {
+		marker := aws.ToString(resp.NextMarker)
+		if marker == "" {
+			if n := len(resp.Contents); n > 0 {
+				marker = aws.ToString(resp.Contents[n-1].Key)
+			}
+			if n := len(resp.CommonPrefixes); n > 0 {
+				marker = max(marker, aws.ToString(resp.CommonPrefixes[n-1].Prefix))
+			}
+		}
+		out.NextContinuationToken = aws.String(marker)
+	}
+	return out, nil
+}
+
 //
 // LIST BUCKETS
 //
@@ -477,6 +538,13 @@ func (*s3bp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int
 			Key:    aws.String(lom.ObjName),
 		}
 	)
+	// equivalent endpoint (hedged request or failover - see ais/rlhedge)
+	if ep, ok := ctx.Value(cos.CtxBackendEndpoint).(*cmn.BackendEndpoint); ok && ep != nil {
+		if ep.Bucket != "" {
+			input.Bucket = aws.String(ep.Bucket)
+		}
+		sessConf.region, sessConf.endpoint = ep.Region, ep.Endpoint
+	}
 	svc, err := sessConf.s3client("[get_obj_reader]")
 	if err != nil {
 		res.Err = err
@@ -590,6 +658,9 @@ func (*s3bp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, oreq *h
 
 	uploader = s3manager.NewUploader(svc)
 
+	if sessConf.dialect.MaxParts > 0 {
+		uploader.MaxUploadParts = sessConf.dialect.MaxParts
+	}
 	switch partSize := int64(lom.Bprops().Extra.AWS.MultiPartSize); partSize {
 	case -1:
 		uploader.PartSize = lom.Lsize() + 1 // forces single-part upload
@@ -598,6 +669,10 @@ func (*s3bp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, oreq *h
 	default:
 		uploader.PartSize = partSize
 	}
+	// (the uploader cannot adjust part size when the reader is not seekable)
+	if n := int64(uploader.MaxUploadParts); lom.Lsize() > uploader.PartSize*n {
+		uploader.PartSize = cos.CeilAlignI64(lom.Lsize(), n) / n
+	}
 
 	uploadOutput, err = uploader.Upload(ctx, &s3.PutObjectInput{
 		Bucket:   aws.String(cloudBck.Name),
@@ -684,8 +759,11 @@ func (sessConf *sessConf) s3client(tag string) (*s3.Client, error) {
 	var (
 		endpoint = s3Endpoint
 		profile  = awsProfile
+		cidp     string
+		creds    *awsSecret
 	)
 	if sessConf.bck != nil && sessConf.bck.Props != nil {
+		sessConf.dialect = sessConf.bck.Props.Extra.AWS.Dialect.Resolve()
 		if sessConf.region == "" {
 			sessConf.region = sessConf.bck.Props.Extra.AWS.CloudRegion
 		}
@@ -696,8 +774,20 @@ func (sessConf *sessConf) s3client(tag string) (*s3.Client, error) {
 			profile = sessConf.bck.Props.Extra.AWS.Profile
 		}
 	}
+	if sessConf.endpoint != "" {
+		endpoint = sessConf.endpoint
+	}
+	cidp = profile
+	if secret := sessConf.secret(); secret != "" {
+		creds = &awsSecret{}
+		gen, err := secrets.unmarshal(secret, creds)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", sessConf.bck.Cname(""), err)
+		}
+		cidp = profile + "|" + secretCid(secret, gen)
+	}
 
-	cid := _cid(profile, sessConf.region, endpoint)
+	cid := _cid(cidp, sessConf.region, endpoint, sessConf.quirks())
 	asvc, loaded := clients.Load(cid)
 	if loaded {
 		svc, ok := asvc.(*s3.Client)
@@ -706,7 +796,7 @@ func (sessConf *sessConf) s3client(tag string) (*s3.Client, error) {
 	}
 
 	// slow path
-	cfg, err := awsLoadConfig(endpoint, profile)
+	cfg, err := awsLoadConfig(endpoint, profile, creds)
 	if err != nil {
 		// normalize s3 error
 		_, errV := awsErrorToAISError(err, sessConf.bck, "")
@@ -742,20 +832,53 @@ func (sessConf *sessConf) options(options *s3.Options) {
 		// 3. SDK uses "AWS_REGION" environment or global default
 		// (note ListBuckets() special case)
 	}
-	if bck := sessConf.bck; bck != nil {
-		if bck.Props != nil {
-			options.UsePathStyle = bck.Props.Features.IsSet(feat.S3UsePathStyle)
-		} else {
-			options.UsePathStyle = cmn.Rom.Features().IsSet(feat.S3UsePathStyle)
-		}
+	if sessConf.bck != nil {
+		options.UsePathStyle = sessConf.pathStyle()
+	}
+	if sessConf.dialect.Checksum == cmn.S3ChecksumWhenRequired {
+		options.RequestChecksumCalculation = aws.RequestChecksumCalculationWhenRequired
+		options.ResponseChecksumValidation = aws.ResponseChecksumValidationWhenRequired
 	}
 	options.DisableLogOutputChecksumValidationSkipped = true
 }
 
-func _cid(profile, region, endpoint string) string {
+func (sessConf *sessConf) secret() string {
+	if bck := sessConf.bck; bck != nil && bck.Props != nil {
+		return bck.Props.Extra.AWS.Secret
+	}
+	return ""
+}
+
+// dialect addressing, if specified, takes precedence over the feature flag
+func (sessConf *sessConf) pathStyle() bool {
+	switch sessConf.dialect.Addressing {
+	case cmn.S3AddrPath:
+		return true
+	case cmn.S3AddrVirtual:
+		return false
+	}
+	if bck := sessConf.bck; bck != nil && bck.Props != nil {
+		return bck.Props.Features.IsSet(feat.S3UsePathStyle)
+	}
+	return cmn.Rom.Features().IsSet(feat.S3UsePathStyle)
+}
+
+// client options that differ between buckets sharing (profile, region, endpoint)
+func (sessConf *sessConf) quirks() string {
+	var q string
+	if sessConf.bck != nil && sessConf.pathStyle() {
+		q = "p"
+	}
+	if sessConf.dialect.Checksum == cmn.S3ChecksumWhenRequired {
+		q += "r"
+	}
+	return q
+}
+
+func _cid(profile, region, endpoint, quirks string) string {
 	var (
 		sb cos.SB
-		l  = len(profile) + 1 + len(region) + 1 + len(endpoint)
+		l  = len(profile) + 1 + len(region) + 1 + len(endpoint) + 1 + len(quirks)
 	)
 	sb.Init(l)
 	if profile != "" {
@@ -769,11 +892,16 @@ func _cid(profile, region, endpoint string) string {
 	if endpoint != "" {
 		sb.WriteString(endpoint)
 	}
+	if quirks != "" {
+		sb.WriteUint8('#')
+		sb.WriteString(quirks)
+	}
 	return sb.String()
 }
 
-// awsLoadConfig create config using default creds from ~/.aws/credentials and environment variables.
-func awsLoadConfig(endpoint, profile string) (aws.Config, error) {
+// awsLoadConfig create config using default creds from ~/.aws/credentials and environment variables,
+// or static per-bucket creds, if provided.
+func awsLoadConfig(endpoint, profile string, creds *awsSecret) (aws.Config, error) {
 	// Disable SDK rate limiting to rely on configured backend.rate_limit
 	retryConfig := retry.NewStandard(func(o *retry.StandardOptions) {
 		o.RateLimiter = ratelimit.None
@@ -781,8 +909,7 @@ func awsLoadConfig(endpoint, profile string) (aws.Config, error) {
 	confFiles, credFiles := getS3ConfFiles()
 	nlog.Infoln("Loading config for profile:", profile, "config files:", confFiles, "credential files:", credFiles)
 	// NOTE: The AWS SDK for Go v2, uses lower case header maps by default.
-	cfg, err := config.LoadDefaultConfig(
-		context.Background(),
+	opts := []func(*config.LoadOptions) error{
 		config.WithHTTPClient(tracing.NewTraceableClient(cmn.NewClient(cmn.TransportArgs{}))),
 		config.WithSharedConfigFiles(confFiles),
 		config.WithSharedCredentialsFiles(credFiles),
@@ -790,7 +917,12 @@ func awsLoadConfig(endpoint, profile string) (aws.Config, error) {
 		config.WithRetryer(func() aws.Retryer {
 			return retryConfig
 		}),
-	)
+	}
+	if creds != nil {
+		p := credentials.NewStaticCredentialsProvider(creds.AccessKeyID, creds.SecretAccessKey, creds.SessionToken)
+		opts = append(opts, config.WithCredentialsProvider(p))
+	}
+	cfg, err := config.LoadDefaultConfig(context.Background(), opts...)
 	if err != nil {
 		return cfg, err
 	}
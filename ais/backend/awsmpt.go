@@ -9,8 +9,12 @@ package backend
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 
 	aiss3 "github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
@@ -88,6 +92,11 @@ func (*s3bp) PutMptPart(lom *core.LOM, r cos.ReadOpenCloser, oreq *http.Request,
 	if errN != nil {
 		return "", http.StatusInternalServerError, errN
 	}
+	dialect := s3dialect(cloudBck)
+	if partNum > dialect.MaxParts {
+		err := fmt.Errorf("%s: part number %d exceeds the maximum (%d) for %s", lom, partNum, dialect.MaxParts, cloudBck.Cname(""))
+		return "", http.StatusBadRequest, err
+	}
 
 	input := s3.UploadPartInput{
 		Bucket:        aws.String(cloudBck.Name),
@@ -117,10 +126,34 @@ func (*s3bp) PutMptPart(lom *core.LOM, r cos.ReadOpenCloser, oreq *http.Request,
 		ecode, errV := awsErrorToAISError(err, cloudBck, lom.ObjName)
 		return "", ecode, errV
 	}
-	etag, _ := h.EncodeETag(out.ETag)
+	etag, ok := h.EncodeETag(out.ETag)
+	if !ok {
+		switch dialect.MissingETag {
+		case cmn.S3MissingETagError:
+			return "", http.StatusBadGateway, fmt.Errorf("%s: missing ETag in the response to upload part %d", lom, partNum)
+		case cmn.S3MissingETagCompute:
+			if etag, err = _partMD5(r); err != nil {
+				return "", 0, fmt.Errorf("%s: failed to compute ETag of part %d: %w", lom, partNum, err)
+			}
+		}
+	}
 	return etag, 0, nil
 }
 
+// MD5 of the part (Amazon S3 ETag semantics), reading it one more time
+func _partMD5(r cos.ReadOpenCloser) (string, error) {
+	rc, err := r.Open()
+	if err != nil {
+		return "", err
+	}
+	_, cksum, err := cos.ChecksumReader(rc, cos.ChecksumMD5)
+	cos.Close(rc)
+	if err != nil {
+		return "", err
+	}
+	return cksum.Value(), nil
+}
+
 func (*s3bp) CompleteMpt(lom *core.LOM, oreq *http.Request, uploadID string, obody []byte, parts apc.MptCompletedParts) (version, etag string, _ int, _ error) {
 	h := cmn.BackendHelpers.Amazon
 
@@ -176,10 +209,31 @@ func (*s3bp) CompleteMpt(lom *core.LOM, oreq *http.Request, uploadID string, obo
 	}
 
 	version, _ = h.EncodeVersion(out.VersionId)
-	etag, _ = h.EncodeETag(out.ETag)
+	etag, ok := h.EncodeETag(out.ETag)
+	if !ok {
+		switch s3dialect(cloudBck).MissingETag {
+		case cmn.S3MissingETagError:
+			return "", "", http.StatusBadGateway, fmt.Errorf("%s: missing ETag in the response to complete upload %q", lom, uploadID)
+		case cmn.S3MissingETagCompute:
+			etag = _mptETag(parts)
+		}
+	}
 	return version, etag, 0, nil
 }
 
+// MD5 of the parts' MD5s followed by "-<number-of-parts>"; empty if any part's ETag is not MD5
+func _mptETag(parts apc.MptCompletedParts) string {
+	h := md5.New()
+	for _, part := range parts {
+		b, err := hex.DecodeString(cmn.UnquoteCEV(part.ETag))
+		if err != nil || len(b) != md5.Size {
+			return ""
+		}
+		h.Write(b)
+	}
+	return hex.EncodeToString(h.Sum(nil)) + "-" + strconv.Itoa(len(parts))
+}
+
 func (*s3bp) AbortMpt(lom *core.LOM, oreq *http.Request, uploadID string) (ecode int, err error) {
 	if lom.IsFeatureSet(feat.S3PresignedRequest) && oreq != nil {
 		pts := aiss3.NewPresignedReq(oreq, lom, oreq.Body, oreq.URL.Query())
@@ -208,6 +262,13 @@ func (*s3bp) AbortMpt(lom *core.LOM, oreq *http.Request, uploadID string) (ecode
 	return ecode, err
 }
 
+func s3dialect(bck *cmn.Bck) cmn.S3Dialect {
+	if bck.Props == nil {
+		return (&cmn.S3Dialect{}).Resolve()
+	}
+	return bck.Props.Extra.AWS.Dialect.Resolve()
+}
+
 func getS3Svc(bck *cmn.Bck, tag string) (*s3.Client, error) {
 	sc := sessConf{bck: bck}
 	svc, err := sc.s3client(tag)
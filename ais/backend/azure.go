@@ -18,11 +18,13 @@ package backend
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
@@ -65,6 +67,20 @@ type (
 		u     string
 		base
 	}
+	// per-bucket storage account: named backend secret (see secrets.go)
+	azSecret struct {
+		AccountName string `json:"account_name"`
+		AccountKey  string `json:"account_key"`
+		Endpoint    string `json:"endpoint,omitempty"` // default: <proto><account_name>.blob.core.windows.net
+	}
+	azAccount struct {
+		creds *azblob.SharedKeyCredential
+		u     string
+	}
+)
+
+var (
+	azAccounts sync.Map // [secret@gen => *azAccount]
 )
 
 // parse azure errors
@@ -102,7 +118,7 @@ func NewAzure(t core.TargetPut, tstats stats.Tracker, startingUp bool) (core.Bac
 	blurl := asEndpoint()
 
 	// NOTE: NewSharedKeyCredential requires account name and its primary or secondary key
-	creds, err := azblob.NewSharedKeyCredential(azAccName(), azAccKey())
+	creds, err := azNewCreds(azAccName(), azAccKey())
 	if err != nil {
 		return nil, cmn.NewErrFailedTo(nil, azErrPrefix+": init]", "credentials", err)
 	}
@@ -115,9 +131,53 @@ func NewAzure(t core.TargetPut, tstats stats.Tracker, startingUp bool) (core.Bac
 	// register metrics
 	bp.base.init(t.Snode(), tstats, startingUp)
 
+	azAccounts.Clear()
+	secrets.sub(apc.Azure, azDropAccounts)
 	return bp, nil
 }
 
+// returns endpoint and credentials: per-bucket (extra.azure.secret) or global (environment)
+func (azbp *azbp) account(bck *cmn.Bck) (string, *azblob.SharedKeyCredential, error) {
+	if bck == nil || bck.Props == nil || bck.Props.Extra.Azure.Secret == "" {
+		return azbp.u, azbp.creds, nil
+	}
+	var (
+		secret = bck.Props.Extra.Azure.Secret
+		azs    azSecret
+	)
+	gen, err := secrets.unmarshal(secret, &azs)
+	if err != nil {
+		return "", nil, fmt.Errorf("%s: %w", bck.Cname(""), err)
+	}
+	cid := secretCid(secret, gen)
+	if v, ok := azAccounts.Load(cid); ok {
+		acc := v.(*azAccount)
+		return acc.u, acc.creds, nil
+	}
+	creds, err := azNewCreds(azs.AccountName, azs.AccountKey)
+	if err != nil {
+		return "", nil, fmt.Errorf("%s: invalid backend secret %q: %v", bck.Cname(""), secret, err)
+	}
+	acc := &azAccount{creds: creds, u: strings.TrimSuffix(azs.Endpoint, "/")}
+	if acc.u == "" {
+		acc.u = azProto() + azs.AccountName + azHost
+	}
+	azAccounts.Store(cid, acc)
+	return acc.u, acc.creds, nil
+}
+
+// (secret changed)
+func azDropAccounts(secret string) {
+	prefix := secret + "@"
+	azAccounts.Range(func(k, v any) bool {
+		if strings.HasPrefix(k.(string), prefix) {
+			azAccounts.Delete(k)
+			azKeys.Delete(v.(*azAccount).creds)
+		}
+		return true
+	})
+}
+
 //
 // format and parse errors
 //
@@ -211,11 +271,12 @@ func _azureErr(azureError error, stgErr *azcore.ResponseError) (int, error) {
 //
 
 func (azbp *azbp) HeadBucket(ctx context.Context, bck *meta.Bck) (cos.StrKVs, int, error) {
-	var (
-		cloudBck = bck.RemoteBck()
-		cntURL   = azbp.u + "/" + cloudBck.Name
-	)
-	client, err := container.NewClientWithSharedKeyCredential(cntURL, azbp.creds, nil)
+	cloudBck := bck.RemoteBck()
+	u, creds, err := azbp.account(cloudBck)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	client, err := container.NewClientWithSharedKeyCredential(u+"/"+cloudBck.Name, creds, nil)
 	if err != nil {
 		status, err := azureErrorToAISError(err, cloudBck, "")
 		return nil, status, err
@@ -223,11 +284,17 @@ func (azbp *azbp) HeadBucket(ctx context.Context, bck *meta.Bck) (cos.StrKVs, in
 	resp, err := client.GetProperties(ctx, nil)
 	if err != nil {
 		status, err := azureErrorToAISError(err, cloudBck, "")
+		if cloudBck.Props != nil {
+			err = secretErr(cloudBck, cloudBck.Props.Extra.Azure.Secret, status, err)
+		}
 		return nil, status, err
 	}
 
-	bckProps := make(cos.StrKVs, 2)
+	bckProps := make(cos.StrKVs, 3)
 	bckProps[apc.HdrBackendProvider] = apc.Azure
+	if azIsHNS(ctx, u, creds) {
+		bckProps[apc.HdrAzureHNS] = "true"
+	}
 
 	// TODO #200224
 	if true || resp.IsImmutableStorageWithVersioningEnabled != nil && *resp.IsImmutableStorageWithVersioningEnabled {
@@ -242,20 +309,27 @@ func (azbp *azbp) HeadBucket(ctx context.Context, bck *meta.Bck) (cos.StrKVs, in
 // LIST OBJECTS
 //
 
-// TODO: support non-recursive (apc.LsNoRecursion) operation, as in:
-// $ az storage blob list -c abc --prefix sub/ --delimiter /
-// TODO: research "hierarchical namespaces"
+// in re: `apc.LsNoDirs` and `apc.LsNoRecursion`, see:
+// https://github.com/NVIDIA/aistore/blob/main/docs/howto_virt_dirs.md
+//
+// with hierarchical namespace (see azurehns.go), directories are blobs as well (zero-size, "hdi_isfolder")
 // See also: aws.go, gcp.go
 func (azbp *azbp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (int, error) {
 	msg.PageSize = calcPageSize(msg.PageSize, bck.MaxPageSize())
 	var (
 		h        = cmn.BackendHelpers.Azure
 		cloudBck = bck.RemoteBck()
-		cntURL   = azbp.u + "/" + cloudBck.Name
 		num      = int32(msg.PageSize)
-		opts     = container.ListBlobsFlatOptions{Prefix: apc.Ptr(msg.Prefix), MaxResults: &num}
+		ctx      = context.Background()
+		marker   *string
+		blobs    []*container.BlobItem
+		prefixes []*container.BlobPrefix
 	)
-	client, err := container.NewClientWithSharedKeyCredential(cntURL, azbp.creds, nil)
+	u, creds, err := azbp.account(cloudBck)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	client, err := container.NewClientWithSharedKeyCredential(u+"/"+cloudBck.Name, creds, nil)
 	if err != nil {
 		return azureErrorToAISError(err, cloudBck, "")
 	}
@@ -263,13 +337,32 @@ func (azbp *azbp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (
 		nlog.Infof("list_objects %s", cloudBck.Name)
 	}
 	if msg.ContinuationToken != "" {
-		opts.Marker = apc.Ptr(msg.ContinuationToken)
+		marker = apc.Ptr(msg.ContinuationToken)
 	}
+	hns := azIsHNS(ctx, u, creds)
 
-	pager := client.NewListBlobsFlatPager(&opts)
-	resp, err := pager.NextPage(context.Background())
-	if err != nil {
-		return azureErrorToAISError(err, cloudBck, "")
+	if msg.IsFlagSet(apc.LsNoRecursion) {
+		opts := container.ListBlobsHierarchyOptions{Prefix: apc.Ptr(msg.Prefix), MaxResults: &num, Marker: marker}
+		if hns {
+			opts.Include = container.ListBlobsInclude{Metadata: true}
+		}
+		pager := client.NewListBlobsHierarchyPager("/", &opts)
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return azureErrorToAISError(err, cloudBck, "")
+		}
+		blobs, prefixes, marker = resp.Segment.BlobItems, resp.Segment.BlobPrefixes, resp.NextMarker
+	} else {
+		opts := container.ListBlobsFlatOptions{Prefix: apc.Ptr(msg.Prefix), MaxResults: &num, Marker: marker}
+		if hns {
+			opts.Include = container.ListBlobsInclude{Metadata: true}
+		}
+		pager := client.NewListBlobsFlatPager(&opts)
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return azureErrorToAISError(err, cloudBck, "")
+		}
+		blobs, marker = resp.Segment.BlobItems, resp.NextMarker
 	}
 
 	var (
@@ -280,7 +373,14 @@ func (azbp *azbp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (
 		custom = make([]string, 0, 8)
 	}
 	lst.Entries = lst.Entries[:0]
-	for _, blob := range resp.Segment.BlobItems {
+	for _, blob := range blobs {
+		if hns && azIsFolder(blob) {
+			// real directory; when non-recursive, may also come with its own prefix (below)
+			if !msg.IsFlagSet(apc.LsNoDirs) {
+				lst.Entries = append(lst.Entries, &cmn.LsoEnt{Name: *blob.Name + "/", Flags: apc.EntryIsDir})
+			}
+			continue
+		}
 		en := cmn.LsoEnt{Name: *blob.Name, Size: *blob.Properties.ContentLength}
 
 		// not expecting directories
@@ -311,8 +411,26 @@ func (azbp *azbp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (
 		lst.Entries = append(lst.Entries, &en)
 	}
 
-	if resp.NextMarker != nil {
-		lst.ContinuationToken = *resp.NextMarker
+	// append virtual (or, with HNS, real) directories unless '--no-dirs'
+	if !msg.IsFlagSet(apc.LsNoDirs) {
+		var dirs cos.StrSet
+		if hns {
+			dirs = make(cos.StrSet, len(lst.Entries))
+			for _, en := range lst.Entries {
+				if en.IsAnyFlagSet(apc.EntryIsDir) {
+					dirs.Add(en.Name)
+				}
+			}
+		}
+		for _, p := range prefixes {
+			if p.Name != nil && !dirs.Contains(*p.Name) {
+				lst.Entries = append(lst.Entries, &cmn.LsoEnt{Name: *p.Name, Flags: apc.EntryIsDir})
+			}
+		}
+	}
+
+	if marker != nil {
+		lst.ContinuationToken = *marker
 	}
 	if cmn.Rom.V(4, cos.ModBackend) {
 		nlog.Infof("[list_objects] count %d(marker: %s)", len(lst.Entries), lst.ContinuationToken)
@@ -358,9 +476,12 @@ func (azbp *azbp) HeadObj(ctx context.Context, lom *core.LOM, _ *http.Request) (
 	var (
 		h        = cmn.BackendHelpers.Azure
 		cloudBck = lom.Bucket().RemoteBck()
-		blURL    = azbp.u + "/" + cloudBck.Name + "/" + lom.ObjName
 	)
-	client, err := blockblob.NewClientWithSharedKeyCredential(blURL, azbp.creds, nil)
+	u, creds, err := azbp.account(cloudBck)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	client, err := blockblob.NewClientWithSharedKeyCredential(u+"/"+cloudBck.Name+"/"+lom.ObjName, creds, nil)
 	if err != nil {
 		status, err := azureErrorToAISError(err, cloudBck, lom.ObjName)
 		return nil, status, err
@@ -423,9 +544,13 @@ func (azbp *azbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, lengt
 	var (
 		h        = cmn.BackendHelpers.Azure
 		cloudBck = lom.Bucket().RemoteBck()
-		blURL    = azbp.u + "/" + cloudBck.Name + "/" + lom.ObjName
 	)
-	client, err := blockblob.NewClientWithSharedKeyCredential(blURL, azbp.creds, nil)
+	u, creds, err := azbp.account(cloudBck)
+	if err != nil {
+		res.ErrCode, res.Err = http.StatusInternalServerError, err
+		return res
+	}
+	client, err := blockblob.NewClientWithSharedKeyCredential(u+"/"+cloudBck.Name+"/"+lom.ObjName, creds, nil)
 	if err != nil {
 		res.ErrCode, res.Err = azureErrorToAISError(err, cloudBck, lom.ObjName)
 		return res
@@ -479,11 +604,15 @@ func (azbp *azbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, lengt
 func (azbp *azbp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, _ *http.Request) (int, error) {
 	defer cos.Close(r)
 
-	client, err := azblob.NewClientWithSharedKeyCredential(azbp.u, azbp.creds, nil)
+	cloudBck := lom.Bck().RemoteBck()
+	u, creds, err := azbp.account(cloudBck)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	client, err := azblob.NewClientWithSharedKeyCredential(u, creds, nil)
 	if err != nil {
 		return azureErrorToAISError(err, &cmn.Bck{Provider: apc.Azure}, "")
 	}
-	cloudBck := lom.Bck().RemoteBck()
 
 	opts := azblob.UploadStreamOptions{}
 	if size := lom.Lsize(true); size > cos.MiB {
@@ -516,11 +645,15 @@ func (azbp *azbp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, _
 //
 
 func (azbp *azbp) DeleteObj(ctx context.Context, lom *core.LOM) (int, error) {
-	client, err := azblob.NewClientWithSharedKeyCredential(azbp.u, azbp.creds, nil)
+	cloudBck := lom.Bck().RemoteBck()
+	u, creds, err := azbp.account(cloudBck)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	client, err := azblob.NewClientWithSharedKeyCredential(u, creds, nil)
 	if err != nil {
 		return azureErrorToAISError(err, &cmn.Bck{Provider: apc.Azure}, "")
 	}
-	cloudBck := lom.Bck().RemoteBck()
 
 	_, err = client.DeleteBlob(ctx, cloudBck.Name, lom.ObjName, nil)
 	if err != nil {
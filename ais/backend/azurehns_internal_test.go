@@ -0,0 +1,55 @@
+//go:build azure
+
+// Package backend contains core/backend interface implementations for supported backend providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestAzureDfsURL(t *testing.T) {
+	dfs, ok := azDfsURL("https://acc.blob.core.windows.net")
+	tassert.Errorf(t, ok && dfs == "https://acc.dfs.core.windows.net", "got %q", dfs)
+	dfs, ok = azDfsURL("https://acc.privatelink.blob.core.windows.net")
+	tassert.Errorf(t, ok && dfs == "https://acc.privatelink.dfs.core.windows.net", "got %q", dfs)
+	_, ok = azDfsURL("http://127.0.0.1:10000/devstoreaccount1")
+	tassert.Errorf(t, !ok, "emulator: not expecting DFS endpoint")
+
+	name := azEscapePath("dir/a b/c?d")
+	tassert.Errorf(t, name == "dir/a%20b/c%3Fd", "got %q", name)
+}
+
+func TestAzureStringToSign(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPut, "https://acc.dfs.core.windows.net/fs/a%20b/c?action=setAccessControl", http.NoBody)
+	tassert.CheckFatal(t, err)
+	req.Header.Set("x-ms-version", azRestVersion)
+	req.Header.Set("x-ms-date", "Thu, 01 Jan 2026 00:00:00 GMT")
+	req.Header.Set("x-ms-acl", "user::rwx,group::r-x,other::---")
+
+	expected := "PUT\n\n\n\n\n\n\n\n\n\n\n\n" +
+		"x-ms-acl:user::rwx,group::r-x,other::---\n" +
+		"x-ms-date:Thu, 01 Jan 2026 00:00:00 GMT\n" +
+		"x-ms-version:" + azRestVersion + "\n" +
+		"/acc/fs/a%20b/c\n" +
+		"action:setAccessControl"
+	s := azStringToSign(req, "acc")
+	tassert.Errorf(t, s == expected, "expecting:\n%q\ngot:\n%q", expected, s)
+}
+
+func TestAzureSign(t *testing.T) {
+	creds, err := azNewCreds("acc", "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=")
+	tassert.CheckFatal(t, err)
+	req, err := http.NewRequest(http.MethodGet, "https://acc.dfs.core.windows.net/fs", http.NoBody)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, azSign(req, creds))
+
+	expected := "SharedKey acc:4HuUkdbVFnh8i4NIoXoSmasHvDPcpF3Te5qdYFnlRjE="
+	auth := req.Header.Get("Authorization")
+	tassert.Errorf(t, auth == expected, "expecting %q, got %q", expected, auth)
+}
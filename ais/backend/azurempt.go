@@ -54,12 +54,13 @@ func (*azbp) StartMpt(lom *core.LOM, _ *http.Request) (id string, ecode int, err
 }
 
 func (azbp *azbp) PutMptPart(lom *core.LOM, r cos.ReadOpenCloser, _ *http.Request, uploadID string, _ int64, partNum int32) (string, int, error) {
-	var (
-		cloudBck = lom.Bck().RemoteBck()
-		blURL    = azbp.u + "/" + cloudBck.Name + "/" + lom.ObjName
-	)
-
-	client, err := blockblob.NewClientWithSharedKeyCredential(blURL, azbp.creds, nil)
+	cloudBck := lom.Bck().RemoteBck()
+	u, creds, err := azbp.account(cloudBck)
+	if err != nil {
+		cos.Close(r)
+		return "", http.StatusInternalServerError, err
+	}
+	client, err := blockblob.NewClientWithSharedKeyCredential(u+"/"+cloudBck.Name+"/"+lom.ObjName, creds, nil)
 	if err != nil {
 		cos.Close(r)
 		ecode, err := azureErrorToAISError(err, cloudBck, lom.ObjName)
@@ -88,12 +89,12 @@ func (azbp *azbp) PutMptPart(lom *core.LOM, r cos.ReadOpenCloser, _ *http.Reques
 }
 
 func (azbp *azbp) CompleteMpt(lom *core.LOM, _ *http.Request, uploadID string, _ []byte, parts apc.MptCompletedParts) (version, etag string, _ int, _ error) {
-	var (
-		cloudBck = lom.Bck().RemoteBck()
-		blURL    = azbp.u + "/" + cloudBck.Name + "/" + lom.ObjName
-	)
-
-	client, err := blockblob.NewClientWithSharedKeyCredential(blURL, azbp.creds, nil)
+	cloudBck := lom.Bck().RemoteBck()
+	u, creds, err := azbp.account(cloudBck)
+	if err != nil {
+		return "", "", http.StatusInternalServerError, err
+	}
+	client, err := blockblob.NewClientWithSharedKeyCredential(u+"/"+cloudBck.Name+"/"+lom.ObjName, creds, nil)
 	if err != nil {
 		ecode, err := azureErrorToAISError(err, cloudBck, lom.ObjName)
 		return "", "", ecode, err
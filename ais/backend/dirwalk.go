@@ -0,0 +1,161 @@
+// Package backend contains core/backend interface implementations for supported backend providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"errors"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// Paginated list-objects over a hierarchical namespace (file://, hdfs://, ht://).
+// Walks the bucket's directories in the order of their (sorted) entries, depth-first.
+// The continuation token is the last listed name; resuming skips all preceding subtrees
+// (see dirCmp for the walk order, which is not necessarily lexicographical).
+
+type (
+	dirEnt struct {
+		mtime   time.Time
+		name    string // base name
+		version string
+		size    int64
+		isDir   bool
+		hasMD   bool // size, mtime, and version are filled-in
+	}
+	dirWalk struct {
+		// sorted directory entries; (nil, nil) when the directory does not exist
+		readDir func(dir string) ([]dirEnt, error)
+		// optional: fill-in size, mtime, and version (`hasMD` == false); false when no longer exists
+		loadMD func(name string, de *dirEnt) bool
+		msg    *apc.LsoMsg
+		lst    *cmn.LsoRes
+		token  string
+		limit  int
+	}
+)
+
+var errPageFull = errors.New("page full")
+
+// (the caller must have computed msg.PageSize)
+func (w *dirWalk) do() error {
+	w.lst.Entries = w.lst.Entries[:0]
+	w.lst.ContinuationToken = ""
+	w.token, w.limit = w.msg.ContinuationToken, int(w.msg.PageSize)
+
+	// start from the prefix's (deepest) directory
+	var dir string
+	if i := strings.LastIndexByte(w.msg.Prefix, '/'); i > 0 {
+		dir = w.msg.Prefix[:i]
+	}
+	switch err := w.walk(dir); err {
+	case nil:
+		return nil
+	case errPageFull:
+		w.lst.ContinuationToken = w.lst.Entries[len(w.lst.Entries)-1].Name
+		return nil
+	default:
+		return err
+	}
+}
+
+func (w *dirWalk) walk(dir string) error {
+	dents, err := w.readDir(dir)
+	if err != nil {
+		return err
+	}
+	var (
+		prefix      = w.msg.Prefix
+		noRecursion = w.msg.IsFlagSet(apc.LsNoRecursion)
+	)
+	for i := range dents {
+		de := &dents[i]
+		name := path.Join(dir, de.name)
+		if de.isDir {
+			dname := name + "/"
+			if prefix != "" && !cmn.DirHasOrIsPrefix(dname, prefix) {
+				continue
+			}
+			if noRecursion {
+				if w.msg.IsFlagSet(apc.LsNoDirs) || !strings.HasPrefix(dname, prefix) || !w.after(dname) {
+					continue
+				}
+				if err := w.add(&cmn.LsoEnt{Name: dname, Flags: apc.EntryIsDir}); err != nil {
+					return err
+				}
+				continue
+			}
+			// skip the entire subtree that precedes the token
+			if w.token != "" && dirCmp(dname, w.token) < 0 && !strings.HasPrefix(w.token, dname) {
+				continue
+			}
+			if err := w.walk(name); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !strings.HasPrefix(name, prefix) || !w.after(name) {
+			continue
+		}
+		en := &cmn.LsoEnt{Name: name}
+		if !w.msg.IsFlagSet(apc.LsNameOnly) {
+			if !de.hasMD && !w.loadMD(name, de) {
+				continue // removed in the meantime
+			}
+			en.Size = de.size
+			if !w.msg.IsFlagSet(apc.LsNameSize) {
+				en.Version = de.version
+				if w.msg.WantProp(apc.GetPropsCustom) {
+					en.Custom = cmn.CustomProps2S(cmn.LsoLastModified, fmtLsoTime(de.mtime))
+				}
+			}
+		}
+		if err := w.add(en); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *dirWalk) after(name string) bool { return w.token == "" || dirCmp(name, w.token) > 0 }
+
+func (w *dirWalk) add(en *cmn.LsoEnt) error {
+	w.lst.Entries = append(w.lst.Entries, en)
+	if len(w.lst.Entries) >= w.limit {
+		return errPageFull
+	}
+	return nil
+}
+
+// compare names in the walk order: path element by element, with a parent directory
+// preceding its content (e.g., "a/b" < "a-b" even though '/' > '-')
+func dirCmp(a, b string) int {
+	for {
+		ia, ib := strings.IndexByte(a, '/'), strings.IndexByte(b, '/')
+		ea, eb := a, b
+		if ia >= 0 {
+			ea = a[:ia]
+		}
+		if ib >= 0 {
+			eb = b[:ib]
+		}
+		if c := strings.Compare(ea, eb); c != 0 {
+			return c
+		}
+		switch {
+		case ia < 0 && ib < 0:
+			return 0
+		case ia < 0:
+			return -1
+		case ib < 0:
+			return 1
+		}
+		a, b = a[ia+1:], b[ib+1:]
+	}
+}
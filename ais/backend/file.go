@@ -0,0 +1,448 @@
+//go:build file
+
+// Package backend contains core/backend interface implementations for supported backend providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// file:// backend: remote buckets on a shared (e.g., NFS) POSIX filesystem
+// - configured via `backend.file.root` (see cmn.BackendConfFile)
+// - bucket => top-level directory under the root; object => regular file under the bucket's directory
+// - all targets must mount the same filesystem at the same root
+// - version: file modification time (nanoseconds); changes out-of-band are detected by warm GET (validate_warm_get)
+// - temporary files (fsTmpPrefix) are hidden from listings; in-progress multipart uploads are stored outside buckets (fsMptDir)
+
+const (
+	fsTmpPrefix = ".ais.tmp."
+	fsMptDir    = ".ais.mpt" // under the root: <root>/.ais.mpt/<upload-id>/<part-number>
+)
+
+type (
+	fsbp struct {
+		t       core.TargetPut
+		mptOnce sync.Once // (see housekeepMpt)
+		base
+	}
+)
+
+// interface guard
+var _ core.Backend = (*fsbp)(nil)
+
+func NewFile(t core.TargetPut, config *cmn.Config, tstats stats.Tracker, startingUp bool) (core.Backend, error) {
+	bp := &fsbp{
+		t:    t,
+		base: base{provider: apc.File},
+	}
+	if conf := config.Backend.Get(apc.File); conf != nil {
+		root, err := fsRoot(conf)
+		if err != nil {
+			return nil, err
+		}
+		finfo, err := os.Stat(root)
+		if err != nil {
+			return nil, err
+		}
+		if !finfo.IsDir() {
+			return nil, fmt.Errorf("file backend: root %q is not a directory", root)
+		}
+	}
+	bp.init(t.Snode(), tstats, startingUp)
+	return bp, nil
+}
+
+func fsRoot(conf any) (string, error) {
+	if c, ok := conf.(cmn.BackendConfFile); ok {
+		return c.Root, nil
+	}
+	var c cmn.BackendConfFile
+	if err := cos.MorphMarshal(conf, &c); err != nil {
+		return "", err
+	}
+	return c.Root, nil
+}
+
+// current root (the configuration may change at runtime)
+func (*fsbp) root() (string, error) {
+	conf := cmn.GCO.Get().Backend.Get(apc.File)
+	if conf == nil {
+		return "", &cmn.ErrMissingBackend{Provider: apc.File}
+	}
+	return fsRoot(conf)
+}
+
+func (fsbp *fsbp) bckDir(bck *cmn.Bck) (string, error) {
+	root, err := fsbp.root()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(root, bck.Name), nil
+}
+
+func (fsbp *fsbp) objPath(lom *core.LOM) (bdir, fpath string, err error) {
+	if bdir, err = fsbp.bckDir(lom.Bck().RemoteBck()); err != nil {
+		return "", "", err
+	}
+	fpath = filepath.Join(bdir, lom.ObjName)
+	if !strings.HasPrefix(fpath, bdir+string(filepath.Separator)) {
+		return "", "", fmt.Errorf("file backend: invalid object name %q", lom.ObjName)
+	}
+	return bdir, fpath, nil
+}
+
+// version is the file's mtime in nanoseconds
+func fsVersion(finfo os.FileInfo) string { return strconv.FormatInt(finfo.ModTime().UnixNano(), 10) }
+
+func fsErr(err error, what string) (int, error) {
+	switch {
+	case os.IsNotExist(err):
+		return http.StatusNotFound, cos.NewErrNotFound(nil, what)
+	case os.IsPermission(err):
+		return http.StatusForbidden, err
+	default:
+		return http.StatusInternalServerError, err
+	}
+}
+
+func (fsbp *fsbp) HeadBucket(_ context.Context, bck *meta.Bck) (cos.StrKVs, int, error) {
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infof("head_bucket %s", bck.Name)
+	}
+	cloudBck := bck.RemoteBck()
+	bdir, err := fsbp.bckDir(cloudBck)
+	if err != nil {
+		return nil, 0, err
+	}
+	finfo, err := os.Stat(bdir)
+	if err != nil || !finfo.IsDir() {
+		if err == nil || os.IsNotExist(err) {
+			return nil, http.StatusNotFound, cmn.NewErrRemBckNotFound(cloudBck)
+		}
+		ecode, err := fsErr(err, cloudBck.Cname(""))
+		return nil, ecode, err
+	}
+	bckProps := make(cos.StrKVs, 2)
+	bckProps[apc.HdrBackendProvider] = apc.File
+	// mtime-based versions are always available
+	bckProps[apc.HdrBucketVerEnabled] = "true"
+	return bckProps, 0, nil
+}
+
+// create bucket's directory
+func (fsbp *fsbp) CreateBucket(bck *meta.Bck) (int, error) {
+	bdir, err := fsbp.bckDir(bck.RemoteBck())
+	if err != nil {
+		return 0, err
+	}
+	if err := os.Mkdir(bdir, cos.PermRWXRX); err != nil && !os.IsExist(err) {
+		return fsErr(err, bck.Cname(""))
+	}
+	return 0, nil
+}
+
+//
+// LIST OBJECTS
+//
+
+// (see dirWalk)
+func (fsbp *fsbp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (int, error) {
+	cloudBck := bck.RemoteBck()
+	bdir, err := fsbp.bckDir(cloudBck)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := os.Stat(bdir); err != nil {
+		if os.IsNotExist(err) {
+			return http.StatusNotFound, cmn.NewErrRemBckNotFound(cloudBck)
+		}
+		return fsErr(err, cloudBck.Cname(""))
+	}
+
+	msg.PageSize = calcPageSize(msg.PageSize, bck.MaxPageSize())
+	w := &dirWalk{
+		readDir: func(dir string) ([]dirEnt, error) { return fsReadDir(bdir, dir) },
+		loadMD: func(name string, de *dirEnt) bool {
+			finfo, err := os.Stat(filepath.Join(bdir, name))
+			if err != nil {
+				return false
+			}
+			de.size, de.mtime, de.version = finfo.Size(), finfo.ModTime(), fsVersion(finfo)
+			return true
+		},
+		msg: msg,
+		lst: lst,
+	}
+	if err := w.do(); err != nil {
+		return fsErr(err, cloudBck.Cname(msg.Prefix))
+	}
+	if cmn.Rom.V(4, cos.ModBackend) {
+		nlog.Infof("[list_objects] count %d", len(lst.Entries))
+	}
+	return 0, nil
+}
+
+// directories and regular files, including symlinks to regular files; size etc. is loaded lazily (see loadMD above)
+func fsReadDir(bdir, dir string) ([]dirEnt, error) {
+	dents, err := os.ReadDir(filepath.Join(bdir, dir))
+	if err != nil {
+		if dir != "" && (os.IsNotExist(err) || errors.Is(err, syscall.ENOTDIR)) {
+			return nil, nil // prefix that does not exist
+		}
+		return nil, err
+	}
+	ents := make([]dirEnt, 0, len(dents))
+	for _, dent := range dents {
+		fname := dent.Name()
+		if strings.HasPrefix(fname, fsTmpPrefix) {
+			continue
+		}
+		mode := dent.Type()
+		if mode&fs.ModeSymlink != 0 {
+			finfo, err := os.Stat(filepath.Join(bdir, dir, fname))
+			if err != nil || !finfo.Mode().IsRegular() {
+				continue // dangling or symlinked directory (not following)
+			}
+			mode = 0
+		}
+		if mode.IsDir() || mode.IsRegular() {
+			ents = append(ents, dirEnt{name: fname, isDir: mode.IsDir()})
+		}
+	}
+	return ents, nil
+}
+
+//
+// LIST BUCKETS
+//
+
+func (fsbp *fsbp) ListBuckets(cmn.QueryBcks) (cmn.Bcks, int, error) {
+	root, err := fsbp.root()
+	if err != nil {
+		return nil, 0, err
+	}
+	dents, err := os.ReadDir(root)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	bcks := make(cmn.Bcks, 0, len(dents))
+	for _, dent := range dents {
+		if !dent.IsDir() {
+			continue
+		}
+		bck := cmn.Bck{Name: dent.Name(), Provider: apc.File}
+		if bck.ValidateName() != nil { // including hidden (dot-prefixed) directories
+			continue
+		}
+		bcks = append(bcks, bck)
+	}
+	return bcks, 0, nil
+}
+
+//
+// HEAD OBJECT
+//
+
+func (fsbp *fsbp) HeadObj(_ context.Context, lom *core.LOM, _ *http.Request) (*cmn.ObjAttrs, int, error) {
+	_, fpath, err := fsbp.objPath(lom)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	finfo, err := os.Stat(fpath)
+	if err == nil && !finfo.Mode().IsRegular() {
+		err = os.ErrNotExist
+	}
+	if err != nil {
+		ecode, errV := fsErr(err, lom.Cname())
+		return nil, ecode, errV
+	}
+	oa := &cmn.ObjAttrs{}
+	oa.CustomMD = make(cos.StrKVs, 4)
+	oa.SetCustomKey(cmn.SourceObjMD, apc.File)
+	oa.Size = finfo.Size()
+	v := fsVersion(finfo)
+	oa.SetCustomKey(cmn.VersionObjMD, v)
+	oa.SetVersion(v)
+	oa.SetCustomKey(cos.HdrLastModified, fmtHdrTime(finfo.ModTime()))
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infof("[head_object] %s", lom)
+	}
+	return oa, 0, nil
+}
+
+//
+// GET OBJECT
+//
+
+func (fsbp *fsbp) GetObj(ctx context.Context, lom *core.LOM, owt cmn.OWT, _ *http.Request) (int, error) {
+	res := fsbp.GetObjReader(ctx, lom, 0, 0)
+	if res.Err != nil {
+		return res.ErrCode, res.Err
+	}
+	params := allocPutParams(res, owt)
+	err := fsbp.t.PutObject(lom, params)
+	core.FreePutParams(params)
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infoln("[get_object]", lom.String(), err)
+	}
+	return 0, err
+}
+
+func (fsbp *fsbp) GetObjReader(_ context.Context, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
+	_, fpath, err := fsbp.objPath(lom)
+	if err != nil {
+		return core.GetReaderResult{Err: err, ErrCode: http.StatusBadRequest}
+	}
+	fh, err := os.Open(fpath)
+	if err != nil {
+		res.ErrCode, res.Err = fsErr(err, lom.Cname())
+		return res
+	}
+	finfo, err := fh.Stat()
+	if err == nil && !finfo.Mode().IsRegular() {
+		err = os.ErrNotExist
+	}
+	if err != nil {
+		cos.Close(fh)
+		res.ErrCode, res.Err = fsErr(err, lom.Cname())
+		return res
+	}
+	size := finfo.Size()
+
+	// range read
+	if length > 0 {
+		if offset < 0 || offset >= size || length > size-offset {
+			cos.Close(fh)
+			s := fmt.Sprintf("offset=%d,length=%d", offset, length)
+			res.Err = cos.NewErrRangeNotSatisfiable(nil, []string{s}, size)
+			res.ErrCode = http.StatusRequestedRangeNotSatisfiable
+			return res
+		}
+		res.R = &fsSection{io.NewSectionReader(fh, offset, length), fh}
+		res.Size = length
+		return res
+	}
+
+	// full read
+	lom.SetCustomKey(cmn.SourceObjMD, apc.File)
+	fsSetCustom(lom, finfo)
+	res.R = fh
+	res.Size = size
+	return res
+}
+
+type fsSection struct {
+	*io.SectionReader
+	fh *os.File
+}
+
+func (r *fsSection) Close() error { return r.fh.Close() }
+
+func fsSetCustom(lom *core.LOM, finfo os.FileInfo) {
+	v := fsVersion(finfo)
+	lom.SetVersion(v)
+	lom.SetCustomKey(cmn.VersionObjMD, v)
+	lom.SetCustomKey(cmn.LsoLastModified, fmtLsoTime(finfo.ModTime()))
+	lom.SetCustomKey(cos.HdrLastModified, fmtHdrTime(finfo.ModTime()))
+}
+
+//
+// PUT OBJECT
+//
+
+// write temp file in the destination directory, then rename
+func (fsbp *fsbp) PutObj(_ context.Context, r io.ReadCloser, lom *core.LOM, _ *http.Request) (int, error) {
+	_, fpath, err := fsbp.objPath(lom)
+	if err != nil {
+		cos.Close(r)
+		return http.StatusBadRequest, err
+	}
+	written, err := fsbp.writeFile(fpath, r)
+	cos.Close(r)
+	if err != nil {
+		return fsErr(err, lom.Cname())
+	}
+	finfo, err := os.Stat(fpath)
+	if err != nil {
+		return fsErr(err, lom.Cname())
+	}
+	fsSetCustom(lom, finfo)
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infof("[put_object] %s, size %d", lom, written)
+	}
+	return 0, nil
+}
+
+func (fsbp *fsbp) writeFile(fpath string, readers ...io.Reader) (written int64, err error) {
+	var (
+		n   int64
+		tmp = filepath.Join(filepath.Dir(fpath), fsTmpPrefix+filepath.Base(fpath)+"."+cos.GenTie())
+	)
+	fh, err := cos.CreateFile(tmp)
+	if err != nil {
+		return 0, err
+	}
+	buf, slab := fsbp.t.PageMM().Alloc()
+	for _, r := range readers {
+		if n, err = io.CopyBuffer(fh, r, buf); err != nil {
+			break
+		}
+		written += n
+	}
+	slab.Free(buf)
+	if err == nil {
+		err = fh.Sync()
+	}
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	if err == nil {
+		err = cos.Rename(tmp, fpath)
+	}
+	if err != nil {
+		if errRm := cos.RemoveFile(tmp); errRm != nil {
+			nlog.Errorln("failed to remove", tmp, "[", err, errRm, "]")
+		}
+	}
+	return written, err
+}
+
+//
+// DELETE OBJECT
+//
+
+func (fsbp *fsbp) DeleteObj(_ context.Context, lom *core.LOM) (int, error) {
+	_, fpath, err := fsbp.objPath(lom)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	if err := os.Remove(fpath); err != nil {
+		return fsErr(err, lom.Cname())
+	}
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infof("[delete_object] %s", lom)
+	}
+	return 0, nil
+}
@@ -0,0 +1,123 @@
+//go:build file
+
+// Package backend contains core/backend interface implementations for supported backend providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+func TestDirCmp(t *testing.T) {
+	names := []string{"b", "a-c", "a/d/e", "a/b", "a/", "a", "x/y/z"}
+	slices.SortFunc(names, dirCmp)
+	expected := []string{"a", "a/", "a/b", "a/d/e", "a-c", "b", "x/y/z"}
+	if !slices.Equal(names, expected) {
+		t.Fatalf("walk order: expected %v, got %v", expected, names)
+	}
+}
+
+func TestFileListObjects(t *testing.T) {
+	var (
+		root = t.TempDir()
+		objs = []string{"a/b", "a/d/e", "a-c", "b", "x/y/z"}
+	)
+	for _, name := range append(slices.Clone(objs), fsTmpPrefix+"b.1234") {
+		fqn := filepath.Join(root, "bck", name)
+		if err := os.MkdirAll(filepath.Dir(fqn), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(fqn, []byte(name), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	config := cmn.GCO.BeginUpdate()
+	config.Backend.Set(apc.File, cmn.BackendConfFile{Root: root})
+	cmn.GCO.CommitUpdate(config)
+
+	var (
+		bp  = &fsbp{base: base{provider: apc.File}}
+		bck = meta.NewBck("bck", apc.File, cmn.NsGlobal)
+	)
+	list := func(msg *apc.LsoMsg) (names []string) {
+		for {
+			lst := &cmn.LsoRes{}
+			if _, err := bp.ListObjects(bck, msg, lst); err != nil {
+				t.Fatal(err)
+			}
+			for _, en := range lst.Entries {
+				names = append(names, en.Name)
+			}
+			if lst.ContinuationToken == "" {
+				return names
+			}
+			msg.ContinuationToken = lst.ContinuationToken
+		}
+	}
+
+	// paginated
+	if names := list(&apc.LsoMsg{PageSize: 2}); !slices.Equal(names, objs) {
+		t.Fatalf("expected %v, got %v", objs, names)
+	}
+	// prefix
+	if names := list(&apc.LsoMsg{Prefix: "a/", PageSize: 1}); !slices.Equal(names, []string{"a/b", "a/d/e"}) {
+		t.Fatalf("prefix: unexpected %v", names)
+	}
+	if names := list(&apc.LsoMsg{Prefix: "nonexistent/dir/"}); len(names) != 0 {
+		t.Fatalf("nonexistent prefix: unexpected %v", names)
+	}
+	// non-recursive
+	msg := &apc.LsoMsg{PageSize: 3}
+	msg.SetFlag(apc.LsNoRecursion)
+	if names := list(msg); !slices.Equal(names, []string{"a/", "a-c", "b", "x/"}) {
+		t.Fatalf("non-recursive: unexpected %v", names)
+	}
+	msg = &apc.LsoMsg{}
+	msg.SetFlag(apc.LsNoRecursion | apc.LsNoDirs)
+	if names := list(msg); !slices.Equal(names, []string{"a-c", "b"}) {
+		t.Fatalf("non-recursive, no dirs: unexpected %v", names)
+	}
+}
+
+func TestFileMptCleanup(t *testing.T) {
+	root := t.TempDir()
+	config := cmn.GCO.BeginUpdate()
+	config.Backend.Set(apc.File, cmn.BackendConfFile{Root: root})
+	cmn.GCO.CommitUpdate(config)
+
+	var (
+		bp  = &fsbp{base: base{provider: apc.File}}
+		old = time.Now().Add(-fsMptOldAge - time.Hour)
+	)
+	for _, id := range []string{"abandoned", "active"} {
+		udir := filepath.Join(root, fsMptDir, id)
+		if err := os.MkdirAll(udir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(udir, fsPartName(1)), []byte(id), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Chtimes(filepath.Join(root, fsMptDir, "abandoned"), old, old); err != nil {
+		t.Fatal(err)
+	}
+	if ival := bp.housekeepMpt(0); ival != fsMptCleanupIval {
+		t.Fatalf("unexpected interval %v", ival)
+	}
+	if _, err := os.Stat(filepath.Join(root, fsMptDir, "abandoned")); !os.IsNotExist(err) {
+		t.Fatalf("expected abandoned upload removed, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, fsMptDir, "active", fsPartName(1))); err != nil {
+		t.Fatal(err)
+	}
+}
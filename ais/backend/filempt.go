@@ -0,0 +1,236 @@
+//go:build file
+
+// Package backend contains core/backend interface implementations for supported backend providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// file:// multipart upload
+// - parts are stored under <root>/.ais.mpt/<upload-id>/ (outside of any bucket)
+//   along with the (bucket-qualified) name of the object being uploaded (fsMptOname)
+// - complete concatenates the parts in the specified order into a temp file and renames it
+// - part ETag is the part's MD5; the resulting ETag is S3-style MD5 of MD5s followed by "-<number-of-parts>"
+// - abandoned uploads (no new parts for fsMptOldAge) are periodically removed (see housekeepMpt)
+
+const (
+	fsMptOname       = "oname"
+	fsMptOldAge      = 7 * 24 * time.Hour
+	fsMptCleanupIval = time.Hour
+)
+
+func (fsbp *fsbp) uploadDir(uploadID string) (string, error) {
+	root, err := fsbp.root()
+	if err != nil {
+		return "", err
+	}
+	if err := cos.ValidateOname(uploadID); err != nil || filepath.Base(uploadID) != uploadID {
+		return "", fmt.Errorf("file backend: invalid upload ID %q", uploadID)
+	}
+	return filepath.Join(root, fsMptDir, uploadID), nil
+}
+
+func fsPartName(partNum int) string { return fmt.Sprintf("%05d", partNum) }
+
+// upload exists and was started for the same object
+func fsCheckUpload(udir, uploadID string, lom *core.LOM) (int, error) {
+	b, err := os.ReadFile(filepath.Join(udir, fsMptOname))
+	if err != nil {
+		return fsErr(err, "upload "+uploadID)
+	}
+	if cname := lom.Cname(); string(b) != cname {
+		return http.StatusBadRequest, fmt.Errorf("file backend: upload %q was started for %s, not %s", uploadID, string(b), cname)
+	}
+	return 0, nil
+}
+
+func (fsbp *fsbp) StartMpt(lom *core.LOM, _ *http.Request) (string, int, error) {
+	if _, _, err := fsbp.objPath(lom); err != nil {
+		return "", http.StatusBadRequest, err
+	}
+	uploadID := cos.GenUUID()
+	udir, err := fsbp.uploadDir(uploadID)
+	if err != nil {
+		return "", 0, err
+	}
+	if err := cos.CreateDir(udir); err != nil {
+		ecode, errV := fsErr(err, lom.Cname())
+		return "", ecode, errV
+	}
+	if err := os.WriteFile(filepath.Join(udir, fsMptOname), []byte(lom.Cname()), cos.PermRWR); err != nil {
+		os.RemoveAll(udir)
+		ecode, errV := fsErr(err, lom.Cname())
+		return "", ecode, errV
+	}
+	fsbp.mptOnce.Do(func() {
+		hk.Reg("file-mpt"+hk.NameSuffix, fsbp.housekeepMpt, fsMptCleanupIval)
+	})
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infof("[start_mpt] %s, upload_id: %s", lom, uploadID)
+	}
+	return uploadID, 0, nil
+}
+
+func (fsbp *fsbp) PutMptPart(lom *core.LOM, r cos.ReadOpenCloser, _ *http.Request, uploadID string, size int64, partNum int32) (string, int, error) {
+	defer cos.Close(r)
+	udir, err := fsbp.uploadDir(uploadID)
+	if err != nil {
+		return "", http.StatusBadRequest, err
+	}
+	if ecode, err := fsCheckUpload(udir, uploadID, lom); err != nil {
+		return "", ecode, err
+	}
+	var (
+		h     = md5.New()
+		fpath = filepath.Join(udir, fsPartName(int(partNum)))
+	)
+	written, err := fsbp.writeFile(fpath, io.TeeReader(r, h))
+	if err != nil {
+		ecode, errV := fsErr(err, lom.Cname())
+		return "", ecode, errV
+	}
+	if size >= 0 && written != size {
+		cos.RemoveFile(fpath)
+		return "", http.StatusBadRequest, fmt.Errorf("%s: part %d size mismatch (%d vs %d)", lom, partNum, written, size)
+	}
+	etag := hex.EncodeToString(h.Sum(nil))
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infof("[put_mpt_part] %s, part %d, size %d", lom, partNum, written)
+	}
+	return etag, 0, nil
+}
+
+func (fsbp *fsbp) CompleteMpt(lom *core.LOM, _ *http.Request, uploadID string, _ []byte, parts apc.MptCompletedParts) (version, etag string, _ int, _ error) {
+	udir, err := fsbp.uploadDir(uploadID)
+	if err != nil {
+		return "", "", http.StatusBadRequest, err
+	}
+	_, fpath, err := fsbp.objPath(lom)
+	if err != nil {
+		return "", "", http.StatusBadRequest, err
+	}
+	if len(parts) == 0 {
+		return "", "", http.StatusBadRequest, fmt.Errorf("%s: no parts to complete upload %q", lom, uploadID)
+	}
+	if ecode, err := fsCheckUpload(udir, uploadID, lom); err != nil {
+		return "", "", ecode, err
+	}
+
+	var (
+		readers = make([]io.Reader, 0, len(parts))
+		hashes  = make([]hash.Hash, 0, len(parts))
+		fhs     = make([]*os.File, 0, len(parts))
+	)
+	defer func() {
+		for _, fh := range fhs {
+			cos.Close(fh)
+		}
+	}()
+	for _, part := range parts {
+		fh, err := os.Open(filepath.Join(udir, fsPartName(part.PartNumber)))
+		if err != nil {
+			if os.IsNotExist(err) {
+				if _, errS := os.Stat(udir); errS != nil {
+					ecode, errV := fsErr(errS, "upload "+uploadID)
+					return "", "", ecode, errV
+				}
+				return "", "", http.StatusBadRequest, fmt.Errorf("%s: upload %q is missing part %d", lom, uploadID, part.PartNumber)
+			}
+			ecode, errV := fsErr(err, lom.Cname())
+			return "", "", ecode, errV
+		}
+		fhs = append(fhs, fh)
+		h := md5.New()
+		hashes = append(hashes, h)
+		readers = append(readers, io.TeeReader(fh, h))
+	}
+	if _, err := fsbp.writeFile(fpath, readers...); err != nil {
+		ecode, errV := fsErr(err, lom.Cname())
+		return "", "", ecode, errV
+	}
+	finfo, err := os.Stat(fpath)
+	if err != nil {
+		ecode, errV := fsErr(err, lom.Cname())
+		return "", "", ecode, errV
+	}
+
+	// md5 of md5s
+	h := md5.New()
+	for _, ph := range hashes {
+		h.Write(ph.Sum(nil))
+	}
+	etag = hex.EncodeToString(h.Sum(nil)) + "-" + strconv.Itoa(len(parts))
+	version = fsVersion(finfo)
+	fsSetCustom(lom, finfo)
+
+	if err := os.RemoveAll(udir); err != nil {
+		nlog.Errorln("failed to cleanup", udir, "[", err, "]")
+	}
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infof("[complete_mpt] %s, upload_id: %s, parts %d", lom, uploadID, len(parts))
+	}
+	return version, etag, 0, nil
+}
+
+func (fsbp *fsbp) AbortMpt(lom *core.LOM, _ *http.Request, uploadID string) (int, error) {
+	udir, err := fsbp.uploadDir(uploadID)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	if _, err := os.Stat(udir); err != nil {
+		return fsErr(err, "upload "+uploadID)
+	}
+	if err := os.RemoveAll(udir); err != nil {
+		return fsErr(err, lom.Cname())
+	}
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infof("[abort_mpt] %s, upload_id: %s", lom, uploadID)
+	}
+	return 0, nil
+}
+
+// remove abandoned uploads (all targets share the root - removing an already removed one is fine)
+func (fsbp *fsbp) housekeepMpt(int64) time.Duration {
+	root, err := fsbp.root()
+	if err != nil {
+		return fsMptCleanupIval
+	}
+	var (
+		mdir    = filepath.Join(root, fsMptDir)
+		ents, _ = os.ReadDir(mdir)
+		now     = time.Now()
+	)
+	for _, ent := range ents {
+		finfo, err := ent.Info()
+		if err != nil || !ent.IsDir() || now.Sub(finfo.ModTime()) < fsMptOldAge {
+			continue
+		}
+		udir := filepath.Join(mdir, ent.Name())
+		if err := os.RemoveAll(udir); err != nil {
+			nlog.Warningln("file backend: failed to remove abandoned upload", udir, "[", err, "]")
+			continue
+		}
+		nlog.Infoln("file backend: removed abandoned upload", ent.Name(), "last modified", finfo.ModTime())
+	}
+	return fsMptCleanupIval
+}
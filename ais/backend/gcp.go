@@ -48,6 +48,8 @@ const (
 	fmtErrLoadCreds = "gcp: failed to load application creds"
 )
 
+const gcpSecretPrefix = "secret:" // appSess key prefix (vs credPath)
+
 type (
 	gsbp struct {
 		t        core.TargetPut
@@ -64,7 +66,7 @@ type (
 )
 
 var (
-	appSess sync.Map // additional [credPath => *gcpSess]
+	appSess sync.Map // additional [credPath | secret@gen => *gcpSess]
 )
 
 // interface guard
@@ -82,6 +84,7 @@ func NewGCP(t core.TargetPut, tstats stats.Tracker, startingUp bool) (core.Backe
 	if !startingUp {
 		appSess.Clear()
 	}
+	secrets.sub(apc.GCP, gcpDropSess)
 
 	return bp, nil
 }
@@ -149,6 +152,9 @@ func (gsbp *gsbp) HeadBucket(ctx context.Context, bck *meta.Bck) (cos.StrKVs, in
 	}
 	if _, err := client.Bucket(cloudBck.Name).Attrs(ctx); err != nil {
 		ecode, err := gcpErrorToAISError(err, cloudBck)
+		if cloudBck.Props != nil {
+			err = secretErr(cloudBck, cloudBck.Props.Extra.GCP.Secret, ecode, err)
+		}
 		return nil, ecode, err
 	}
 	//
@@ -560,6 +566,31 @@ func gcpCreateSess(ctx context.Context, credPath string, bck *cmn.Bck) (*gcpSess
 	return sess, sess.init(ctx, opts)
 }
 
+// once per (secret, generation), via bprops "extra.gcp.secret"
+func gcpCreateSessJSON(ctx context.Context, data []byte, secret string) (*gcpSess, error) {
+	projectID, _ := jsoniter.Get(data, projectIDField).GetInterface().(string)
+	if projectID == "" {
+		return nil, fmt.Errorf("%s from backend secret %q: missing %s", fmtErrLoadCreds, secret, projectIDField)
+	}
+	opts := []option.ClientOption{
+		option.WithScopes(storage.ScopeFullControl),
+		option.WithAuthCredentialsJSON(option.ServiceAccount, data),
+	}
+	sess := &gcpSess{projectID: projectID}
+	return sess, sess.init(ctx, opts)
+}
+
+// (secret changed)
+func gcpDropSess(secret string) {
+	prefix := gcpSecretPrefix + secret + "@"
+	appSess.Range(func(k, _ any) bool {
+		if strings.HasPrefix(k.(string), prefix) {
+			appSess.Delete(k)
+		}
+		return true
+	})
+}
+
 func (sess *gcpSess) init(ctx context.Context, opts []option.ClientOption) error {
 	// HTTP transport
 	transport, err := htransport.NewTransport(ctx, cmn.NewTransport(cmn.TransportArgs{}), opts...)
@@ -590,9 +621,13 @@ func (gsbp *gsbp) getClient(ctx context.Context, bck *cmn.Bck) (*storage.Client,
 }
 
 func (gsbp *gsbp) getSess(ctx context.Context, bck *cmn.Bck) (*gcpSess, error) {
-	var credPath string
+	var credPath, secret string
 	if bck != nil && bck.Props != nil {
 		credPath = bck.Props.Extra.GCP.ApplicationCreds
+		secret = bck.Props.Extra.GCP.Secret
+	}
+	if secret != "" {
+		return getSecretSess(ctx, bck, secret)
 	}
 	if credPath == "" {
 		return gsbp.getDfltSess()
@@ -612,6 +647,23 @@ func (gsbp *gsbp) getSess(ctx context.Context, bck *cmn.Bck) (*gcpSess, error) {
 	return sess, nil
 }
 
+func getSecretSess(ctx context.Context, bck *cmn.Bck, secret string) (*gcpSess, error) {
+	data, gen, err := secrets.get(secret)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", bck.Cname(""), err)
+	}
+	key := gcpSecretPrefix + secretCid(secret, gen)
+	if v, loaded := appSess.Load(key); loaded {
+		return v.(*gcpSess), nil
+	}
+	sess, err := gcpCreateSessJSON(ctx, data, secret)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", bck.Cname(""), err)
+	}
+	appSess.Store(key, sess)
+	return sess, nil
+}
+
 //
 // errors
 //
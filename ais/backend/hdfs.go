@@ -0,0 +1,580 @@
+//go:build hdfs
+
+// Package backend contains core/backend interface implementations for supported backend providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// hdfs:// backend via WebHDFS REST API
+// - https://hadoop.apache.org/docs/stable/hadoop-project-dist/hadoop-hdfs/WebHDFS.html
+// - configured via `backend.hdfs` (see cmn.BackendConfHDFS)
+// - bucket => top-level directory under the configured root; object => file under the bucket's directory
+// - version: file modification time (milliseconds)
+// - simple (user.name) authentication only; multipart upload is not supported
+
+const (
+	webhdfsPrefix = "/webhdfs/v1"
+
+	hdfsTypeFile = "FILE"
+	hdfsTypeDir  = "DIRECTORY"
+
+	hdfsNotFound = "FileNotFoundException"
+	hdfsDenied   = "AccessControlException"
+)
+
+type (
+	hdfsbp struct {
+		t      core.TargetPut
+		cliH   *http.Client
+		cliTLS *http.Client
+		cliNR  *http.Client // no redirects (two-step CREATE)
+		base
+	}
+	hdfsStatus struct {
+		PathSuffix string `json:"pathSuffix"`
+		Type       string `json:"type"`
+		Length     int64  `json:"length"`
+		MTime      int64  `json:"modificationTime"` // milliseconds
+	}
+	hdfsRemoteErr struct {
+		RemoteException struct {
+			Exception string `json:"exception"`
+			Message   string `json:"message"`
+		} `json:"RemoteException"`
+	}
+)
+
+// interface guard
+var _ core.Backend = (*hdfsbp)(nil)
+
+func NewHDFS(t core.TargetPut, config *cmn.Config, tstats stats.Tracker, startingUp bool) (core.Backend, error) {
+	bp := &hdfsbp{
+		t:    t,
+		base: base{provider: apc.HDFS},
+	}
+	bp.initClients(config.Client.Timeout.D(), config.Client.TimeoutLong.D())
+	bp.init(t.Snode(), tstats, startingUp)
+	return bp, nil
+}
+
+func (hdfsbp *hdfsbp) initClients(timeout, timeoutLong time.Duration) {
+	hdfsbp.cliH, hdfsbp.cliTLS = cmn.NewDefaultClients(timeoutLong)
+	hdfsbp.cliNR = &http.Client{
+		Transport:     hdfsbp.cliTLS.Transport,
+		Timeout:       timeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+}
+
+// current configuration (may change at runtime)
+func hdfsConf() (*cmn.BackendConfHDFS, error) {
+	conf := cmn.GCO.Get().Backend.Get(apc.HDFS)
+	if conf == nil {
+		return nil, &cmn.ErrMissingBackend{Provider: apc.HDFS}
+	}
+	if c, ok := conf.(cmn.BackendConfHDFS); ok {
+		return &c, nil
+	}
+	c := &cmn.BackendConfHDFS{}
+	if err := cos.MorphMarshal(conf, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *hdfsStatus) version() string  { return strconv.FormatInt(c.MTime, 10) }
+func (c *hdfsStatus) mtime() time.Time { return time.UnixMilli(c.MTime) }
+func (c *hdfsStatus) isDir() bool      { return c.Type == hdfsTypeDir }
+func (c *hdfsStatus) isFile() bool     { return c.Type == hdfsTypeFile }
+func hdfsBckPath(conf *cmn.BackendConfHDFS, bck *cmn.Bck) string {
+	return path.Join(conf.Root, bck.Name)
+}
+
+func hdfsObjPath(conf *cmn.BackendConfHDFS, bck *cmn.Bck, objName string) (string, error) {
+	var (
+		bpath = hdfsBckPath(conf, bck)
+		opath = path.Join(bpath, objName)
+	)
+	if !strings.HasPrefix(opath, bpath+"/") {
+		return "", fmt.Errorf("hdfs backend: invalid object name %q", objName)
+	}
+	return opath, nil
+}
+
+//
+// WebHDFS client
+//
+
+func (hdfsbp *hdfsbp) client(u string) *http.Client {
+	if cos.IsHTTPS(u) {
+		return hdfsbp.cliTLS
+	}
+	return hdfsbp.cliH
+}
+
+func hdfsURL(conf *cmn.BackendConfHDFS, fpath, op string, q url.Values) string {
+	if q == nil {
+		q = make(url.Values, 2)
+	}
+	q.Set("op", op)
+	if conf.User != "" {
+		q.Set("user.name", conf.User)
+	}
+	u := url.URL{Path: webhdfsPrefix + fpath}
+	return conf.URL + u.EscapedPath() + "?" + q.Encode()
+}
+
+func (hdfsbp *hdfsbp) do(ctx context.Context, cli *http.Client, method, u string, body io.Reader, size int64) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.ContentLength = size
+		req.Header.Set(cos.HdrContentType, cos.ContentBinary)
+	}
+	if cli == nil {
+		cli = hdfsbp.client(u)
+	}
+	return cli.Do(req)
+}
+
+// returns (status, error) given non-2xx response
+func hdfsErr(resp *http.Response, what string) (int, error) {
+	var (
+		rerr  hdfsRemoteErr
+		ecode = resp.StatusCode
+		b, _  = io.ReadAll(io.LimitReader(resp.Body, 4*cos.KiB))
+	)
+	if json.Unmarshal(b, &rerr) == nil && rerr.RemoteException.Exception != "" {
+		switch rerr.RemoteException.Exception {
+		case hdfsNotFound:
+			return http.StatusNotFound, cos.NewErrNotFound(nil, what)
+		case hdfsDenied:
+			ecode = http.StatusForbidden
+		}
+		return ecode, fmt.Errorf("hdfs: %s: %s (%s)", what, rerr.RemoteException.Message, rerr.RemoteException.Exception)
+	}
+	if ecode == http.StatusNotFound {
+		return ecode, cos.NewErrNotFound(nil, what)
+	}
+	return ecode, fmt.Errorf("hdfs: %s: status %d (%s)", what, ecode, strings.TrimSpace(string(b)))
+}
+
+// GETFILESTATUS
+func (hdfsbp *hdfsbp) status(ctx context.Context, conf *cmn.BackendConfHDFS, fpath string) (*hdfsStatus, int, error) {
+	resp, err := hdfsbp.do(ctx, nil, http.MethodGet, hdfsURL(conf, fpath, "GETFILESTATUS", nil), nil, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		ecode, err := hdfsErr(resp, fpath)
+		return nil, ecode, err
+	}
+	var res struct {
+		FileStatus hdfsStatus `json:"FileStatus"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, 0, fmt.Errorf("hdfs: %s: invalid file status: %w", fpath, err)
+	}
+	return &res.FileStatus, 0, nil
+}
+
+// LISTSTATUS (sorted by name)
+func (hdfsbp *hdfsbp) listStatus(ctx context.Context, conf *cmn.BackendConfHDFS, dir string) ([]hdfsStatus, int, error) {
+	resp, err := hdfsbp.do(ctx, nil, http.MethodGet, hdfsURL(conf, dir, "LISTSTATUS", nil), nil, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		ecode, err := hdfsErr(resp, dir)
+		return nil, ecode, err
+	}
+	var res struct {
+		FileStatuses struct {
+			FileStatus []hdfsStatus `json:"FileStatus"`
+		} `json:"FileStatuses"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, 0, fmt.Errorf("hdfs: %s: invalid directory listing: %w", dir, err)
+	}
+	sts := res.FileStatuses.FileStatus
+	sort.Slice(sts, func(i, j int) bool { return sts[i].PathSuffix < sts[j].PathSuffix })
+	return sts, 0, nil
+}
+
+// OPEN (the NameNode redirects to a DataNode); zero length reads until the end
+func (hdfsbp *hdfsbp) open(ctx context.Context, conf *cmn.BackendConfHDFS, fpath string, offset, length int64) (io.ReadCloser, int, error) {
+	var q url.Values
+	if length > 0 {
+		q = url.Values{"offset": []string{strconv.FormatInt(offset, 10)}, "length": []string{strconv.FormatInt(length, 10)}}
+	}
+	resp, err := hdfsbp.do(ctx, nil, http.MethodGet, hdfsURL(conf, fpath, "OPEN", q), nil, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		ecode, err := hdfsErr(resp, fpath)
+		resp.Body.Close()
+		return nil, ecode, err
+	}
+	return resp.Body, 0, nil
+}
+
+// CREATE: two steps - the NameNode redirects (without reading the body) to a DataNode
+func (hdfsbp *hdfsbp) create(ctx context.Context, conf *cmn.BackendConfHDFS, fpath string, r io.Reader, size int64) (int, error) {
+	q := url.Values{"overwrite": []string{"true"}}
+	resp, err := hdfsbp.do(ctx, hdfsbp.cliNR, http.MethodPut, hdfsURL(conf, fpath, "CREATE", q), nil, 0)
+	if err != nil {
+		return 0, err
+	}
+	var location string
+	switch resp.StatusCode {
+	case http.StatusTemporaryRedirect, http.StatusFound, http.StatusSeeOther:
+		location = resp.Header.Get(cos.HdrLocation)
+	case http.StatusOK: // noredirect=true
+		var res struct {
+			Location string `json:"Location"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&res)
+		location = res.Location
+	default:
+		ecode, err := hdfsErr(resp, fpath)
+		resp.Body.Close()
+		return ecode, err
+	}
+	resp.Body.Close()
+	if err != nil || location == "" {
+		return 0, fmt.Errorf("hdfs: %s: missing DataNode location (%v)", fpath, err)
+	}
+
+	resp, err = hdfsbp.do(ctx, nil, http.MethodPut, location, r, size)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return hdfsErr(resp, fpath)
+	}
+	return 0, nil
+}
+
+// DELETE and MKDIRS
+func (hdfsbp *hdfsbp) boolOp(ctx context.Context, conf *cmn.BackendConfHDFS, method, op, fpath string) (bool, int, error) {
+	resp, err := hdfsbp.do(ctx, nil, method, hdfsURL(conf, fpath, op, nil), nil, 0)
+	if err != nil {
+		return false, 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		ecode, err := hdfsErr(resp, fpath)
+		return false, ecode, err
+	}
+	var res struct {
+		Boolean bool `json:"boolean"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return false, 0, fmt.Errorf("hdfs: %s %s: invalid response: %w", op, fpath, err)
+	}
+	return res.Boolean, 0, nil
+}
+
+//
+// core.Backend
+//
+
+func (hdfsbp *hdfsbp) HeadBucket(ctx context.Context, bck *meta.Bck) (cos.StrKVs, int, error) {
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infof("head_bucket %s", bck.Name)
+	}
+	conf, err := hdfsConf()
+	if err != nil {
+		return nil, 0, err
+	}
+	cloudBck := bck.RemoteBck()
+	st, ecode, err := hdfsbp.status(ctx, conf, hdfsBckPath(conf, cloudBck))
+	if err == nil && !st.isDir() {
+		ecode, err = http.StatusNotFound, errors.New("not a directory")
+	}
+	if err != nil {
+		if ecode == http.StatusNotFound {
+			return nil, ecode, cmn.NewErrRemBckNotFound(cloudBck)
+		}
+		return nil, ecode, err
+	}
+	bckProps := make(cos.StrKVs, 2)
+	bckProps[apc.HdrBackendProvider] = apc.HDFS
+	// mtime-based versions are always available
+	bckProps[apc.HdrBucketVerEnabled] = "true"
+	return bckProps, 0, nil
+}
+
+func (hdfsbp *hdfsbp) CreateBucket(bck *meta.Bck) (int, error) {
+	conf, err := hdfsConf()
+	if err != nil {
+		return 0, err
+	}
+	fpath := hdfsBckPath(conf, bck.RemoteBck())
+	ok, ecode, err := hdfsbp.boolOp(context.Background(), conf, http.MethodPut, "MKDIRS", fpath)
+	if err == nil && !ok {
+		ecode, err = http.StatusInternalServerError, fmt.Errorf("hdfs: failed to create %s", fpath)
+	}
+	return ecode, err
+}
+
+// (see dirWalk)
+func (hdfsbp *hdfsbp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (int, error) {
+	conf, err := hdfsConf()
+	if err != nil {
+		return 0, err
+	}
+	var (
+		ctx      = context.Background()
+		cloudBck = bck.RemoteBck()
+		bpath    = hdfsBckPath(conf, cloudBck)
+		ecode    int
+	)
+	if st, code, err := hdfsbp.status(ctx, conf, bpath); err != nil || !st.isDir() {
+		if err == nil || code == http.StatusNotFound {
+			return http.StatusNotFound, cmn.NewErrRemBckNotFound(cloudBck)
+		}
+		return code, err
+	}
+
+	msg.PageSize = calcPageSize(msg.PageSize, bck.MaxPageSize())
+	w := &dirWalk{
+		readDir: func(dir string) ([]dirEnt, error) {
+			sts, code, err := hdfsbp.listStatus(ctx, conf, path.Join(bpath, dir))
+			if err != nil {
+				if dir != "" && code == http.StatusNotFound {
+					return nil, nil // prefix that does not exist
+				}
+				ecode = code
+				return nil, err
+			}
+			ents := make([]dirEnt, 0, len(sts))
+			for i := range sts {
+				st := &sts[i]
+				if !st.isDir() && !st.isFile() {
+					continue // symlinks
+				}
+				ents = append(ents, dirEnt{
+					name:    st.PathSuffix,
+					size:    st.Length,
+					mtime:   st.mtime(),
+					version: st.version(),
+					isDir:   st.isDir(),
+					hasMD:   true,
+				})
+			}
+			return ents, nil
+		},
+		msg: msg,
+		lst: lst,
+	}
+	if err := w.do(); err != nil {
+		return ecode, err
+	}
+	if cmn.Rom.V(4, cos.ModBackend) {
+		nlog.Infof("[list_objects] count %d", len(lst.Entries))
+	}
+	return 0, nil
+}
+
+func (hdfsbp *hdfsbp) ListBuckets(cmn.QueryBcks) (cmn.Bcks, int, error) {
+	conf, err := hdfsConf()
+	if err != nil {
+		return nil, 0, err
+	}
+	sts, ecode, err := hdfsbp.listStatus(context.Background(), conf, conf.Root)
+	if err != nil {
+		return nil, ecode, err
+	}
+	bcks := make(cmn.Bcks, 0, len(sts))
+	for i := range sts {
+		if !sts[i].isDir() {
+			continue
+		}
+		bck := cmn.Bck{Name: sts[i].PathSuffix, Provider: apc.HDFS}
+		if bck.ValidateName() != nil {
+			continue
+		}
+		bcks = append(bcks, bck)
+	}
+	return bcks, 0, nil
+}
+
+func (hdfsbp *hdfsbp) HeadObj(ctx context.Context, lom *core.LOM, _ *http.Request) (*cmn.ObjAttrs, int, error) {
+	st, ecode, err := hdfsbp.headObj(ctx, lom.Bck().RemoteBck(), lom.ObjName)
+	if err != nil {
+		return nil, ecode, err
+	}
+	oa := &cmn.ObjAttrs{}
+	oa.CustomMD = make(cos.StrKVs, 4)
+	oa.SetCustomKey(cmn.SourceObjMD, apc.HDFS)
+	oa.Size = st.Length
+	v := st.version()
+	oa.SetCustomKey(cmn.VersionObjMD, v)
+	oa.SetVersion(v)
+	oa.SetCustomKey(cos.HdrLastModified, fmtHdrTime(st.mtime()))
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infof("[head_object] %s", lom)
+	}
+	return oa, 0, nil
+}
+
+func (hdfsbp *hdfsbp) headObj(ctx context.Context, cloudBck *cmn.Bck, objName string) (*hdfsStatus, int, error) {
+	conf, err := hdfsConf()
+	if err != nil {
+		return nil, 0, err
+	}
+	fpath, err := hdfsObjPath(conf, cloudBck, objName)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	st, ecode, err := hdfsbp.status(ctx, conf, fpath)
+	if err == nil && !st.isFile() {
+		ecode, err = http.StatusNotFound, cos.NewErrNotFound(nil, cloudBck.Cname(objName))
+	}
+	return st, ecode, err
+}
+
+func (hdfsbp *hdfsbp) GetObj(ctx context.Context, lom *core.LOM, owt cmn.OWT, _ *http.Request) (int, error) {
+	res := hdfsbp.GetObjReader(ctx, lom, 0, 0)
+	if res.Err != nil {
+		return res.ErrCode, res.Err
+	}
+	params := allocPutParams(res, owt)
+	err := hdfsbp.t.PutObject(lom, params)
+	core.FreePutParams(params)
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infoln("[get_object]", lom.String(), err)
+	}
+	return 0, err
+}
+
+func (hdfsbp *hdfsbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
+	var st *hdfsStatus
+	st, res = hdfsbp.getReader(ctx, lom.Bck().RemoteBck(), lom.ObjName, offset, length)
+	if res.Err == nil && length == 0 {
+		lom.SetCustomKey(cmn.SourceObjMD, apc.HDFS)
+		hdfsSetCustom(lom, st)
+	}
+	return res
+}
+
+func (hdfsbp *hdfsbp) getReader(ctx context.Context, cloudBck *cmn.Bck, objName string, offset, length int64) (*hdfsStatus, core.GetReaderResult) {
+	var res core.GetReaderResult
+	st, ecode, err := hdfsbp.headObj(ctx, cloudBck, objName)
+	if err != nil {
+		res.ErrCode, res.Err = ecode, err
+		return nil, res
+	}
+	if length > 0 && (offset < 0 || offset >= st.Length || length > st.Length-offset) {
+		s := fmt.Sprintf("offset=%d,length=%d", offset, length)
+		res.Err = cos.NewErrRangeNotSatisfiable(nil, []string{s}, st.Length)
+		res.ErrCode = http.StatusRequestedRangeNotSatisfiable
+		return nil, res
+	}
+	conf, err := hdfsConf()
+	if err != nil {
+		res.Err = err
+		return nil, res
+	}
+	fpath, _ := hdfsObjPath(conf, cloudBck, objName)
+	res.R, res.ErrCode, res.Err = hdfsbp.open(ctx, conf, fpath, offset, length)
+	if res.Err != nil {
+		return nil, res
+	}
+	res.Size = st.Length
+	if length > 0 {
+		res.Size = length
+	}
+	return st, res
+}
+
+func hdfsSetCustom(lom *core.LOM, st *hdfsStatus) {
+	v := st.version()
+	lom.SetVersion(v)
+	lom.SetCustomKey(cmn.VersionObjMD, v)
+	lom.SetCustomKey(cmn.LsoLastModified, fmtLsoTime(st.mtime()))
+	lom.SetCustomKey(cos.HdrLastModified, fmtHdrTime(st.mtime()))
+}
+
+func (hdfsbp *hdfsbp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, _ *http.Request) (int, error) {
+	defer cos.Close(r)
+	st, ecode, err := hdfsbp.putObj(ctx, lom.Bck().RemoteBck(), lom.ObjName, r, lom.Lsize(true))
+	if err != nil {
+		return ecode, err
+	}
+	hdfsSetCustom(lom, st)
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infof("[put_object] %s, size %d", lom, st.Length)
+	}
+	return 0, nil
+}
+
+func (hdfsbp *hdfsbp) putObj(ctx context.Context, cloudBck *cmn.Bck, objName string, r io.Reader, size int64) (*hdfsStatus, int, error) {
+	conf, err := hdfsConf()
+	if err != nil {
+		return nil, 0, err
+	}
+	fpath, err := hdfsObjPath(conf, cloudBck, objName)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	if ecode, err := hdfsbp.create(ctx, conf, fpath, r, size); err != nil {
+		return nil, ecode, err
+	}
+	return hdfsbp.status(ctx, conf, fpath)
+}
+
+func (hdfsbp *hdfsbp) DeleteObj(ctx context.Context, lom *core.LOM) (int, error) {
+	ecode, err := hdfsbp.deleteObj(ctx, lom.Bck().RemoteBck(), lom.ObjName)
+	if err == nil && cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infof("[delete_object] %s", lom)
+	}
+	return ecode, err
+}
+
+func (hdfsbp *hdfsbp) deleteObj(ctx context.Context, cloudBck *cmn.Bck, objName string) (int, error) {
+	conf, err := hdfsConf()
+	if err != nil {
+		return 0, err
+	}
+	fpath, err := hdfsObjPath(conf, cloudBck, objName)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	ok, ecode, err := hdfsbp.boolOp(ctx, conf, http.MethodDelete, "DELETE", fpath)
+	if err == nil && !ok {
+		ecode, err = http.StatusNotFound, cos.NewErrNotFound(nil, cloudBck.Cname(objName))
+	}
+	return ecode, err
+}
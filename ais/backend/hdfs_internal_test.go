@@ -0,0 +1,233 @@
+//go:build hdfs
+
+// Package backend contains core/backend interface implementations for supported backend providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// in-process WebHDFS stand-in: NameNode and DataNode in one, with OPEN and CREATE
+// redirecting to the "DataNode" (same server, `datanode=true`)
+type webhdfs struct {
+	srv   *httptest.Server
+	files map[string][]byte
+	dirs  map[string]bool
+	mu    sync.Mutex
+}
+
+func newWebHDFS(t *testing.T) *webhdfs {
+	w := &webhdfs{files: map[string][]byte{}, dirs: map[string]bool{"/": true}}
+	w.srv = httptest.NewServer(http.HandlerFunc(w.handle))
+	t.Cleanup(w.srv.Close)
+	return w
+}
+
+func (w *webhdfs) mkdirs(p string) {
+	for ; p != "/"; p = path.Dir(p) {
+		w.dirs[p] = true
+	}
+}
+
+func (w *webhdfs) status(p string) map[string]any {
+	if w.dirs[p] {
+		return map[string]any{"pathSuffix": path.Base(p), "type": hdfsTypeDir, "length": 0, "modificationTime": 1}
+	}
+	if b, ok := w.files[p]; ok {
+		return map[string]any{"pathSuffix": path.Base(p), "type": hdfsTypeFile, "length": len(b), "modificationTime": 1000}
+	}
+	return nil
+}
+
+func (w *webhdfs) handle(rw http.ResponseWriter, r *http.Request) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var (
+		q        = r.URL.Query()
+		p        = path.Clean(strings.TrimPrefix(r.URL.Path, webhdfsPrefix))
+		datanode = q.Get("datanode") == "true"
+		reply    = func(v any) { json.NewEncoder(rw).Encode(v) }
+		notFound = func() {
+			rw.WriteHeader(http.StatusNotFound)
+			reply(map[string]any{"RemoteException": map[string]string{"exception": hdfsNotFound, "message": "File " + p + " does not exist."}})
+		}
+		redirect = func() {
+			q.Set("datanode", "true")
+			rw.Header().Set("Location", w.srv.URL+r.URL.Path+"?"+q.Encode())
+			rw.WriteHeader(http.StatusTemporaryRedirect)
+		}
+	)
+	switch q.Get("op") {
+	case "GETFILESTATUS":
+		st := w.status(p)
+		if st == nil {
+			notFound()
+			return
+		}
+		reply(map[string]any{"FileStatus": st})
+	case "LISTSTATUS":
+		if !w.dirs[p] {
+			notFound()
+			return
+		}
+		sts := []map[string]any{}
+		for d := range w.dirs {
+			if d != "/" && path.Dir(d) == p {
+				sts = append(sts, w.status(d))
+			}
+		}
+		for f := range w.files {
+			if path.Dir(f) == p {
+				sts = append(sts, w.status(f))
+			}
+		}
+		reply(map[string]any{"FileStatuses": map[string]any{"FileStatus": sts}})
+	case "OPEN":
+		b, ok := w.files[p]
+		if !ok {
+			notFound()
+			return
+		}
+		if !datanode {
+			redirect()
+			return
+		}
+		offset, _ := strconv.ParseInt(q.Get("offset"), 10, 64)
+		length, _ := strconv.ParseInt(q.Get("length"), 10, 64)
+		b = b[offset:]
+		if length > 0 {
+			b = b[:length]
+		}
+		rw.Write(b)
+	case "CREATE":
+		if !datanode {
+			redirect()
+			return
+		}
+		b, _ := io.ReadAll(r.Body)
+		w.mkdirs(path.Dir(p))
+		w.files[p] = b
+		rw.WriteHeader(http.StatusCreated)
+	case "MKDIRS":
+		w.mkdirs(p)
+		reply(map[string]bool{"boolean": true})
+	case "DELETE":
+		_, ok := w.files[p]
+		delete(w.files, p)
+		reply(map[string]bool{"boolean": ok})
+	default:
+		rw.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func TestHDFS(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		w    = newWebHDFS(t)
+		bp   = &hdfsbp{base: base{provider: apc.HDFS}}
+		bck  = meta.NewBck("bck", apc.HDFS, cmn.NsGlobal)
+		objs = []string{"a/b", "a/d/e", "a-c", "b"}
+	)
+	config := cmn.GCO.BeginUpdate()
+	config.Backend.Set(apc.HDFS, cmn.BackendConfHDFS{URL: w.srv.URL, Root: "/data"})
+	cmn.GCO.CommitUpdate(config)
+	bp.initClients(time.Minute, time.Minute)
+
+	if _, _, err := bp.HeadBucket(ctx, bck); err == nil {
+		t.Fatal("expecting bucket not found")
+	}
+	if _, err := bp.CreateBucket(bck); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := bp.HeadBucket(ctx, bck); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range objs {
+		st, _, err := bp.putObj(ctx, bck.Bucket(), name, strings.NewReader("hello "+name), -1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if st.Length != int64(len("hello "+name)) || st.version() != "1000" {
+			t.Fatalf("%s: unexpected status %+v", name, st)
+		}
+	}
+
+	// list
+	var names []string
+	msg := &apc.LsoMsg{PageSize: 3}
+	for {
+		lst := &cmn.LsoRes{}
+		if _, err := bp.ListObjects(bck, msg, lst); err != nil {
+			t.Fatal(err)
+		}
+		for _, en := range lst.Entries {
+			names = append(names, en.Name)
+		}
+		if lst.ContinuationToken == "" {
+			break
+		}
+		msg.ContinuationToken = lst.ContinuationToken
+	}
+	if !slices.Equal(names, objs) {
+		t.Fatalf("expected %v, got %v", objs, names)
+	}
+	bcks, _, err := bp.ListBuckets(cmn.QueryBcks{Provider: apc.HDFS})
+	if err != nil || len(bcks) != 1 || bcks[0].Name != bck.Name {
+		t.Fatalf("expecting %s, got %v (%v)", bck, bcks, err)
+	}
+
+	// read: full and range
+	_, res := bp.getReader(ctx, bck.Bucket(), "a/d/e", 0, 0)
+	if res.Err != nil {
+		t.Fatal(res.Err)
+	}
+	b, _ := io.ReadAll(res.R)
+	res.R.Close()
+	if string(b) != "hello a/d/e" || res.Size != int64(len(b)) {
+		t.Fatalf("unexpected %q (size %d)", b, res.Size)
+	}
+	_, res = bp.getReader(ctx, bck.Bucket(), "a/d/e", 6, 3)
+	if res.Err != nil {
+		t.Fatal(res.Err)
+	}
+	b, _ = io.ReadAll(res.R)
+	res.R.Close()
+	if string(b) != "a/d" || res.Size != 3 {
+		t.Fatalf("range read: unexpected %q (size %d)", b, res.Size)
+	}
+	if _, res = bp.getReader(ctx, bck.Bucket(), "a/d/e", 6, 100); res.ErrCode != http.StatusRequestedRangeNotSatisfiable {
+		t.Fatalf("expecting %d, got %d (%v)", http.StatusRequestedRangeNotSatisfiable, res.ErrCode, res.Err)
+	}
+
+	// delete
+	if _, err := bp.deleteObj(ctx, bck.Bucket(), "b"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ecode, _ := bp.headObj(ctx, bck.Bucket(), "b"); ecode != http.StatusNotFound {
+		t.Fatalf("expecting %d, got %d", http.StatusNotFound, ecode)
+	}
+	if ecode, _ := bp.deleteObj(ctx, bck.Bucket(), "b"); ecode != http.StatusNotFound {
+		t.Fatalf("expecting %d, got %d", http.StatusNotFound, ecode)
+	}
+	if _, ecode, _ := bp.headObj(ctx, bck.Bucket(), "a"); ecode != http.StatusNotFound {
+		t.Fatalf("directory: expecting %d, got %d", http.StatusNotFound, ecode)
+	}
+}
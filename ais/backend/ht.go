@@ -24,9 +24,10 @@ import (
 
 type (
 	htbp struct {
-		t      core.TargetPut
-		cliH   *http.Client
-		cliTLS *http.Client
+		t         core.TargetPut
+		cliH      *http.Client
+		cliTLS    *http.Client
+		manifests htManifests // (see htlist)
 		base
 	}
 )
@@ -51,6 +52,18 @@ func (htbp *htbp) client(u string) *http.Client {
 	return htbp.cliH
 }
 
+func (htbp *htbp) do(ctx context.Context, method, u string, body io.Reader, size int64) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.ContentLength = size
+		req.Header.Set(cos.HdrContentType, cos.ContentBinary)
+	}
+	return htbp.client(u).Do(req)
+}
+
 func (htbp *htbp) HeadBucket(ctx context.Context, bck *meta.Bck) (cos.StrKVs, int, error) {
 	// TODO: we should use `bck.RemoteBck()`.
 
@@ -86,16 +99,6 @@ func (htbp *htbp) HeadBucket(ctx context.Context, bck *meta.Bck) (cos.StrKVs, in
 	return bckProps, 0, nil
 }
 
-func (*htbp) ListObjects(*meta.Bck, *apc.LsoMsg, *cmn.LsoRes) (ecode int, err error) {
-	debug.Assert(false)
-	return
-}
-
-func (*htbp) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, ecode int, err error) {
-	debug.Assert(false)
-	return
-}
-
 func getOriginalURL(ctx context.Context, bck *meta.Bck, objName string) (string, error) {
 	origURL, ok := ctx.Value(cos.CtxOriginalURL).(string)
 	if !ok || origURL == "" {
@@ -210,10 +213,64 @@ func (htbp *htbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, lengt
 	return res
 }
 
-func (*htbp) PutObj(context.Context, io.ReadCloser, *core.LOM, *http.Request) (int, error) {
-	return http.StatusBadRequest, cmn.NewErrUnsupp("PUT", " objects => HTTP backend")
+// PUT and DELETE require `extra.http.writable` (e.g., WebDAV-style servers)
+func htWritable(bck *meta.Bck) bool { return bck.Props != nil && bck.Props.Extra.HTTP.Writable }
+
+func (htbp *htbp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, _ *http.Request) (int, error) {
+	defer cos.Close(r)
+	bck := lom.Bck()
+	if !htWritable(bck) {
+		return http.StatusBadRequest, cmn.NewErrUnsupp("PUT", " objects => HTTP backend (extra.http.writable not set)")
+	}
+	origURL, err := getOriginalURL(ctx, bck, lom.ObjName)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	resp, err := htbp.do(ctx, http.MethodPut, origURL, r, lom.Lsize(true))
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+	default:
+		return resp.StatusCode, fmt.Errorf("PUT(%s) failed, status %d", origURL, resp.StatusCode)
+	}
+
+	lom.SetCustomKey(cmn.SourceObjMD, apc.HT)
+	lom.SetCustomKey(cmn.OrigURLObjMD, origURL)
+	if v, ok := cmn.BackendHelpers.HTTP.EncodeETag(resp.Header.Get(cos.HdrETag)); ok {
+		lom.SetCustomKey(cmn.ETag, v)
+	}
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infof("[put_object] %s => %q", lom, origURL)
+	}
+	return 0, nil
 }
 
-func (*htbp) DeleteObj(context.Context, *core.LOM) (int, error) {
-	return http.StatusBadRequest, cmn.NewErrUnsupp("DELETE", " objects from HTTP backend")
+func (htbp *htbp) DeleteObj(ctx context.Context, lom *core.LOM) (int, error) {
+	bck := lom.Bck()
+	if !htWritable(bck) {
+		return http.StatusBadRequest, cmn.NewErrUnsupp("DELETE", " objects from HTTP backend (extra.http.writable not set)")
+	}
+	origURL, err := getOriginalURL(ctx, bck, lom.ObjName)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	resp, err := htbp.do(ctx, http.MethodDelete, origURL, nil, 0)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+	case http.StatusNotFound:
+		return http.StatusNotFound, cos.NewErrNotFound(nil, lom.Cname())
+	default:
+		return resp.StatusCode, fmt.Errorf("DELETE(%s) failed, status %d", origURL, resp.StatusCode)
+	}
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infof("[delete_object] %s", lom)
+	}
+	return 0, nil
 }
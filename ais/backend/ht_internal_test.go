@@ -0,0 +1,193 @@
+//go:build ht
+
+// Package backend contains core/backend interface implementations for supported backend providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// static file server with nginx-style autoindex (including parent and sorting links)
+func newAutoindex(t *testing.T, files map[string]string) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p := strings.TrimPrefix(r.URL.Path, "/")
+		if body, ok := files[p]; ok {
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+			w.Header().Set("Content-Length", fmt.Sprint(len(body)))
+			if r.Method == http.MethodGet {
+				w.Write([]byte(body))
+			}
+			return
+		}
+		if !strings.HasSuffix(p, "/") && p != "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		seen := map[string]bool{}
+		links := []string{`<a href="../">../</a>`, `<a href="?C=N;O=D">Name</a>`, `<a href="http://example.com/x">ext</a>`}
+		for name := range files {
+			if !strings.HasPrefix(name, p) {
+				continue
+			}
+			rel := name[len(p):]
+			if i := strings.IndexByte(rel, '/'); i >= 0 {
+				rel = rel[:i+1]
+			}
+			if !seen[rel] {
+				seen[rel] = true
+				links = append(links, fmt.Sprintf(`<a href="%s"><img src="/icons/x.gif"></a> <A HREF='%s'>%s</A>`, rel, rel, rel))
+			}
+		}
+		if len(seen) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, "<html><body><pre>%s</pre></body></html>", strings.Join(links, "\n"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestHTListObjects(t *testing.T) {
+	var (
+		objs  = []string{"a/b", "a/d/e", "a-c", "b", "x/y/z"}
+		files = map[string]string{}
+	)
+	for _, name := range objs {
+		files["data/"+name] = "hello " + name
+	}
+	srv := newAutoindex(t, files)
+	files["data/"+cmn.HTManifestDflt] = "# comment\n\n" + srv.URL + "/data/x/y/z\nb\n/a/d/e\na-c\na/b\nhttp://example.com/data/skip\n"
+
+	bp := &htbp{base: base{provider: apc.HT}}
+	bp.cliH, bp.cliTLS = cmn.NewDefaultClients(time.Minute)
+
+	list := func(bck *meta.Bck, msg *apc.LsoMsg) (names []string) {
+		for {
+			lst := &cmn.LsoRes{}
+			if _, err := bp.ListObjects(bck, msg, lst); err != nil {
+				t.Fatal(err)
+			}
+			for _, en := range lst.Entries {
+				if en.Flags&apc.EntryIsDir == 0 && en.Name != cmn.HTManifestDflt && !msg.IsFlagSet(apc.LsNameOnly) && en.Size != int64(len("hello "+en.Name)) {
+					t.Fatalf("%s: unexpected size %d", en.Name, en.Size)
+				}
+				names = append(names, en.Name)
+			}
+			if lst.ContinuationToken == "" {
+				return names
+			}
+			msg.ContinuationToken = lst.ContinuationToken
+		}
+	}
+
+	for _, listing := range []string{cmn.HTListManifest, cmn.HTListAutoindex} {
+		bck := meta.NewBck("bck", apc.HT, cmn.NsGlobal)
+		bck.Props = &cmn.Bprops{Provider: apc.HT}
+		bck.Props.Extra.HTTP = cmn.ExtraPropsHTTP{OrigURLBck: srv.URL + "/data/", Listing: listing}
+
+		expected := objs
+		if listing == cmn.HTListAutoindex {
+			expected = append(slices.Clone(objs), cmn.HTManifestDflt)
+			slices.SortFunc(expected, dirCmp)
+		}
+		if names := list(bck, &apc.LsoMsg{PageSize: 2}); !slices.Equal(names, expected) {
+			t.Fatalf("%s: expected %v, got %v", listing, expected, names)
+		}
+		msg := &apc.LsoMsg{Prefix: "a/", PageSize: 1}
+		msg.SetFlag(apc.LsNameOnly)
+		if names := list(bck, msg); !slices.Equal(names, []string{"a/b", "a/d/e"}) {
+			t.Fatalf("%s: prefix: unexpected %v", listing, names)
+		}
+		if names := list(bck, &apc.LsoMsg{Prefix: "nonexistent/dir/"}); len(names) != 0 {
+			t.Fatalf("%s: nonexistent prefix: unexpected %v", listing, names)
+		}
+		msg = &apc.LsoMsg{}
+		msg.SetFlag(apc.LsNoRecursion)
+		names := list(bck, msg)
+		for i := range names {
+			names[i] = path.Clean(names[i])
+		}
+		if !slices.Contains(names, "a") || !slices.Contains(names, "b") || slices.Contains(names, "a/b") {
+			t.Fatalf("%s: non-recursive: unexpected %v", listing, names)
+		}
+	}
+
+	// not configured
+	bck := meta.NewBck("bck", apc.HT, cmn.NsGlobal)
+	bck.Props = &cmn.Bprops{Provider: apc.HT}
+	bck.Props.Extra.HTTP.OrigURLBck = srv.URL + "/data/"
+	if _, err := bp.ListObjects(bck, &apc.LsoMsg{}, &cmn.LsoRes{}); err == nil {
+		t.Fatal("expecting error when listing is not configured")
+	}
+}
+
+func TestHTManifestCache(t *testing.T) {
+	hk.Init(false)
+
+	var (
+		gets atomic.Int32
+		objs = []string{"a", "b", "c", "d", "e"}
+		srv  = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasSuffix(r.URL.Path, cmn.HTManifestDflt) {
+				gets.Add(1)
+				w.Write([]byte(strings.Join(objs, "\n")))
+			}
+		}))
+	)
+	t.Cleanup(srv.Close)
+
+	bp := &htbp{base: base{provider: apc.HT}}
+	bp.cliH, bp.cliTLS = cmn.NewDefaultClients(time.Minute)
+	bck := meta.NewBck("bck", apc.HT, cmn.NsGlobal)
+	bck.Props = &cmn.Bprops{Provider: apc.HT}
+	bck.Props.Extra.HTTP = cmn.ExtraPropsHTTP{OrigURLBck: srv.URL + "/", Listing: cmn.HTListManifest}
+
+	for i, uuid := range []string{"lso-1", "lso-2"} {
+		msg := &apc.LsoMsg{UUID: uuid, PageSize: 2}
+		msg.SetFlag(apc.LsNameOnly)
+		var pages int
+		for {
+			lst := &cmn.LsoRes{}
+			if _, err := bp.ListObjects(bck, msg, lst); err != nil {
+				t.Fatal(err)
+			}
+			pages++
+			if lst.ContinuationToken == "" {
+				break
+			}
+			msg.ContinuationToken = lst.ContinuationToken
+		}
+		if pages < 3 {
+			t.Fatalf("%s: expected multiple pages, got %d", uuid, pages)
+		}
+		if n := gets.Load(); n != int32(i+1) {
+			t.Fatalf("%s: expected the manifest downloaded once per listing, got %d total", uuid, n)
+		}
+	}
+	if n := len(bp.manifests.m); n != 0 {
+		t.Fatalf("expected no cached manifests upon completion, got %d", n)
+	}
+	bp.manifests.put("stale", map[string][]dirEnt{})
+	bp.manifests.housekeep(mono.NanoTime() + int64(htManifestTTL) + 1)
+	if n := len(bp.manifests.m); n != 0 {
+		t.Fatalf("expected expired manifest removed, got %d", n)
+	}
+}
@@ -0,0 +1,308 @@
+//go:build ht
+
+// Package backend contains core/backend interface implementations for supported backend providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// ht:// list-objects (optional, per bucket - see cmn.ExtraPropsHTTP)
+// - manifest:  newline-delimited list of URLs or object names (relative to the bucket's original URL);
+//              blank lines, '#' comments, and URLs outside the original URL are skipped
+// - autoindex: links parsed out of Apache/nginx-generated HTML directory indexes, recursively;
+//              a link ending with '/' is a subdirectory; parent, sorting, and external links are skipped
+// - both use dirWalk; size and last-modified come from HEAD (not needed with LsNameOnly)
+// - parsed manifest is kept for the duration of a given listing (list-objects UUID) - see htManifests
+
+const (
+	htMaxIndexSize = 64 * cos.MiB
+	htManifestTTL  = 2 * time.Minute // since last use (i.e., the previous page)
+)
+
+type (
+	htManifest struct {
+		tree  map[string][]dirEnt
+		atime int64 // mono
+	}
+	htManifests struct {
+		m    map[string]*htManifest // (bucket, listing UUID) => parsed manifest
+		mu   sync.Mutex
+		once sync.Once
+	}
+)
+
+var htHref = regexp.MustCompile(`(?i)<a\s[^>]*?href\s*=\s*["']([^"'#?]+)["']`)
+
+func (htbp *htbp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (int, error) {
+	if bck.Props == nil {
+		return http.StatusBadRequest, fmt.Errorf("%s: missing bucket properties", bck.Cname(""))
+	}
+	var (
+		ctx   = context.Background()
+		conf  = &bck.Props.Extra.HTTP
+		ecode int
+		w     = &dirWalk{msg: msg, lst: lst}
+	)
+	switch conf.Listing {
+	case cmn.HTListManifest:
+		var key string
+		if msg.UUID != "" {
+			key = string(bck.MakeUname(msg.UUID))
+		}
+		tree := htbp.manifests.get(key)
+		if tree == nil {
+			var (
+				code int
+				err  error
+			)
+			if tree, code, err = htbp.manifest(ctx, conf); err != nil {
+				return code, err
+			}
+			htbp.manifests.put(key, tree)
+		}
+		w.readDir = func(dir string) ([]dirEnt, error) { return tree[dir], nil }
+	case cmn.HTListAutoindex:
+		w.readDir = func(dir string) ([]dirEnt, error) {
+			ents, code, err := htbp.autoindex(ctx, htDirURL(conf.OrigURLBck, dir))
+			if err != nil {
+				if dir != "" && code == http.StatusNotFound {
+					return nil, nil // prefix that does not exist
+				}
+				ecode = code
+				return nil, err
+			}
+			return ents, nil
+		}
+	default:
+		return http.StatusNotImplemented, cmn.NewErrUnsupp("list", bck.Cname("")+" (extra.http.listing not configured)")
+	}
+	w.loadMD = func(name string, de *dirEnt) bool { return htbp.loadMD(ctx, cos.JoinPath(conf.OrigURLBck, name), de) }
+
+	msg.PageSize = calcPageSize(msg.PageSize, bck.MaxPageSize())
+	if err := w.do(); err != nil {
+		return ecode, err
+	}
+	if conf.Listing == cmn.HTListManifest && lst.ContinuationToken == "" && msg.UUID != "" {
+		htbp.manifests.del(string(bck.MakeUname(msg.UUID))) // listed all
+	}
+	if cmn.Rom.V(4, cos.ModBackend) {
+		nlog.Infof("[list_objects] %s (%s), count %d", bck.Cname(""), conf.Listing, len(lst.Entries))
+	}
+	return 0, nil
+}
+
+func (*htbp) ListBuckets(cmn.QueryBcks) (cmn.Bcks, int, error) {
+	return nil, http.StatusNotImplemented, cmn.NewErrUnsupp("list", "buckets => HTTP backend (use BMD)")
+}
+
+func htDirURL(base, dir string) string {
+	u := base
+	if dir != "" {
+		u = cos.JoinPath(base, dir)
+	}
+	if !cos.IsLastB(u, '/') {
+		u += "/"
+	}
+	return u
+}
+
+// GET and parse the manifest into a directory tree: dir => sorted entries
+func (htbp *htbp) manifest(ctx context.Context, conf *cmn.ExtraPropsHTTP) (map[string][]dirEnt, int, error) {
+	u := cos.JoinPath(conf.OrigURLBck, conf.ManifestName())
+	resp, err := htbp.do(ctx, http.MethodGet, u, nil, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, resp.StatusCode, fmt.Errorf("GET(%s) failed, status %d", u, resp.StatusCode)
+	}
+
+	var (
+		tree    = make(map[string][]dirEnt, 16)
+		seen    = make(map[string]bool, 64)
+		scanner = bufio.NewScanner(resp.Body)
+	)
+	scanner.Buffer(make([]byte, 0, 4*cos.KiB), 64*cos.KiB)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		name := line
+		if strings.Contains(line, "://") {
+			if !strings.HasPrefix(line, conf.OrigURLBck) {
+				continue // not in this bucket
+			}
+			name = strings.TrimPrefix(line, conf.OrigURLBck)
+		}
+		name = strings.TrimPrefix(name, "/")
+		if cos.ValidateOname(name) != nil || cos.IsLastB(name, '/') {
+			continue
+		}
+		// add the object along with its parent directories
+		for isDir := false; name != "."; isDir, name = true, path.Dir(name) {
+			key := name
+			if isDir {
+				key += "/"
+			}
+			if seen[key] {
+				break
+			}
+			seen[key] = true
+			dir := path.Dir(name)
+			if dir == "." {
+				dir = ""
+			}
+			tree[dir] = append(tree[dir], dirEnt{name: path.Base(name), isDir: isDir})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to read manifest %s: %w", u, err)
+	}
+	for _, ents := range tree {
+		slices.SortFunc(ents, func(a, b dirEnt) int { return strings.Compare(a.name, b.name) })
+	}
+	return tree, 0, nil
+}
+
+//
+// htManifests
+//
+
+func (hm *htManifests) get(key string) map[string][]dirEnt {
+	if key == "" {
+		return nil
+	}
+	hm.mu.Lock()
+	defer hm.mu.Unlock()
+	mf, ok := hm.m[key]
+	if !ok {
+		return nil
+	}
+	mf.atime = mono.NanoTime()
+	return mf.tree
+}
+
+func (hm *htManifests) put(key string, tree map[string][]dirEnt) {
+	if key == "" {
+		return
+	}
+	hm.once.Do(func() {
+		hk.Reg("ht-manifests"+hk.NameSuffix, hm.housekeep, htManifestTTL)
+	})
+	hm.mu.Lock()
+	if hm.m == nil {
+		hm.m = make(map[string]*htManifest, 4)
+	}
+	hm.m[key] = &htManifest{tree: tree, atime: mono.NanoTime()}
+	hm.mu.Unlock()
+}
+
+func (hm *htManifests) del(key string) {
+	hm.mu.Lock()
+	delete(hm.m, key)
+	hm.mu.Unlock()
+}
+
+func (hm *htManifests) housekeep(now int64) time.Duration {
+	hm.mu.Lock()
+	for key, mf := range hm.m {
+		if time.Duration(now-mf.atime) > htManifestTTL {
+			delete(hm.m, key)
+		}
+	}
+	hm.mu.Unlock()
+	return htManifestTTL
+}
+
+// GET and parse HTML directory index
+func (htbp *htbp) autoindex(ctx context.Context, u string) ([]dirEnt, int, error) {
+	durl, err := url.Parse(u)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	resp, err := htbp.do(ctx, http.MethodGet, u, nil, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, resp.StatusCode, fmt.Errorf("GET(%s) failed, status %d", u, resp.StatusCode)
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, htMaxIndexSize))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var (
+		ents []dirEnt
+		seen = make(map[string]bool, 64)
+	)
+	for _, m := range htHref.FindAllSubmatch(b, -1) {
+		ref, err := url.Parse(string(m[1]))
+		if err != nil {
+			continue
+		}
+		res := durl.ResolveReference(ref)
+		if res.Host != durl.Host || !strings.HasPrefix(res.Path, durl.Path) {
+			continue // parent or external
+		}
+		rel := res.Path[len(durl.Path):]
+		isDir := cos.IsLastB(rel, '/')
+		if isDir {
+			rel = rel[:len(rel)-1]
+		}
+		if rel == "" || strings.IndexByte(rel, '/') >= 0 || seen[rel] {
+			continue // self, not an immediate child, or duplicate (e.g., Apache icon link)
+		}
+		seen[rel] = true
+		ents = append(ents, dirEnt{name: rel, isDir: isDir})
+	}
+	slices.SortFunc(ents, func(a, b dirEnt) int { return strings.Compare(a.name, b.name) })
+	return ents, 0, nil
+}
+
+func (htbp *htbp) loadMD(ctx context.Context, u string, de *dirEnt) bool {
+	resp, err := htbp.do(ctx, http.MethodHead, u, nil, 0)
+	if err != nil {
+		nlog.Warningln("HEAD", u, "failed: [", err, "]")
+		return false
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode != http.StatusNotFound {
+			nlog.Warningln("HEAD", u, "failed, status", resp.StatusCode)
+		}
+		return false
+	}
+	de.size = max(resp.ContentLength, 0)
+	if t, err := http.ParseTime(resp.Header.Get(cos.HdrLastModified)); err == nil {
+		de.mtime = t
+	}
+	de.hasMD = true
+	return true
+}
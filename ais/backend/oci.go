@@ -28,6 +28,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -83,6 +84,16 @@ type mpChildIf interface {
 	Run()
 }
 
+// per-bucket API signing key of an OCI user (in the same tenancy, and so - the same namespace):
+// named backend secret (see secrets.go)
+type ociSecret struct {
+	TenancyOCID string `json:"tenancy_ocid"`
+	UserOCID    string `json:"user_ocid"`
+	Fingerprint string `json:"fingerprint"`
+	PrivateKey  string `json:"private_key"` // PEM
+	Passphrase  string `json:"passphrase,omitempty"`
+}
+
 type ocibp struct {
 	sync.Mutex            // serializes access to .mpChildPendingList & .mpChildActiveCount
 	t                     core.TargetPut
@@ -100,7 +111,7 @@ type ocibp struct {
 	mpThreadPoolSize      int64
 	mpChildPendingList    *list.List
 	mpChildActiveCount    int64
-	clients               sync.Map // map[string]*ocios.ObjectStorageClient, keyed by region
+	clients               sync.Map // map[string]*ocios.ObjectStorageClient, keyed by region[|secret@gen]
 	namespace             string
 	base
 }
@@ -145,6 +156,7 @@ func NewOCI(t core.TargetPut, tstats stats.Tracker, startingUp bool) (core.Backe
 	bp.namespace = *resp.Value
 
 	bp.base.init(t.Snode(), tstats, startingUp)
+	secrets.sub(apc.OCI, bp.dropClients)
 
 	return bp, nil
 }
@@ -459,29 +471,67 @@ func (bp *ocibp) regionForBck(bck *cmn.Bck) string {
 }
 
 func (bp *ocibp) ociClient(bck *cmn.Bck) (*ocios.ObjectStorageClient, string, error) {
-	region := bp.regionForBck(bck)
-	if v, ok := bp.clients.Load(region); ok {
+	var (
+		region = bp.regionForBck(bck)
+		key    = region
+		secret = ociSecretName(bck)
+		ocs    ociSecret
+	)
+	if secret != "" {
+		gen, err := secrets.unmarshal(secret, &ocs)
+		if err != nil {
+			return nil, region, fmt.Errorf("%s: %w", bck.Cname(""), err)
+		}
+		key = region + "|" + secretCid(secret, gen)
+	}
+	if v, ok := bp.clients.Load(key); ok {
 		return v.(*ocios.ObjectStorageClient), region, nil
 	}
 
 	bp.clientsMu.Lock()
 	defer bp.clientsMu.Unlock()
 
-	if v, ok := bp.clients.Load(region); ok {
+	if v, ok := bp.clients.Load(key); ok {
 		return v.(*ocios.ObjectStorageClient), region, nil
 	}
 
-	client, err := ocios.NewObjectStorageClientWithConfigurationProvider(bp.configurationProvider)
+	provider := bp.configurationProvider
+	if secret != "" {
+		var passphrase *string
+		if ocs.Passphrase != "" {
+			passphrase = &ocs.Passphrase
+		}
+		provider = ocicmn.NewRawConfigurationProvider(ocs.TenancyOCID, ocs.UserOCID, region, ocs.Fingerprint, ocs.PrivateKey, passphrase)
+	}
+	client, err := ocios.NewObjectStorageClientWithConfigurationProvider(provider)
 	if err != nil {
 		return nil, region, err
 	}
 	if region != "" {
 		client.SetRegion(region)
 	}
-	bp.clients.Store(region, &client)
+	bp.clients.Store(key, &client)
 	return &client, region, nil
 }
 
+func ociSecretName(bck *cmn.Bck) string {
+	if bck != nil && bck.Props != nil {
+		return bck.Props.Extra.OCI.Secret
+	}
+	return ""
+}
+
+// (secret changed)
+func (bp *ocibp) dropClients(secret string) {
+	s := "|" + secret + "@"
+	bp.clients.Range(func(k, _ any) bool {
+		if strings.Contains(k.(string), s) {
+			bp.clients.Delete(k)
+		}
+		return true
+	})
+}
+
 // as core.Backend --------------------------------------------------------------
 
 func (bp *ocibp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (int, error) {
@@ -749,7 +799,7 @@ func (bp *ocibp) HeadBucket(ctx context.Context, bck *meta.Bck) (cos.StrKVs, int
 	resp, err := client.HeadBucket(ctx, req)
 	if err != nil {
 		ecode, err := ociErrorToAISError("HeadBucket", cloudBck.Name, "", "", err, resp)
-		return nil, ecode, err
+		return nil, ecode, secretErr(cloudBck, ociSecretName(cloudBck), ecode, err)
 	}
 
 	bckProps := make(cos.StrKVs, 3)
@@ -0,0 +1,274 @@
+// Package backend contains core/backend interface implementations for supported backend providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/hk"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Per-bucket backend credentials
+//
+// Bucket props "extra.<provider>.secret" (aws, gcp, azure, oci) reference a named secret
+// that comes from the env.AisBackendSecrets location - either:
+// - a directory with one file per secret (e.g., Kubernetes secret mounted as a volume), or
+// - a single JSON file: {"<secret-name>": {<provider-specific content>}, ...}
+//
+// Secrets are loaded on demand and then checked for changes (see hk.SecretsIval);
+// a changed (rotated) secret gets a new generation, and each backend that caches clients
+// keyed by (secret, generation) drops the ones created with the previous generation.
+//
+// Provider-specific content: see awsSecret, gcpSess (service-account JSON),
+// azSecret, and ociSecret, respectively.
+
+type (
+	bsecret struct {
+		data  []byte
+		mtime int64 // (directory) secret file
+		size  int64
+		gen   int64
+	}
+	bsecrets struct {
+		m     map[string]*bsecret
+		subs  map[string]func(name string) // per provider: drop cached clients
+		src   string
+		mtime int64 // (single file) src
+		size  int64
+		gen   int64
+		mu    sync.RWMutex
+		once  sync.Once
+		file  bool
+	}
+)
+
+var secrets bsecrets
+
+func (bs *bsecrets) init() {
+	bs.src = os.Getenv(env.AisBackendSecrets)
+	bs.m = make(map[string]*bsecret, 4)
+	bs.subs = make(map[string]func(string), 4)
+	if bs.src == "" {
+		return
+	}
+	finfo, err := os.Stat(bs.src)
+	if err != nil {
+		nlog.Errorln("backend secrets:", err)
+		return
+	}
+	bs.file = !finfo.IsDir()
+	nlog.Infoln("backend secrets:", env.AisBackendSecrets, "=", bs.src)
+}
+
+// register provider's callback to drop clients created with a given (changed or removed) secret
+func (bs *bsecrets) sub(provider string, cb func(name string)) {
+	bs.once.Do(bs.init)
+	bs.mu.Lock()
+	bs.subs[provider] = cb
+	bs.mu.Unlock()
+}
+
+// returns secret's content and generation
+func (bs *bsecrets) get(name string) ([]byte, int64, error) {
+	bs.once.Do(bs.init)
+	bs.mu.RLock()
+	s, ok := bs.m[name]
+	bs.mu.RUnlock()
+	if ok {
+		return s.data, s.gen, nil
+	}
+
+	// slow path
+	if bs.src == "" {
+		return nil, 0, fmt.Errorf("backend secret %q: %s is not set", name, env.AisBackendSecrets)
+	}
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	if s, ok := bs.m[name]; ok {
+		return s.data, s.gen, nil
+	}
+	if bs.file {
+		if err := bs.loadFile(); err != nil {
+			return nil, 0, err
+		}
+		if s, ok := bs.m[name]; ok {
+			return s.data, s.gen, nil
+		}
+		return nil, 0, cos.NewErrNotFoundFmt(nil, "backend secret %q in %s", name, bs.src)
+	}
+	s, err := bs.loadDir(name)
+	if err != nil {
+		return nil, 0, err
+	}
+	bs.gen++
+	s.gen = bs.gen
+	bs.m[name] = s
+	return s.data, s.gen, nil
+}
+
+// unmarshal provider-specific secret
+func (bs *bsecrets) unmarshal(name string, v any) (int64, error) {
+	data, gen, err := bs.get(name)
+	if err != nil {
+		return 0, err
+	}
+	if err := jsoniter.Unmarshal(data, v); err != nil {
+		return 0, fmt.Errorf("backend secret %q: invalid content: %v", name, err)
+	}
+	return gen, nil
+}
+
+func (bs *bsecrets) loadDir(name string) (*bsecret, error) {
+	fqn := filepath.Join(bs.src, name)
+	finfo, err := os.Stat(fqn)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, cos.NewErrNotFoundFmt(nil, "backend secret %q in %s", name, bs.src)
+		}
+		return nil, err
+	}
+	data, err := os.ReadFile(fqn)
+	if err != nil {
+		return nil, err
+	}
+	return &bsecret{data: bytes.TrimSpace(data), mtime: finfo.ModTime().UnixNano(), size: finfo.Size()}, nil
+}
+
+// (re)load all secrets from a single file, and notify subscribers of the changed and removed ones
+// (under lock)
+func (bs *bsecrets) loadFile() error {
+	finfo, err := os.Stat(bs.src)
+	if err != nil {
+		return err
+	}
+	mtime, size := finfo.ModTime().UnixNano(), finfo.Size()
+	if mtime == bs.mtime && size == bs.size {
+		return nil
+	}
+	data, err := os.ReadFile(bs.src)
+	if err != nil {
+		return err
+	}
+	all := make(map[string]jsoniter.RawMessage, 4)
+	if err := jsoniter.Unmarshal(data, &all); err != nil {
+		return fmt.Errorf("backend secrets %s: invalid content: %v", bs.src, err)
+	}
+	for name, s := range bs.m {
+		raw, ok := all[name]
+		switch {
+		case !ok:
+			delete(bs.m, name)
+			bs.notify(name)
+		case !bytes.Equal(raw, s.data):
+			bs.gen++
+			bs.m[name] = &bsecret{data: raw, gen: bs.gen}
+			bs.notify(name)
+		}
+	}
+	for name, raw := range all {
+		if _, ok := bs.m[name]; !ok {
+			bs.gen++
+			bs.m[name] = &bsecret{data: raw, gen: bs.gen}
+		}
+	}
+	bs.mtime, bs.size = mtime, size
+	return nil
+}
+
+func (bs *bsecrets) notify(name string) {
+	nlog.Infoln("backend secret", name, "changed")
+	for _, cb := range bs.subs {
+		cb(name)
+	}
+}
+
+// check loaded secrets for changes
+func (bs *bsecrets) refresh() {
+	bs.once.Do(bs.init)
+	if bs.src == "" {
+		return
+	}
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	if len(bs.m) == 0 && bs.mtime == 0 {
+		return
+	}
+	if bs.file {
+		if err := bs.loadFile(); err != nil {
+			nlog.Errorln("failed to reload backend secrets:", err)
+		}
+		return
+	}
+	for name, s := range bs.m {
+		finfo, err := os.Stat(filepath.Join(bs.src, name))
+		if err != nil {
+			if os.IsNotExist(err) {
+				delete(bs.m, name)
+				bs.notify(name)
+			}
+			continue
+		}
+		if finfo.ModTime().UnixNano() == s.mtime && finfo.Size() == s.size {
+			continue
+		}
+		ns, err := bs.loadDir(name)
+		if err != nil {
+			nlog.Errorln("failed to reload backend secret", name, "[", err, "]")
+			continue
+		}
+		if !bytes.Equal(ns.data, s.data) {
+			bs.gen++
+			ns.gen = bs.gen
+			bs.m[name] = ns
+			bs.notify(name)
+		} else {
+			s.mtime, s.size = ns.mtime, ns.size
+		}
+	}
+}
+
+// housekeeping callback
+func RefreshSecrets(int64) time.Duration {
+	secrets.refresh()
+	return hk.SecretsIval
+}
+
+// upon ActReloadBackendCreds: forget all loaded secrets
+func ReloadSecrets() {
+	secrets.once.Do(secrets.init)
+	secrets.mu.Lock()
+	for name := range secrets.m {
+		delete(secrets.m, name)
+		secrets.notify(name)
+	}
+	secrets.mtime, secrets.size = 0, 0
+	secrets.mu.Unlock()
+}
+
+// client cache key
+func secretCid(name string, gen int64) string {
+	return name + "@" + strconv.FormatInt(gen, 10)
+}
+
+// HEAD(bucket) et al. with per-bucket secret: make the failure to authenticate (or authorize) explicit
+func secretErr(bck *cmn.Bck, name string, ecode int, err error) error {
+	if name == "" || (ecode != http.StatusUnauthorized && ecode != http.StatusForbidden) {
+		return err
+	}
+	return fmt.Errorf("%s: access denied using backend secret %q: %w", bck.Cname(""), name, err)
+}
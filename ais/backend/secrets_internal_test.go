@@ -0,0 +1,97 @@
+// Package backend contains core/backend interface implementations for supported backend providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+type testSecret struct {
+	Key string `json:"key"`
+}
+
+func TestSecretsDir(t *testing.T) {
+	var (
+		dir     = t.TempDir()
+		fqn     = filepath.Join(dir, "team-a")
+		bs      = &bsecrets{}
+		dropped []string
+		sec     testSecret
+	)
+	t.Setenv(env.AisBackendSecrets, dir)
+	bs.sub("test", func(name string) { dropped = append(dropped, name) })
+
+	tassert.CheckFatal(t, os.WriteFile(fqn, []byte(`{"key": "v1"}`), 0o600))
+	gen, err := bs.unmarshal("team-a", &sec)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, sec.Key == "v1", "expecting v1, got %q", sec.Key)
+
+	_, _, err = bs.get("team-b")
+	tassert.Errorf(t, cos.IsNotExist(err), "expecting not-found, got %v", err)
+
+	// no changes
+	bs.refresh()
+	_, gen2, err := bs.get("team-a")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, gen2 == gen && len(dropped) == 0, "unexpected change: gen %d => %d, dropped %v", gen, gen2, dropped)
+
+	// rotate
+	tassert.CheckFatal(t, os.WriteFile(fqn, []byte(`{"key": "v2-rotated"}`), 0o600))
+	mtime := time.Now().Add(time.Second)
+	tassert.CheckFatal(t, os.Chtimes(fqn, mtime, mtime))
+	bs.refresh()
+	gen2, err = bs.unmarshal("team-a", &sec)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, sec.Key == "v2-rotated", "expecting rotated secret, got %q", sec.Key)
+	tassert.Errorf(t, gen2 > gen, "expecting new generation")
+	tassert.Errorf(t, len(dropped) == 1 && dropped[0] == "team-a", "expecting team-a dropped, got %v", dropped)
+
+	// remove
+	tassert.CheckFatal(t, os.Remove(fqn))
+	bs.refresh()
+	_, _, err = bs.get("team-a")
+	tassert.Errorf(t, cos.IsNotExist(err), "expecting not-found, got %v", err)
+	tassert.Errorf(t, len(dropped) == 2, "expecting team-a dropped again, got %v", dropped)
+}
+
+func TestSecretsFile(t *testing.T) {
+	var (
+		fqn     = filepath.Join(t.TempDir(), "secrets.json")
+		bs      = &bsecrets{}
+		dropped []string
+		sec     testSecret
+	)
+	tassert.CheckFatal(t, os.WriteFile(fqn, []byte(`{"a": {"key": "a1"}, "b": {"key": "b1"}}`), 0o600))
+	t.Setenv(env.AisBackendSecrets, fqn)
+	bs.sub("test", func(name string) { dropped = append(dropped, name) })
+
+	genA, err := bs.unmarshal("a", &sec)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, sec.Key == "a1", "expecting a1, got %q", sec.Key)
+	genB, err := bs.unmarshal("b", &sec)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, sec.Key == "b1", "expecting b1, got %q", sec.Key)
+
+	// rotate "b" only
+	tassert.CheckFatal(t, os.WriteFile(fqn, []byte(`{"a": {"key": "a1"}, "b": {"key": "b2"}}`), 0o600))
+	mtime := time.Now().Add(time.Second)
+	tassert.CheckFatal(t, os.Chtimes(fqn, mtime, mtime))
+	bs.refresh()
+
+	gen, err := bs.unmarshal("a", &sec)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, gen == genA, "a: unexpected new generation")
+	gen, err = bs.unmarshal("b", &sec)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, sec.Key == "b2" && gen > genB, "b: expecting rotated secret, got %q (gen %d => %d)", sec.Key, genB, gen)
+	tassert.Errorf(t, len(dropped) == 1 && dropped[0] == "b", "expecting b dropped, got %v", dropped)
+}
@@ -533,6 +533,8 @@ func (*bckPropsArgs) merge(props *cmn.Bprops, header http.Header) *cmn.Bprops {
 		props.Extra.AWS.Profile = header.Get(apc.HdrS3Profile)
 	case apc.OCI:
 		props.Extra.OCI.Region = header.Get(apc.HdrOCIRegion)
+	case apc.Azure:
+		props.Extra.Azure.HNS = cos.IsParseBool(header.Get(apc.HdrAzureHNS))
 	case apc.HT:
 		props.Extra.HTTP.OrigURLBck = header.Get(apc.HdrOrigURLBck)
 	}
@@ -246,6 +246,7 @@ func (p *proxy) initRecvHandlers() {
 		networkHandler{r: apc.Objects, h: p.objectHandler, net: accessNetPublic},
 		networkHandler{r: apc.Download, h: p.dloadHandler, net: accessNetPublic},
 		networkHandler{r: apc.ETL, h: p.etlHandler, net: accessNetPublic},
+		networkHandler{r: apc.Events, h: p.eventsHandler, net: accessNetPublic},
 
 		networkHandler{r: apc.IC, h: p.ic.handler, net: accessNetIntraControl},
 		networkHandler{r: apc.Tokens, h: p.tokenHandler, net: accessNetPublic},
@@ -436,6 +437,7 @@ func (p *proxy) _parseReqTry(w http.ResponseWriter, r *http.Request, bckArgs *bc
 		return
 	}
 	bckArgs.bck, bckArgs.query = apireq.bck, apireq.query
+	bckArgs.objName = apireq.items[1]
 	bck, err = bckArgs.initAndTry()
 	objName = apireq.items[1]
 
@@ -681,6 +683,7 @@ func (p *proxy) bgetSumm(w http.ResponseWriter, r *http.Request, qbck *cmn.Query
 			bckArgs.r = r
 			bckArgs.msg = msg
 			bckArgs.perms = apc.AceBckHEAD
+			bckArgs.objName, bckArgs.isPrefix = summMsg.Prefix, true
 			bckArgs.bck = bck
 			bckArgs.dpq = dpq
 			bckArgs.createAIS = false
@@ -722,6 +725,7 @@ func (p *proxy) bgetShardSumm(w http.ResponseWriter, r *http.Request, qbck *cmn.
 		bckArgs.r = r
 		bckArgs.msg = msg
 		bckArgs.perms = apc.AceObjLIST | apc.AceBckHEAD
+		bckArgs.objName, bckArgs.isPrefix = summMsg.Prefix, true
 		bckArgs.bck = bck
 		bckArgs.dpq = dpq
 		bckArgs.createAIS = false
@@ -788,6 +792,7 @@ func (p *proxy) bgetObjects(w http.ResponseWriter, r *http.Request, qbck *cmn.Qu
 		bckArgs.r = r
 		bckArgs.msg = msg
 		bckArgs.perms = apc.AceObjLIST
+		bckArgs.objName, bckArgs.isPrefix = lsmsg.Prefix, true
 		bckArgs.bck = bck
 		bckArgs.dpq = dpq
 		bckArgs.createAIS = false
@@ -827,6 +832,7 @@ func (p *proxy) httpobjget(w http.ResponseWriter, r *http.Request, origURLBck ..
 		bckArgs.bck = apireq.bck
 		bckArgs.dpq = apireq.dpq
 		bckArgs.perms = apc.AceGET
+		bckArgs.objName = apireq.items[1]
 		bckArgs.createAIS = false
 	}
 	if len(origURLBck) > 0 {
@@ -919,6 +925,7 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 		bckArgs.w = w
 		bckArgs.r = r
 		bckArgs.perms = perms
+		bckArgs.objName = apireq.items[1]
 		bckArgs.createAIS = false
 	}
 	bckArgs.bck, bckArgs.dpq = apireq.bck, apireq.dpq
@@ -928,6 +935,10 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 		return
 	}
 	vlabs[stats.VlabBucket] = bck.Cname("")
+	if err := p.checkReplSrc(r, bck); err != nil {
+		p.writeErr(w, r, err, http.StatusForbidden)
+		return
+	}
 
 	// 3. rate limit
 	smap := p.owner.smap.get()
@@ -997,6 +1008,10 @@ func (p *proxy) httpobjdelete(w http.ResponseWriter, r *http.Request) {
 		p.writeErr(w, r, err)
 		return
 	}
+	if err := p.checkReplSrc(r, bck); err != nil {
+		p.writeErr(w, r, err, http.StatusForbidden)
+		return
+	}
 
 	// rate limit
 	smap := p.owner.smap.get()
@@ -1056,6 +1071,15 @@ func (p *proxy) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *ap
 		bckArgs.query = apireq.query
 		bckArgs.createAIS = false
 	}
+	if delObjs && msg.Value != nil {
+		lr := &apc.ListRange{}
+		if err := cos.MorphMarshal(msg.Value, lr); err != nil {
+			freeBctx(bckArgs)
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		bckArgs.setScope(lr)
+	}
 	if msg.Action == apc.ActEvictRemoteBck {
 		bckArgs.dontHeadRemote = true // unconditionally
 		ecode, e = bckArgs.init()
@@ -1434,7 +1458,7 @@ func (p *proxy) httpbckput(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// +gen:endpoint POST /v1/buckets/{bucket-name}[apc.QparamProvider=string,apc.QparamNamespace=string,apc.QparamBckTo=string,apc.QparamDontHeadRemote=bool] action=[apc.ActCreateBck=cmn.BpropsToSet|apc.ActMoveBck=apc.ActMsg|apc.ActCopyBck=apc.TCBMsg|apc.ActETLBck=apc.TCBMsg|apc.ActCopyObjects=cmn.TCOMsg|apc.ActETLObjects=cmn.TCOMsg|apc.ActPrefetchObjects=apc.PrefetchMsg|apc.ActMakeNCopies=int|apc.ActECEncode=cmn.ECConfToSet|apc.ActRechunk=apc.RechunkMsg|apc.ActCreateNBI=apc.CreateNBIMsg]
+// +gen:endpoint POST /v1/buckets/{bucket-name}[apc.QparamProvider=string,apc.QparamNamespace=string,apc.QparamBckTo=string,apc.QparamDontHeadRemote=bool] action=[apc.ActCreateBck=cmn.BpropsToSet|apc.ActMoveBck=apc.ActMsg|apc.ActCopyBck=apc.TCBMsg|apc.ActETLBck=apc.TCBMsg|apc.ActCopyObjects=cmn.TCOMsg|apc.ActETLObjects=cmn.TCOMsg|apc.ActPrefetchObjects=apc.PrefetchMsg|apc.ActMakeNCopies=int|apc.ActECEncode=cmn.ECConfToSet|apc.ActRechunk=apc.RechunkMsg|apc.ActCreateNBI=apc.CreateNBIMsg|apc.ActSyncBck=apc.SyncBckMsg]
 // +gen:payload apc.ActCopyBck={"action": "copy-bck", "value": {"prefix": "images/", "prepend": "backup/", "latest-ver": true, "num-workers": 8}}
 // +gen:payload apc.ActETLBck={"action": "etl-bck", "value": {"id": "ETL_NAME", "prefix": "images/", "num-workers": 8}}
 // +gen:payload apc.ActCopyObjects={"action": "copy-objects", "value": {"tobck": {"name": "destination-bucket", "provider": "ais"}, "template": "shard-{001..100}.tar"}}
@@ -1445,6 +1469,7 @@ func (p *proxy) httpbckput(w http.ResponseWriter, r *http.Request) {
 // +gen:payload apc.ActCreateBck={"action": "create-bck", "value": {"versioning": {"enabled": true}, "mirror": {"enabled": true, "copies": 2}}}
 // +gen:payload apc.ActRechunk={"action": "rechunk", "value": {"chunk-size": 4194304, "objsize-limit": 1048576}}
 // +gen:payload apc.ActCreateNBI={"action": "create-inventory", "value": {"name": "my-inventory"}}
+// +gen:payload apc.ActSyncBck={"action": "sync-bck", "value": {"prefix": "images/", "dry_run": true, "delete": true}}
 // +gen:name apc.ActECEncode="Set to \"recover\" to validate and rebuild missing or corrupted EC slices"
 // +gen:value apc.ActMakeNCopies="Target n-way replication level: total number of copies to maintain for each object in the bucket"
 // Create, rename, copy, transform, or manage a bucket
@@ -1539,6 +1564,10 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErrf(w, r, "cannot rename bucket %q to itself (%q)", bckFrom.Cname(""), bckTo.Cname(""))
 			return
 		}
+		if bckFrom.Props.ObjLock.Enabled {
+			p.writeErrf(w, r, "cannot rename bucket %q with object lock enabled", bckFrom.Cname(""))
+			return
+		}
 		bckFrom.Provider, bckTo.Provider = apc.AIS, apc.AIS
 		if _, present := p.owner.bmd.get().Get(bckTo); present {
 			err := cmn.NewErrBckAlreadyExists(bckTo.Bucket())
@@ -1674,7 +1703,8 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			eq = true
 			nlog.Warningf("multi-object operation %q within the same bucket %q", msg.Action, bck)
 		}
-		if bckTo.IsHT() {
+		// (when !eq, initBckTo checks whether HTTP destination is writeable)
+		if eq && bck.IsHT() && !bck.Props.Extra.HTTP.Writable {
 			p.writeErrf(w, r, "cannot %s to HTTP bucket %q", msg.Action, bckTo.Cname(""))
 			return
 		}
@@ -1719,6 +1749,41 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErr(w, r, err)
 			return
 		}
+	case apc.ActSyncBck:
+		var (
+			bckFrom = bck
+			bckTo   *meta.Bck
+			syncmsg = &apc.SyncBckMsg{}
+			ecode   int
+		)
+		if err := cos.MorphMarshal(msg.Value, syncmsg); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		syncmsg.Prefix = cos.TrimPrefix(syncmsg.Prefix)
+		msg.Value = syncmsg
+		bckTo, err = newBckFromQuname(query, true /*required*/)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if bckFrom.Equal(bckTo, false /*same BID*/, true) {
+			p.writeErrf(w, r, "cannot %s bucket %q onto itself", msg.Action, bckFrom.Cname(""))
+			return
+		}
+		// (remote destination gets added to BMD on the fly)
+		if bckTo, ecode, err = p.initBckTo(w, r, query, bckTo); err != nil {
+			return
+		}
+		if ecode == http.StatusNotFound {
+			p.writeErr(w, r, cmn.NewErrBckNotFound(bckTo.Bucket()), ecode)
+			return
+		}
+		nlog.Infoln("x-sync-bck:", bckFrom.String(), "=>", bckTo.String(), "[", syncmsg.Prefix, syncmsg.DryRun, syncmsg.Delete, "]")
+		if xid, err = p.bcastBckAction(r.Method, bucket, msg, query); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 	case apc.ActIndexShard:
 		// ensure the system bucket for shard indices exists before starting the xaction
 		if err = p.initTrySysBck(w, r, msg, meta.SysBckShardIdx()); err != nil {
@@ -2009,7 +2074,9 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 		// for actions that either don't support remote buckets, or don't require that the target remote bucket exists in the cluster,
 		// set dontHeadRemote to skip adding remote bucket.
 		switch msg.Action {
-		case apc.ActRenameObject, apc.ActCheckLock:
+		case apc.ActRenameObject:
+			bckArgs.dontHeadRemote = bck.Provider != apc.Azure // (server-side rename below)
+		case apc.ActCheckLock:
 			bckArgs.dontHeadRemote = true
 		}
 	}
@@ -2022,7 +2089,7 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 	// action
 	switch msg.Action {
 	case apc.ActRenameObject:
-		if err := p.checkAccess(w, r, bck, apc.AceObjMOVE); err != nil {
+		if err := p.checkAccessObj(w, r, bck, apireq.items[1], apc.AceObjMOVE); err != nil {
 			p.statsT.IncBck(stats.ErrRenameCount, bck.Bucket())
 			return
 		}
@@ -2070,7 +2137,7 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 			writeXid(w, xid)
 		}
 	case apc.ActBlobDl:
-		if err := p.checkAccess(w, r, bck, apc.AccessRW); err != nil {
+		if err := p.checkAccessObj(w, r, bck, msg.Name, apc.AccessRW); err != nil {
 			return
 		}
 		if err := cmn.ValidateRemoteBck(apc.ActBlobDl, bck.Bucket()); err != nil {
@@ -2080,12 +2147,12 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 		objName := msg.Name
 		p.redirectAction(w, r, bck, objName, msg)
 	case apc.ActMptUpload, apc.ActMptAbort, apc.ActMptComplete:
-		if err := p.checkAccess(w, r, bck, apc.AccessRW); err != nil {
+		if err := p.checkAccessObj(w, r, bck, apireq.items[1], apc.AccessRW); err != nil {
 			return
 		}
 		p.redirectAction(w, r, bck, apireq.items[1], msg)
 	case apc.ActCheckLock:
-		if err := p.checkAccess(w, r, bck, apc.AccessRO); err != nil {
+		if err := p.checkAccessObj(w, r, bck, apireq.items[1], apc.AccessRO); err != nil {
 			return
 		}
 		p.redirectAction(w, r, bck, apireq.items[1], msg)
@@ -2095,7 +2162,8 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 }
 
 func _checkObjMv(bck *meta.Bck, msg *apc.ActMsg, apireq *apiRequest) error {
-	if bck.IsRemote() {
+	// remote: server-side rename in Azure storage accounts with hierarchical namespace (ADLS Gen2)
+	if bck.IsRemote() && bck.RemoteBck().Provider != apc.Azure {
 		err := fmt.Errorf("invalid action %q: not supported for remote buckets (%s)", msg.Action, bck.String())
 		return cmn.NewErrUnsuppErr(err)
 	}
@@ -2331,6 +2399,28 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 			return
 		}
 	}
+	if nprops.Replication.Enabled {
+		// replication destination (remote AIS bucket) must exist, must be added to BMD
+		dstBck := meta.CloneBck(&nprops.Replication.Dst)
+		dstBck.Props = nil
+
+		bckArgs := allocBctx()
+		{
+			bckArgs.p = p
+			bckArgs.w = w
+			bckArgs.r = r
+			bckArgs.bck = dstBck
+			bckArgs.msg = msg
+			bckArgs.dpq = apireq.dpq
+			bckArgs.query = apireq.query
+			bckArgs.createAIS = false
+		}
+		_, err := bckArgs.initAndTry()
+		freeBctx(bckArgs)
+		if err != nil {
+			return
+		}
+	}
 	if xid, err = p.setBprops(msg, bck, nprops); err != nil {
 		p.writeErr(w, r, err)
 		return
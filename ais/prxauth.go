@@ -371,9 +371,36 @@ func (p *proxy) extractAndValidate(ctx context.Context, hdr http.Header) (*tok.A
 	return claims, nil
 }
 
+// Replicated PUT or DELETE (apc.HdrReplSrc) is not replicated back (see tgtrepl) - trusted only
+// when coming from another node in this cluster, from an authenticated user, or - with AuthN
+// disabled - on behalf of the peer cluster (the bucket's replication destination)
+func (p *proxy) checkReplSrc(r *http.Request, bck *meta.Bck) error {
+	src := r.Header.Get(apc.HdrReplSrc)
+	if src == "" || !bck.Props.Replication.Enabled {
+		return nil
+	}
+	if p.checkIntraCall(r, false /*from primary*/) == nil {
+		return nil
+	}
+	if cmn.Rom.AuthEnabled() {
+		if _, err := p.validateToken(r.Context(), r.Header); err != nil {
+			return fmt.Errorf("%s: unauthenticated replication request (%q): %w", p, src, err)
+		}
+		return nil
+	}
+	if peer := bck.Props.Replication.Dst.Ns.UUID; src != p.a2u(peer) {
+		return fmt.Errorf("%s: %s replicates to %q - not accepting replication from %q", p, bck.Cname(""), peer, src)
+	}
+	return nil
+}
+
 // Wraps the access check with an HTTP error message
-func (p *proxy) checkAccess(w http.ResponseWriter, r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
-	if err = p.access(r, bck, ace); err != nil {
+func (p *proxy) checkAccess(w http.ResponseWriter, r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) error {
+	return p.checkAccessObj(w, r, bck, "" /*objName*/, ace)
+}
+
+func (p *proxy) checkAccessObj(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, ace apc.AccessAttrs) (err error) {
+	if err = p.accessObj(r, bck, objName, ace); err != nil {
 		// Use writeErrMsg (with the combined message from wrapped errors) instead of writeErr
 		// aceErrToCode parses code from the type so additional status code parsing is not necessary
 		p.writeErrMsg(w, r, err.Error(), aceErrToCode(err))
@@ -406,7 +433,23 @@ func aceErrToCode(err error) (status int) {
 //	Exceptions:
 //	- read-only access to a bucket is always granted
 //	- PATCH cannot be forbidden
-func (p *proxy) access(r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
+//
+// In both cases, bucket policy (if any) is evaluated first - see `accessPolicy` below.
+func (p *proxy) access(r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) error {
+	return p.accessObj(r, bck, "" /*objName*/, ace)
+}
+
+// same as above with object name, if known (to evaluate object-scoped bucket policy)
+func (p *proxy) accessObj(r *http.Request, bck *meta.Bck, objName string, ace apc.AccessAttrs) error {
+	return p._access(r, bck, objName, false /*is prefix*/, ace)
+}
+
+// same as above for list and multi-object operations on objects with a given prefix
+func (p *proxy) accessPrefix(r *http.Request, bck *meta.Bck, prefix string, ace apc.AccessAttrs) error {
+	return p._access(r, bck, prefix, true, ace)
+}
+
+func (p *proxy) _access(r *http.Request, bck *meta.Bck, name string, isPrefix bool, ace apc.AccessAttrs) (err error) {
 	var (
 		hdr     = r.Header
 		sid     = hdr.Get(apc.HdrSenderID)
@@ -425,6 +468,13 @@ func (p *proxy) access(r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) (err
 		}
 	}
 
+	// bucket policy: explicit deny, or allow that bypasses the checks below
+	if bck != nil && bck.Props != nil && !bck.Props.Policy.IsEmpty() && !bck.Bucket().IsSystem() {
+		if decided, err := p.accessPolicy(r, bck, name, isPrefix, ace); decided {
+			return err
+		}
+	}
+
 	// If auth is NOT enabled, only check bucket properties
 	if !cmn.Rom.AuthEnabled() {
 		if bck == nil || bck.Props == nil {
@@ -460,6 +510,37 @@ func (p *proxy) access(r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) (err
 	return p.checkTokenAccess(claims, bck, ace)
 }
 
+// evaluate bucket policy (see cmn/policy.go) for a given requester:
+// - AuthN user (token subject) when AuthN is enabled and the token is valid
+// - anonymous otherwise
+func (p *proxy) accessPolicy(r *http.Request, bck *meta.Bck, name string, isPrefix bool, ace apc.AccessAttrs) (decided bool, err error) {
+	var principal string
+	if cmn.Rom.AuthEnabled() {
+		if claims, err := p.validateToken(r.Context(), r.Header); err == nil {
+			principal, _ = claims.GetSubject()
+		}
+	}
+	var allowed, denied bool
+	if isPrefix {
+		allowed, denied = bck.Props.Policy.EvaluatePrefix(principal, name, ace)
+	} else {
+		allowed, denied = bck.Props.Policy.Evaluate(principal, name, ace)
+	}
+	switch {
+	case denied:
+		err = cmn.NewBucketAccessDenied(bck.String(), apc.AccessOp(ace)+" (bucket policy)", bck.Props.Access)
+		nlog.Warningln("bucket policy:", err)
+		p.statsT.Inc(stats.ACLDeniedCount)
+		return true, err
+	case allowed:
+		if cmn.Rom.V(5, cos.ModAIS) {
+			nlog.Infoln("bucket policy: allow", apc.AccessOp(ace), bck.Cname(name), "principal:", principal)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
 func (p *proxy) checkTokenAccess(claims *tok.AISClaims, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
 	if bck == nil {
 		err = p.checkClaimPermissions(claims, nil, ace)
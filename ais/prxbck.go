@@ -40,6 +40,11 @@ type bctx struct {
 	reqBody []byte          // request body of original request
 	perms   apc.AccessAttrs // apc.AceGET, apc.AcePATCH etc.
 
+	// object name or, for list and multi-object operations, prefix
+	// (to evaluate object-scoped bucket policy - see cmn/policy)
+	objName  string
+	isPrefix bool
+
 	// 5 user or caller-provided control flags followed by
 	// 3 result flags
 	skipBackend     bool // initialize bucket via `bck.InitNoBackend`
@@ -185,6 +190,11 @@ func (bctx *bctx) init() (int, error) {
 
 	bctx.isPresent = true
 
+	// HTTP buckets are read-only unless explicitly configured (see `extra.http.writable`)
+	if bck.IsHT() && bctx._perm(apc.AcePUT) && !bck.Props.Extra.HTTP.Writable {
+		return http.StatusMethodNotAllowed, cmn.NewErrUnsupp("write to HTTP bucket", bck.Cname(""))
+	}
+
 	// if permissions are not explicitly specified check the default (msg.Action => permissions)
 	if bctx.perms == 0 && bctx.msg != nil {
 		dtor, ok := xact.Table[bctx.msg.Action]
@@ -210,11 +220,6 @@ func (bctx *bctx) accessSupported() error {
 		op = "rename/move remote bucket"
 		goto rerr
 	}
-	// HTTP buckets are not writeable
-	if bctx.bck.IsHT() && bctx._perm(apc.AcePUT) {
-		op = "write to HTTP bucket"
-		goto rerr
-	}
 	// Cloud bucket: destroy op. not allowed, and not supported yet
 	// (have no separate perm for eviction, that's why an extra check)
 	if rmb := bctx.bck.IsCloud() && bctx._perm(apc.AceDestroyBucket) && bctx.msg.Action == apc.ActDestroyBck; !rmb {
@@ -227,11 +232,40 @@ rerr:
 
 // (compare w/ accessSupported)
 func (bctx *bctx) accessAllowed(bck *meta.Bck) (ecode int, err error) {
-	err = bctx.p.access(bctx.r, bck, bctx.perms)
+	if bctx.isPrefix {
+		err = bctx.p.accessPrefix(bctx.r, bck, bctx.objName, bctx.perms)
+	} else {
+		err = bctx.p.accessObj(bctx.r, bck, bctx.objName, bctx.perms)
+	}
 	ecode = aceErrToCode(err)
 	return ecode, err
 }
 
+// multi-object operation on a single named object or else on all objects
+// with the (longest) common prefix of the list (or template)
+func (bctx *bctx) setScope(lr *apc.ListRange) {
+	switch {
+	case len(lr.ObjNames) == 1:
+		bctx.objName = lr.ObjNames[0]
+	case lr.IsList():
+		prefix := lr.ObjNames[0]
+		for _, name := range lr.ObjNames[1:] {
+			i := 0
+			for i < len(prefix) && i < len(name) && prefix[i] == name[i] {
+				i++
+			}
+			prefix = prefix[:i]
+		}
+		bctx.objName, bctx.isPrefix = prefix, true
+	default:
+		pt, err := cos.NewParsedTemplate(lr.Template)
+		if err != nil {
+			pt.Prefix = "" // (all objects)
+		}
+		bctx.objName, bctx.isPrefix = pt.Prefix, true
+	}
+}
+
 // initAndTry initializes the bucket (proxy-only, as the filename implies).
 // The method _may_:
 // - try to add remote bucket to BMD if it doesn't exist (grep "on-the-fly")
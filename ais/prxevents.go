@@ -0,0 +1,217 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Change notifications (webhook) => remote listing cache invalidation
+// (see cmn/lsocache and xact/xs/lsocache)
+//
+// POST /v1/events accepts:
+// - S3 event notifications: {"Records": [{"s3": {"bucket": {"name": ...}, "object": {"key": ...}}}, ...]},
+//   either directly or wrapped in SNS HTTP(S) message: {"Type": "Notification", "Message": "<S3 event>"};
+// - GCS Pub/Sub push messages: {"message": {"attributes": {"bucketId": ..., "objectId": ...}}, ...};
+// - normalized (as sent to targets): {"events": [{"provider": ..., "bucket": ..., "name": ...}, ...]}.
+//
+// Events for buckets that are not in the BMD, or don't have listing cache enabled, are ignored.
+// NOTE: no authentication - cloud providers don't carry AIS tokens, and the only effect is
+// (extra) invalidation; restrict access at the network level as needed.
+
+const maxEventsSize = 4 * cos.MiB
+
+type (
+	bckEvent struct {
+		Provider string `json:"provider"`
+		Bucket   string `json:"bucket"`
+		ObjName  string `json:"name,omitempty"` // empty: entire bucket
+	}
+	bckEvents struct {
+		Events []bckEvent `json:"events"`
+		Evict  []objEvict `json:"evict,omitempty"` // (target to target only)
+	}
+	// remote object changed out of band: evict its in-cluster copy (see t.evictCached)
+	objEvict struct {
+		Bck     cmn.Bck `json:"bck"`
+		ObjName string  `json:"name"`
+	}
+
+	s3EventRecords struct {
+		Records []struct {
+			S3 struct {
+				Bucket struct {
+					Name string `json:"name"`
+				} `json:"bucket"`
+				Object struct {
+					Key string `json:"key"` // URL-encoded
+				} `json:"object"`
+			} `json:"s3"`
+		} `json:"Records"`
+	}
+	snsMessage struct {
+		Type         string `json:"Type"`
+		Message      string `json:"Message"`
+		TopicArn     string `json:"TopicArn"`
+		SubscribeURL string `json:"SubscribeURL"`
+	}
+	gcsPushMessage struct {
+		Message struct {
+			Attributes struct {
+				BucketID string `json:"bucketId"`
+				ObjectID string `json:"objectId"`
+			} `json:"attributes"`
+		} `json:"message"`
+	}
+)
+
+func (p *proxy) eventsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		cmn.WriteErr405(w, r, http.MethodPost)
+		return
+	}
+	if _, err := p.parseURL(w, r, apc.URLPathEvents.L, 0, false); err != nil {
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxEventsSize))
+	cos.Close(r.Body)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	events, err := parseEvents(body)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	events = p.filterEvents(events)
+	if len(events) == 0 {
+		return
+	}
+
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodPost, Path: apc.URLPathEvents.S, Body: cos.MustMarshal(&bckEvents{Events: events})}
+	args.to = core.Targets
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.toErr())
+			break
+		}
+	}
+	freeBcastRes(results)
+}
+
+// keep only events for buckets with listing cache
+func (p *proxy) filterEvents(events []bckEvent) []bckEvent {
+	var (
+		bmd     = p.owner.bmd.get()
+		enabled = make(map[cmn.Bck]bool, 4) // (provider, name) => enabled
+	)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		if bck.Props.LsoCache.Enabled {
+			rbck := bck.RemoteBck()
+			enabled[cmn.Bck{Name: rbck.Name, Provider: rbck.Provider}] = true
+		}
+		return false
+	})
+	if len(enabled) == 0 {
+		return nil
+	}
+	var i int
+	for _, ev := range events {
+		if enabled[cmn.Bck{Name: ev.Bucket, Provider: ev.Provider}] {
+			events[i] = ev
+			i++
+		}
+	}
+	return events[:i]
+}
+
+func parseEvents(body []byte) ([]bckEvent, error) {
+	var top map[string]jsoniter.RawMessage // (case-sensitive, unlike struct fields)
+	if err := jsoniter.Unmarshal(body, &top); err != nil {
+		return nil, fmt.Errorf("invalid change notification: %v", err)
+	}
+	switch {
+	case top["Records"] != nil:
+		return parseS3Events(body)
+	case top["Type"] != nil && top["Message"] != nil:
+		var sns snsMessage
+		if err := jsoniter.Unmarshal(body, &sns); err != nil {
+			return nil, fmt.Errorf("invalid SNS message: %v", err)
+		}
+		switch sns.Type {
+		case "Notification":
+			return parseS3Events(cos.UnsafeB(sns.Message))
+		case "SubscriptionConfirmation":
+			// not confirming automatically (that'd be GET on a URL that comes with the request)
+			nlog.Warningln("SNS subscription", sns.TopicArn, "- to confirm, visit:", sns.SubscribeURL)
+			return nil, nil
+		default:
+			return nil, nil
+		}
+	case top["message"] != nil:
+		var gcs gcsPushMessage
+		if err := jsoniter.Unmarshal(body, &gcs); err != nil {
+			return nil, fmt.Errorf("invalid Pub/Sub message: %v", err)
+		}
+		attrs := gcs.Message.Attributes
+		if attrs.BucketID == "" {
+			return nil, errors.New("invalid Pub/Sub message: missing bucketId attribute")
+		}
+		return []bckEvent{{Provider: apc.GCP, Bucket: attrs.BucketID, ObjName: attrs.ObjectID}}, nil
+	case top["events"] != nil:
+		var evs bckEvents
+		if err := jsoniter.Unmarshal(body, &evs); err != nil {
+			return nil, fmt.Errorf("invalid change notification: %v", err)
+		}
+		for i := range evs.Events {
+			ev := &evs.Events[i]
+			ev.Provider = apc.NormalizeProvider(ev.Provider)
+			if !apc.IsProvider(ev.Provider) || ev.Bucket == "" {
+				return nil, fmt.Errorf("invalid change notification: bucket %q, provider %q", ev.Bucket, ev.Provider)
+			}
+		}
+		return evs.Events, nil
+	case top["Event"] != nil: // s3:TestEvent
+		return nil, nil
+	default:
+		return nil, errors.New("unrecognized change notification (expecting S3 event, SNS, or Pub/Sub message)")
+	}
+}
+
+func parseS3Events(body []byte) ([]bckEvent, error) {
+	var s3evs s3EventRecords
+	if err := jsoniter.Unmarshal(body, &s3evs); err != nil {
+		return nil, fmt.Errorf("invalid S3 event notification: %v", err)
+	}
+	events := make([]bckEvent, 0, len(s3evs.Records))
+	for _, rec := range s3evs.Records {
+		if rec.S3.Bucket.Name == "" {
+			continue
+		}
+		key, err := url.QueryUnescape(rec.S3.Object.Key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid S3 event notification: object key %q: %v", rec.S3.Object.Key, err)
+		}
+		events = append(events, bckEvent{Provider: apc.AWS, Bucket: rec.S3.Bucket.Name, ObjName: key})
+	}
+	return events, nil
+}
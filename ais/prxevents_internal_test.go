@@ -0,0 +1,56 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestParseEvents(t *testing.T) {
+	const s3event = `{"Records": [{"eventSource": "aws:s3", "eventName": "ObjectCreated:Put",
+		"s3": {"bucket": {"name": "data"}, "object": {"key": "dir/file+name%3D1.tar", "size": 10}}}]}`
+	tests := []struct {
+		body     string
+		expected []bckEvent
+		fail     bool
+	}{
+		{body: s3event, expected: []bckEvent{{apc.AWS, "data", "dir/file name=1.tar"}}},
+		{
+			body:     `{"Type": "Notification", "TopicArn": "arn:aws:sns:x", "Message": ` + strconv.Quote(s3event) + `}`,
+			expected: []bckEvent{{apc.AWS, "data", "dir/file name=1.tar"}},
+		},
+		{body: `{"Type": "SubscriptionConfirmation", "Message": "confirm", "SubscribeURL": "https://sns"}`},
+		{body: `{"Service": "Amazon S3", "Event": "s3:TestEvent", "Bucket": "data"}`},
+		{
+			body: `{"message": {"attributes": {"bucketId": "gdata", "objectId": "a/b", "eventType": "OBJECT_DELETE"},
+				"data": "e30=", "messageId": "1"}, "subscription": "projects/p/subscriptions/s"}`,
+			expected: []bckEvent{{apc.GCP, "gdata", "a/b"}},
+		},
+		{
+			body:     `{"events": [{"provider": "s3", "bucket": "data"}, {"provider": "gcp", "bucket": "g", "name": "x"}]}`,
+			expected: []bckEvent{{apc.AWS, "data", ""}, {apc.GCP, "g", "x"}},
+		},
+		{body: `{"events": [{"provider": "nonesuch", "bucket": "data"}]}`, fail: true},
+		{body: `{"message": {"attributes": {}}}`, fail: true},
+		{body: `{"foo": "bar"}`, fail: true},
+		{body: `[]`, fail: true},
+	}
+	for i, test := range tests {
+		events, err := parseEvents([]byte(test.body))
+		if test.fail {
+			tassert.Errorf(t, err != nil, "%d: expecting error", i)
+			continue
+		}
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, len(events) == len(test.expected), "%d: expecting %v, got %v", i, test.expected, events)
+		for j := range events {
+			tassert.Errorf(t, events[j] == test.expected[j], "%d: expecting %v, got %v", i, test.expected[j], events[j])
+		}
+	}
+}
@@ -47,7 +47,14 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if r.Header.Get(cos.HdrOrigin) != "" && len(apiItems) > 0 && r.Method != http.MethodOptions {
+		corsS3(w, r, p.owner.bmd, apiItems[0])
+	}
+
 	switch r.Method {
+	case http.MethodOptions:
+		// CORS preflight
+		p.preflightS3(w, r, apiItems)
 	case http.MethodHead:
 		if len(apiItems) == 0 {
 			s3.WriteErr(w, r, s3.ErrInfo{Err: errS3Req})
@@ -79,8 +86,41 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 			_, policy    = q[s3.QparamPolicy]
 			_, cors      = q[s3.QparamCORS]
 			_, acl       = q[s3.QparamACL]
+			_, tagging   = q[s3.QparamTagging]
+			_, objLock   = q[s3.QparamObjLock]
+			objMD        = q.Has(s3.QparamRetention) || q.Has(s3.QparamLegalHold)
 		)
-		if lifecycle || policy || cors || acl {
+		if lifecycle && len(apiItems) == 1 {
+			// perms: apc.AceBckHEAD
+			p.getBckLifecycleS3(w, r, apiItems[0])
+			return
+		}
+		if cors && len(apiItems) == 1 {
+			// perms: apc.AceBckHEAD
+			p.getBckCORSS3(w, r, apiItems[0])
+			return
+		}
+		if policy && len(apiItems) == 1 {
+			// perms: apc.AceBckHEAD
+			p.getBckPolicyS3(w, r, apiItems[0])
+			return
+		}
+		if objLock && len(apiItems) == 1 {
+			// perms: apc.AceBckHEAD
+			p.getBckObjLockS3(w, r, apiItems[0])
+			return
+		}
+		if tagging && len(apiItems) > 1 {
+			// perms: apc.AceObjHEAD
+			p.tagObjS3(w, r, apiItems, apc.AceObjHEAD)
+			return
+		}
+		if objMD && len(apiItems) > 1 {
+			// perms: apc.AceObjHEAD
+			p.objLockS3(w, r, apiItems, apc.AceObjHEAD)
+			return
+		}
+		if lifecycle || policy || cors || acl || tagging || objLock || objMD {
 			p.unsupported(w, r, apiItems[0])
 			return
 		}
@@ -91,6 +131,11 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.getBckVersioningS3(w, r, apiItems[0])
 				return
 			}
+			if q.Has(s3.QparamVersions) {
+				// perms: apc.AceObjLIST
+				p.listVersionsS3(w, r, apiItems[0], q)
+				return
+			}
 			// perms: apc.AceObjLIST
 			p.listObjectsS3(w, r, apiItems[0], q)
 			return
@@ -110,14 +155,49 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.putBckVersioningS3(w, r, apiItems[0])
 				return
 			}
+			if q.Has(s3.QparamLifecycle) {
+				// perms: apc.AcePATCH
+				p.putBckLifecycleS3(w, r, apiItems[0])
+				return
+			}
+			if q.Has(s3.QparamCORS) {
+				// perms: apc.AcePATCH
+				p.putBckCORSS3(w, r, apiItems[0])
+				return
+			}
+			if q.Has(s3.QparamPolicy) {
+				// perms: apc.AceBckSetACL
+				p.putBckPolicyS3(w, r, apiItems[0])
+				return
+			}
+			if q.Has(s3.QparamObjLock) {
+				// perms: apc.AcePATCH
+				p.putBckObjLockS3(w, r, apiItems[0])
+				return
+			}
 			// perms: apc.AceCreateBucket
 			p.putBckS3(w, r, apiItems[0])
 			return
 		}
+		if r.URL.Query().Has(s3.QparamTagging) {
+			// perms: apc.AceObjUpdate
+			p.tagObjS3(w, r, apiItems, apc.AceObjUpdate)
+			return
+		}
+		if q := r.URL.Query(); q.Has(s3.QparamRetention) || q.Has(s3.QparamLegalHold) {
+			// perms: apc.AceObjUpdate
+			p.objLockS3(w, r, apiItems, apc.AceObjUpdate)
+			return
+		}
 		// perms: apc.AcePUT
 		p.putObjS3(w, r, apiItems)
 	case http.MethodPost:
 		q := r.URL.Query()
+		if q.Has(s3.QparamSelect) {
+			// perms: apc.AceGET
+			p.selectObjS3(w, r, apiItems)
+			return
+		}
 		if q.Has(s3.QparamMptUploadID) || q.Has(s3.QparamMptUploads) {
 			p.handleMptUpload(w, r, apiItems)
 			return
@@ -144,15 +224,35 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.delMultipleObjs(w, r, apiItems[0])
 				return
 			}
+			if q.Has(s3.QparamLifecycle) {
+				// perms: apc.AcePATCH
+				p.delBckLifecycleS3(w, r, apiItems[0])
+				return
+			}
+			if q.Has(s3.QparamCORS) {
+				// perms: apc.AcePATCH
+				p.delBckCORSS3(w, r, apiItems[0])
+				return
+			}
+			if q.Has(s3.QparamPolicy) {
+				// perms: apc.AceBckSetACL
+				p.delBckPolicyS3(w, r, apiItems[0])
+				return
+			}
 			// perms: apc.AceDestroyBucket
 			p.delBckS3(w, r, apiItems[0])
 			return
 		}
+		if r.URL.Query().Has(s3.QparamTagging) {
+			// perms: apc.AceObjUpdate
+			p.tagObjS3(w, r, apiItems, apc.AceObjUpdate)
+			return
+		}
 		// perms: apc.AceObjDELETE
 		p.delObjS3(w, r, apiItems)
 	default:
 		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodHead,
-			http.MethodPost, http.MethodPut)
+			http.MethodPost, http.MethodPut, http.MethodOptions)
 	}
 }
 
@@ -196,6 +296,11 @@ func (p *proxy) putBckS3(w http.ResponseWriter, r *http.Request, bucket string)
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
 		return
 	}
+	if strings.EqualFold(r.Header.Get(cos.S3HdrBckObjLock), "true") {
+		bargs := bckPropsArgs{bck: bck}
+		bck.Props = bargs.inheritMerge()
+		bck.Props.ObjLock.Enabled = true
+	}
 
 	if err := p.createBucket(&msg, bck, nil); err != nil {
 		status := crerrStatus(err)
@@ -241,14 +346,47 @@ func (p *proxy) handleMptUpload(w http.ResponseWriter, r *http.Request, items []
 	if bck == nil {
 		return
 	}
-	if err := p.access(r, bck, apc.AcePUT); err != nil {
+	objName, errN := s3.JoinValidateOname(w, r, items)
+	if errN != nil {
+		return
+	}
+	if err := p.accessObj(r, bck, objName, apc.AcePUT); err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
 		return
 	}
+
+	smap := p.owner.smap.get()
+	tsi, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return
+	}
+	started := time.Now()
+	redurl := p.redurl(r, tsi, smap.Version, started.UnixNano(), cmn.NetIntraData, netPub)
+	p.s3Redirect(w, r, tsi, redurl, bck.Name)
+}
+
+// POST /s3/<bucket-name>/<object-name>?select&select-type=2
+// +gen:endpoint POST /s3/{bucket-name}/{object-name} [s3.QparamSelect=string,s3.QparamSelectType=string] payload=s3-select
+// +gen:payload s3-select=<SelectObjectContentRequest><Expression>SELECT * FROM S3Object s WHERE s._1 = 'x'</Expression><ExpressionType>SQL</ExpressionType><InputSerialization><CSV/></InputSerialization><OutputSerialization><CSV/></OutputSerialization></SelectObjectContentRequest>
+// Run S3 Select (SQL subset) over a CSV or JSON object
+func (p *proxy) selectObjS3(w http.ResponseWriter, r *http.Request, items []string) {
+	if len(items) < 2 {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: errS3BckObj})
+		return
+	}
+	bck := p.initByNameOnly(w, r, items[0] /*bucket*/)
+	if bck == nil {
+		return
+	}
 	objName, errN := s3.JoinValidateOname(w, r, items)
 	if errN != nil {
 		return
 	}
+	if err := p.accessObj(r, bck, objName, apc.AceGET); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
 
 	smap := p.owner.smap.get()
 	tsi, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
@@ -256,6 +394,9 @@ func (p *proxy) handleMptUpload(w http.ResponseWriter, r *http.Request, items []
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
 		return
 	}
+	if cmn.Rom.V(5, cos.ModS3) {
+		nlog.Infoln("select", bck.Cname(objName), "=>", tsi.StringEx())
+	}
 	started := time.Now()
 	redurl := p.redurl(r, tsi, smap.Version, started.UnixNano(), cmn.NetIntraData, netPub)
 	p.s3Redirect(w, r, tsi, redurl, bck.Name)
@@ -270,10 +411,6 @@ func (p *proxy) delMultipleObjs(w http.ResponseWriter, r *http.Request, bucket s
 	if bck == nil {
 		return
 	}
-	if err := p.access(r, bck, apc.AceObjDELETE); err != nil {
-		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
-		return
-	}
 	decoder := xml.NewDecoder(r.Body)
 	lst := &s3.Delete{}
 	if err := decoder.Decode(lst); err != nil {
@@ -294,6 +431,10 @@ func (p *proxy) delMultipleObjs(w http.ResponseWriter, r *http.Request, bucket s
 			s3.WriteErr(w, r, s3.ErrInfo{Err: err})
 			return
 		}
+		if err := p.accessObj(r, bck, obj.Key, apc.AceObjDELETE); err != nil {
+			s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+			return
+		}
 		objNames = append(objNames, obj.Key)
 	}
 
@@ -364,7 +505,7 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	if bck == nil {
 		return
 	}
-	if err := p.access(r, bck, apc.AceObjLIST); err != nil {
+	if err := p.accessPrefix(r, bck, q.Get(s3.QparamPrefix), apc.AceObjLIST); err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
 		return
 	}
@@ -517,7 +658,8 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string
 	if bckSrc == nil {
 		return
 	}
-	if err := p.access(r, bckSrc, apc.AceGET); err != nil {
+	objName := strings.Trim(parts[1], "/")
+	if err := p.accessObj(r, bckSrc, objName, apc.AceGET); err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
 		return
 	}
@@ -526,14 +668,23 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string
 	if bckDst == nil {
 		return
 	}
-	if err := bckDst.Allow(apc.AcePUT); err != nil {
+	objNameTo, errN := s3.JoinValidateOname(w, r, items)
+	if errN != nil {
+		return
+	}
+	if err := p.accessObj(r, bckDst, objNameTo, apc.AcePUT); err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
 		return
 	}
 
-	objName := strings.Trim(parts[1], "/")
+	// CopyObject executes on the source's target, UploadPartCopy - on the destination's
+	// (that is, where the upload is; compare with p.directPutObjS3)
+	uname := bckSrc.MakeUname(objName)
+	if q := r.URL.Query(); q.Has(s3.QparamMptPartNo) && q.Has(s3.QparamMptUploadID) {
+		uname = bckDst.MakeUname(objNameTo)
+	}
 	smap := p.owner.smap.get()
-	tsi, err := smap.HrwName2T(bckSrc.MakeUname(objName))
+	tsi, err := smap.HrwName2T(uname)
 	if err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
 		return
@@ -553,10 +704,6 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 	if bck == nil {
 		return
 	}
-	if err := p.access(r, bck, apc.AcePUT); err != nil {
-		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
-		return
-	}
 	if len(items) < 2 {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: errS3Obj})
 		return
@@ -565,6 +712,10 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 	if errN != nil {
 		return
 	}
+	if err := p.accessObj(r, bck, objName, apc.AcePUT); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
 
 	smap := p.owner.smap.get()
 	tsi, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
@@ -588,11 +739,11 @@ func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, items []string,
 	if bck == nil {
 		return
 	}
-	if err := p.access(r, bck, apc.AceGET); err != nil {
-		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
-		return
-	}
 	if listMultipart {
+		if err := p.access(r, bck, apc.AceGET); err != nil {
+			s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+			return
+		}
 		p.listMultipart(w, r, bck, q)
 		return
 	}
@@ -604,6 +755,10 @@ func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, items []string,
 	if errN != nil {
 		return
 	}
+	if err := p.accessObj(r, bck, objName, apc.AceGET); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
 
 	smap := p.owner.smap.get()
 	tsi, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
@@ -667,6 +822,59 @@ func (p *proxy) listMultipart(w http.ResponseWriter, r *http.Request, bck *meta.
 	sgl.Free()
 }
 
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamVersions=string,s3.QparamPrefix=string,s3.QparamKeyMarker=string,s3.QparamVersionIDMarker=string,s3.QparamMaxKeys=string]
+// List object versions (ais:// buckets that retain prior versions - see versioning.retain_prior)
+func (p *proxy) listVersionsS3(w http.ResponseWriter, r *http.Request, bucket string, q url.Values) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.accessPrefix(r, bck, q.Get(s3.QparamPrefix), apc.AceObjLIST); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	if !bck.IsAIS() {
+		err := fmt.Errorf("listing object versions is not supported for %s (ais:// buckets only)", bck.Cname(""))
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusNotImplemented, Code: "NotImplemented"})
+		return
+	}
+
+	// bcast & merge
+	var (
+		smap = p.owner.smap.get()
+		all  = s3.NewListVersionsResult(bck.Name, q)
+	)
+	for _, si := range smap.Tmap {
+		if si.InMaintOrDecomm() {
+			continue
+		}
+		cargs := allocCargs()
+		cargs.si = si
+		cargs.req = cmn.HreqArgs{Method: http.MethodGet, Base: si.URL(cmn.NetPublic), Path: r.URL.Path, Query: q}
+		res := p.call(cargs, smap)
+		b, err := res.bytes, res.err
+		freeCargs(cargs)
+		freeCR(res)
+		if err == nil {
+			results := &s3.ListVersionsResult{}
+			err = xml.Unmarshal(b, results)
+			if err == nil {
+				all.Merge(results)
+				continue
+			}
+		}
+		s3.WriteErr(w, r, s3.ErrInfo{Err: fmt.Errorf("%s: failed to list object versions: %v", si, err)})
+		return
+	}
+	all.Finalize()
+
+	sgl := p.gmm.NewSGL(0)
+	all.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
 // +gen:endpoint HEAD /s3/{bucket-name}/{object-name}
 // Retrieve S3 object metadata and headers
 func (p *proxy) headObjS3(w http.ResponseWriter, r *http.Request, items []string) {
@@ -678,15 +886,15 @@ func (p *proxy) headObjS3(w http.ResponseWriter, r *http.Request, items []string
 	if bck == nil {
 		return
 	}
-	if err := p.access(r, bck, apc.AceObjHEAD); err != nil {
-		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
-		return
-	}
 
 	objName, errN := s3.JoinValidateOname(w, r, items)
 	if errN != nil {
 		return
 	}
+	if err := p.accessObj(r, bck, objName, apc.AceObjHEAD); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
 
 	smap := p.owner.smap.get()
 	tsi, err := smap.HrwName2T(bck.MakeUname(objName))
@@ -704,6 +912,7 @@ func (p *proxy) headObjS3(w http.ResponseWriter, r *http.Request, items []string
 	// (make p.s3Redirect() work, otherwise - direct call)
 	parsedURL, err := url.Parse(tsi.URL(cmn.NetIntraData))
 	debug.AssertNoErr(err)
+	s3.DelCORSHeaders(w.Header()) // (target will add its own)
 	p.reverseRequest(w, r, tsi.ID(), parsedURL)
 }
 
@@ -718,15 +927,66 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 	if bck == nil {
 		return
 	}
-	if err := p.access(r, bck, apc.AceObjDELETE); err != nil {
+
+	objName, errN := s3.JoinValidateOname(w, r, items)
+	if errN != nil {
+		return
+	}
+	if err := p.accessObj(r, bck, objName, apc.AceObjDELETE); err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
 		return
 	}
 
+	smap := p.owner.smap.get()
+	tsi, err := smap.HrwName2T(bck.MakeUname(objName))
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return
+	}
+	if cmn.Rom.V(5, cos.ModS3) {
+		nlog.Infoln(r.Method, bck.Cname(objName), "=>", tsi.StringEx())
+	}
+	started := time.Now()
+	redurl := p.redurl(r, tsi, smap.Version, started.UnixNano(), cmn.NetIntraControl, "")
+	p.s3Redirect(w, r, tsi, redurl, bck.Name)
+}
+
+// GET, PUT, or DELETE /s3/<bucket-name>/<object-name>?tagging
+// +gen:endpoint GET /s3/{bucket-name}/{object-name} [s3.QparamTagging=string]
+// +gen:endpoint PUT /s3/{bucket-name}/{object-name} [s3.QparamTagging=string] payload=s3-tagging
+// +gen:endpoint DELETE /s3/{bucket-name}/{object-name} [s3.QparamTagging=string]
+// +gen:payload s3-tagging=<Tagging><TagSet><Tag><Key>split</Key><Value>train</Value></Tag></TagSet></Tagging>
+// Get, set, or remove S3 object tags
+func (p *proxy) tagObjS3(w http.ResponseWriter, r *http.Request, items []string, ace apc.AccessAttrs) {
+	p.objMetaS3(w, r, items, ace, "tagging")
+}
+
+// GET or PUT /s3/<bucket-name>/<object-name>?retention|legal-hold
+// +gen:endpoint GET /s3/{bucket-name}/{object-name} [s3.QparamRetention=string]
+// +gen:endpoint PUT /s3/{bucket-name}/{object-name} [s3.QparamRetention=string] payload=s3-retention
+// +gen:endpoint GET /s3/{bucket-name}/{object-name} [s3.QparamLegalHold=string]
+// +gen:endpoint PUT /s3/{bucket-name}/{object-name} [s3.QparamLegalHold=string] payload=s3-legal-hold
+// +gen:payload s3-retention=<Retention><Mode>GOVERNANCE</Mode><RetainUntilDate>2030-01-01T00:00:00Z</RetainUntilDate></Retention>
+// +gen:payload s3-legal-hold=<LegalHold><Status>ON</Status></LegalHold>
+// Get or set S3 object retention and legal hold
+func (p *proxy) objLockS3(w http.ResponseWriter, r *http.Request, items []string, ace apc.AccessAttrs) {
+	p.objMetaS3(w, r, items, ace, "object-lock")
+}
+
+// object's metadata (sub-resource): redirect to the target that stores the object
+func (p *proxy) objMetaS3(w http.ResponseWriter, r *http.Request, items []string, ace apc.AccessAttrs, what string) {
+	bck := p.initByNameOnly(w, r, items[0] /*bucket*/)
+	if bck == nil {
+		return
+	}
 	objName, errN := s3.JoinValidateOname(w, r, items)
 	if errN != nil {
 		return
 	}
+	if err := p.accessObj(r, bck, objName, ace); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
 
 	smap := p.owner.smap.get()
 	tsi, err := smap.HrwName2T(bck.MakeUname(objName))
@@ -735,7 +995,7 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 		return
 	}
 	if cmn.Rom.V(5, cos.ModS3) {
-		nlog.Infoln(r.Method, bck.Cname(objName), "=>", tsi.StringEx())
+		nlog.Infoln(r.Method, what, bck.Cname(objName), "=>", tsi.StringEx())
 	}
 	started := time.Now()
 	redurl := p.redurl(r, tsi, smap.Version, started.UnixNano(), cmn.NetIntraControl, "")
@@ -757,7 +1017,7 @@ func (p *proxy) getBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 	sgl.Free()
 }
 
-// GET /s3/<bucket-name>?lifecycle|cors|policy|acl
+// GET /s3/<bucket-name>?acl (and object-level ?lifecycle|cors|policy)
 func (p *proxy) unsupported(w http.ResponseWriter, r *http.Request, bucket string) {
 	if _, ecode, err := meta.InitByNameOnly(bucket, p.owner.bmd); err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: ecode})
@@ -822,6 +1082,7 @@ func (p *proxy) s3Redirect(w http.ResponseWriter, r *http.Request, si *meta.Snod
 		// forward using pub net
 		parsedURL, err := url.Parse(si.URL(cmn.NetPublic))
 		debug.AssertNoErr(err)
+		s3.DelCORSHeaders(w.Header()) // (target will add its own)
 		p.reverseRequest(w, r, si.ID(), parsedURL)
 		return
 	}
@@ -0,0 +1,379 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// S3 bucket sub-resources (configurations) that are stored in bucket props (BMD):
+// - ?lifecycle
+// - ?cors (and preflight OPTIONS)
+// - ?policy
+// - ?object-lock
+
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamLifecycle=string]
+// Get S3 bucket lifecycle configuration
+func (p *proxy) getBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	if bck.Props.Lifecycle.IsEmpty() {
+		err := fmt.Errorf("bucket %s has no lifecycle configuration", bck.Cname(""))
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusNotFound, Code: s3.NoSuchLifecycleConfiguration})
+		return
+	}
+	resp := s3.NewLifecycleConfiguration(&bck.Props.Lifecycle)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// +gen:endpoint PUT /s3/{bucket-name} [s3.QparamLifecycle=string] payload=s3-lifecycle
+// +gen:payload s3-lifecycle=<LifecycleConfiguration><Rule><ID>tmp</ID><Filter><Prefix>tmp/</Prefix></Filter><Status>Enabled</Status><Expiration><Days>7</Days></Expiration></Rule></LifecycleConfiguration>
+// Configure S3 bucket lifecycle (object expiration and abort-incomplete-upload rules)
+func (p *proxy) putBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	lc := &s3.LifecycleConfiguration{}
+	if err := xml.NewDecoder(r.Body).Decode(lc); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: "MalformedXML"})
+		return
+	}
+	conf, err := lc.ToConf()
+	if err != nil {
+		ei := s3.ErrInfo{Err: err, Code: "InvalidRequest"}
+		if s3.IsErrLifecycleNotImpl(err) {
+			ei.Status, ei.Code = http.StatusNotImplemented, "NotImplemented"
+		}
+		s3.WriteErr(w, r, ei)
+		return
+	}
+	propsToUpdate := &cmn.BpropsToSet{
+		Lifecycle: &cmn.LifecycleConfToSet{Rules: &conf.Rules},
+	}
+	p.setBpropsS3(w, r, msg, bck, propsToUpdate)
+}
+
+// +gen:endpoint DELETE /s3/{bucket-name} [s3.QparamLifecycle=string]
+// Delete S3 bucket lifecycle configuration
+func (p *proxy) delBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	if !bck.Props.Lifecycle.IsEmpty() {
+		propsToUpdate := &cmn.BpropsToSet{
+			Lifecycle: &cmn.LifecycleConfToSet{Rules: &[]cmn.LifecycleRule{}},
+		}
+		if !p.setBpropsS3(w, r, msg, bck, propsToUpdate) {
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamCORS=string]
+// Get S3 bucket CORS configuration
+func (p *proxy) getBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	if bck.Props.CORS.IsEmpty() {
+		err := fmt.Errorf("bucket %s has no CORS configuration", bck.Cname(""))
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusNotFound, Code: s3.NoSuchCORSConfiguration})
+		return
+	}
+	resp := s3.NewCORSConfiguration(&bck.Props.CORS)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// +gen:endpoint PUT /s3/{bucket-name} [s3.QparamCORS=string] payload=s3-cors
+// +gen:payload s3-cors=<CORSConfiguration><CORSRule><AllowedOrigin>https://example.com</AllowedOrigin><AllowedMethod>GET</AllowedMethod><AllowedHeader>*</AllowedHeader></CORSRule></CORSConfiguration>
+// Configure S3 bucket CORS (cross-origin resource sharing)
+func (p *proxy) putBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	cc := &s3.CORSConfiguration{}
+	if err := xml.NewDecoder(r.Body).Decode(cc); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: "MalformedXML"})
+		return
+	}
+	conf, err := cc.ToConf()
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: "InvalidRequest"})
+		return
+	}
+	propsToUpdate := &cmn.BpropsToSet{
+		CORS: &cmn.CORSConfToSet{Rules: &conf.Rules},
+	}
+	p.setBpropsS3(w, r, msg, bck, propsToUpdate)
+}
+
+// +gen:endpoint DELETE /s3/{bucket-name} [s3.QparamCORS=string]
+// Delete S3 bucket CORS configuration
+func (p *proxy) delBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	if !bck.Props.CORS.IsEmpty() {
+		propsToUpdate := &cmn.BpropsToSet{
+			CORS: &cmn.CORSConfToSet{Rules: &[]cmn.CORSRule{}},
+		}
+		if !p.setBpropsS3(w, r, msg, bck, propsToUpdate) {
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// OPTIONS /s3/<bucket-name>[/<object-name>]
+// CORS preflight - not authenticated (browsers never send credentials with preflight requests)
+// https://docs.aws.amazon.com/AmazonS3/latest/API/RESTOPTIONSobject.html
+func (p *proxy) preflightS3(w http.ResponseWriter, r *http.Request, items []string) {
+	if len(items) == 0 {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: errS3Req})
+		return
+	}
+	if r.Header.Get(cos.HdrOrigin) == "" || r.Header.Get(cos.HdrACRequestMethod) == "" {
+		err := fmt.Errorf("invalid CORS preflight request: missing %q and/or %q header", cos.HdrOrigin, cos.HdrACRequestMethod)
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return
+	}
+	bck := p.initByNameOnly(w, r, items[0])
+	if bck == nil {
+		return
+	}
+	if !s3.SetCORSPreflight(w.Header(), r, &bck.Props.CORS) {
+		err := errors.New("CORSResponse: this CORS request is not allowed")
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden, Code: s3.CORSForbidden})
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// add CORS headers to the response of an actual cross-origin request
+// (no-op if the bucket does not exist or has no CORS configuration - errors are handled elsewhere)
+func corsS3(w http.ResponseWriter, r *http.Request, bowner meta.Bowner, bucket string) {
+	bck, _, err := meta.InitByNameOnly(bucket, bowner)
+	if err == nil {
+		s3.SetCORSHeaders(w.Header(), r, &bck.Props.CORS)
+	}
+}
+
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamPolicy=string]
+// Get S3 bucket policy
+func (p *proxy) getBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	if bck.Props.Policy.IsEmpty() {
+		err := fmt.Errorf("bucket %s has no policy", bck.Cname(""))
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusNotFound, Code: s3.NoSuchBucketPolicy})
+		return
+	}
+	p.writeJSON(w, r, s3.NewPolicy(&bck.Props.Policy, bck.Name), "get-bucket-policy")
+}
+
+// +gen:endpoint PUT /s3/{bucket-name} [s3.QparamPolicy=string] payload=s3-policy
+// +gen:payload s3-policy={"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket-name/public/*"}]}
+// Set S3 bucket policy (supported subset - see ais/s3/policy.go)
+func (p *proxy) putBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r, bck, apc.AceBckSetACL); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	policy := &s3.Policy{}
+	if err := json.NewDecoder(r.Body).Decode(policy); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.MalformedPolicy})
+		return
+	}
+	conf, err := policy.ToConf(bck.Name)
+	if err == nil {
+		err = conf.ValidateAsProps()
+	}
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.MalformedPolicy})
+		return
+	}
+	propsToUpdate := &cmn.BpropsToSet{
+		Policy: &cmn.PolicyConfToSet{Statements: &conf.Statements},
+	}
+	if p.setBpropsS3(w, r, msg, bck, propsToUpdate) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// +gen:endpoint DELETE /s3/{bucket-name} [s3.QparamPolicy=string]
+// Delete S3 bucket policy
+func (p *proxy) delBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r, bck, apc.AceBckSetACL); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	if !bck.Props.Policy.IsEmpty() {
+		propsToUpdate := &cmn.BpropsToSet{
+			Policy: &cmn.PolicyConfToSet{Statements: &[]cmn.PolicyStatement{}},
+		}
+		if !p.setBpropsS3(w, r, msg, bck, propsToUpdate) {
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamObjLock=string]
+// Get S3 bucket object lock configuration
+func (p *proxy) getBckObjLockS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	if !bck.Props.ObjLock.Enabled {
+		err := fmt.Errorf("bucket %s has no object lock configuration", bck.Cname(""))
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusNotFound, Code: s3.ObjLockConfigNotFound})
+		return
+	}
+	resp := s3.NewObjectLockConfiguration(&bck.Props.ObjLock)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// +gen:endpoint PUT /s3/{bucket-name} [s3.QparamObjLock=string] payload=s3-object-lock
+// +gen:payload s3-object-lock=<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>30</Days></DefaultRetention></Rule></ObjectLockConfiguration>
+// Enable S3 object lock (WORM) and configure default retention (ais:// buckets only)
+func (p *proxy) putBckObjLockS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	olc := &s3.ObjectLockConfiguration{}
+	if err := xml.NewDecoder(r.Body).Decode(olc); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: "MalformedXML"})
+		return
+	}
+	conf, err := olc.ToConf()
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: "InvalidRequest"})
+		return
+	}
+	propsToUpdate := &cmn.BpropsToSet{
+		ObjLock: &cmn.ObjLockConfToSet{Enabled: &conf.Enabled, Mode: &conf.Mode, Days: &conf.Days, Years: &conf.Years},
+	}
+	p.setBpropsS3(w, r, msg, bck, propsToUpdate)
+}
+
+// make, validate, and commit new bucket props (compare with `httpbckpatch`)
+func (p *proxy) setBpropsS3(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, bck *meta.Bck, propsToUpdate *cmn.BpropsToSet) bool {
+	nprops, err := p.makeNewBckProps(bck, propsToUpdate)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return false
+	}
+	if _, err := p.setBprops(msg, bck, nprops); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return false
+	}
+	return true
+}
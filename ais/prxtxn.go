@@ -1112,6 +1112,12 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BpropsToSet, c
 			return nil, err
 		}
 	}
+	if bprops.ObjLock.Enabled && !nprops.ObjLock.Enabled {
+		return nil, fmt.Errorf("%s: once enabled, object lock cannot be disabled (%s)", p.si, bck)
+	}
+	if nprops.ObjLock.Enabled && !bck.IsAIS() {
+		return nil, fmt.Errorf("%s: object lock is supported only for ais:// buckets (have %s)", p.si, bck)
+	}
 	if bprops.EC.Enabled && nprops.EC.Enabled {
 		sameSlices := bprops.EC.DataSlices == nprops.EC.DataSlices && bprops.EC.ParitySlices == nprops.EC.ParitySlices
 		sameLimit := bprops.EC.ObjSizeLimit == nprops.EC.ObjSizeLimit
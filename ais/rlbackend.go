@@ -13,6 +13,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/stats"
@@ -24,6 +25,8 @@ import (
 // - GetObjReader()
 // - PutObj()
 // - DeleteObj()
+//
+// additionally, hedge and fail over cold GETs (see ais/rlhedge)
 
 // stats:
 // - not counting proactive delay    - only (reactive) retries
@@ -38,6 +41,10 @@ type (
 )
 
 func (bp *rlbackend) GetObj(ctx context.Context, lom *core.LOM, owt cmn.OWT, origReq *http.Request) (int, error) {
+	if bp.hedging(lom) && (origReq == nil || !lom.IsFeatureSet(feat.S3PresignedRequest)) {
+		return bp.hedgeGetObj(ctx, lom, owt)
+	}
+
 	// proactive
 	arl := bp.acquire(lom.Bck(), http.MethodGet)
 	ecode, err := bp.Backend.GetObj(ctx, lom, owt, origReq)
@@ -55,7 +62,14 @@ func (bp *rlbackend) GetObj(ctx context.Context, lom *core.LOM, owt cmn.OWT, ori
 	return code, e
 }
 
-func (bp *rlbackend) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
+func (bp *rlbackend) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int64) core.GetReaderResult {
+	if bp.hedging(lom) {
+		return bp.hedge(ctx, lom, offset, length)
+	}
+	return bp.getObjReader(ctx, lom, offset, length)
+}
+
+func (bp *rlbackend) getObjReader(ctx context.Context, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
 	// proactive
 	arl := bp.acquire(lom.Bck(), http.MethodGet)
 	res = bp.Backend.GetObjReader(ctx, lom, offset, length)
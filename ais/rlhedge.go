@@ -0,0 +1,295 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// Cold GET hedging and failover (see cmn.HedgeConf):
+// - attempts: the bucket's own endpoint, followed by its (configured) equivalents,
+//   or, when there are none, the same endpoint again
+// - the first attempt that takes longer than the configured percentile of recent
+//   cold GET latencies (time to response) triggers the second (hedged) one
+// - 5xx, 429, and network errors fail over to the next attempt in line
+// - first to respond wins; all other attempts get canceled
+// - each attempt runs with its own LOM clone (backends assign custom metadata) and
+//   its own cancelable context; the winner's attributes are then copied back
+// - rate limiting (if enabled) applies to each attempt separately
+
+const (
+	hedgeSamples    = 128 // recent latencies to keep
+	hedgeMinSamples = 16  // to compute percentile (otherwise, use HedgeConf.MinDelay)
+)
+
+type (
+	// per-bucket ring of recent cold GET latencies;
+	// stored in t.ratelim to get pruned when not used (see ratelim.housekeep)
+	hedgeLat struct {
+		samples  [hedgeSamples]int64
+		n        int // total added, saturating at hedgeSamples
+		idx      int
+		lastUsed atomic.Int64
+		mu       sync.Mutex
+	}
+
+	hedgeRes struct {
+		lom     *core.LOM
+		cancel  context.CancelFunc
+		res     core.GetReaderResult
+		started int64 // mono
+		i       int   // attempt
+		hedged  bool  // vs. the first attempt or failover
+	}
+
+	// winner's reader: cancels its context when closed
+	hedgeReader struct {
+		io.ReadCloser
+		cancel context.CancelFunc
+	}
+)
+
+// interface guard
+var _ cos.Rater = (*hedgeLat)(nil)
+
+func (*rlbackend) hedging(lom *core.LOM) bool {
+	bprops := lom.Bprops()
+	return bprops != nil && bprops.Hedge.Enabled
+}
+
+// hedged alternative to backend's GetObj (compare with backend.allocPutParams)
+func (bp *rlbackend) hedgeGetObj(ctx context.Context, lom *core.LOM, owt cmn.OWT) (int, error) {
+	res := bp.hedge(ctx, lom, 0, 0)
+	if res.Err != nil {
+		return res.ErrCode, res.Err
+	}
+	params := core.AllocPutParams()
+	{
+		params.WorkTag = fs.WorkfileColdget
+		params.Reader = res.R
+		params.OWT = owt
+		params.Cksum = res.ExpCksum
+		params.Size = res.Size
+		params.Atime = time.Now()
+		params.SkipBackend = true
+	}
+	err := bp.t.PutObject(lom, params)
+	core.FreePutParams(params)
+	return 0, err
+}
+
+func (bp *rlbackend) hedge(ctx context.Context, lom *core.LOM, offset, length int64) core.GetReaderResult {
+	var (
+		bck      = lom.Bck()
+		conf     = &bck.Props.Hedge
+		eps      = make([]*cmn.BackendEndpoint, 1, 2+len(conf.Endpoints)) // eps[0] == nil: the bucket's own
+		cancels  = make([]context.CancelFunc, 0, cap(eps))
+		hl       = bp.hedgeLat(bck)
+		delay    = hl.delay(conf)
+		timerC   <-chan time.Time
+		winner   *hedgeRes
+		errRes   core.GetReaderResult // primary's error (takes precedence) or the last one
+		inflight int
+	)
+	for i := range conf.Endpoints {
+		eps = append(eps, &conf.Endpoints[i])
+	}
+	if len(eps) == 1 && delay > 0 {
+		eps = append(eps, nil) // hedge with self
+	}
+	resCh := make(chan *hedgeRes, len(eps))
+
+	launch := func(hedged bool) {
+		actx, cancel := context.WithCancel(ctx)
+		if ep := eps[len(cancels)]; ep != nil {
+			actx = context.WithValue(actx, cos.CtxBackendEndpoint, ep)
+		}
+		clone := lom.Clone()
+		clone.SetCustomMD(nil) // not to share the map
+		r := &hedgeRes{lom: clone, cancel: cancel, i: len(cancels), started: mono.NanoTime(), hedged: hedged}
+		cancels = append(cancels, cancel)
+		inflight++
+		go func() {
+			r.res = bp.getObjReader(actx, r.lom, offset, length)
+			resCh <- r
+		}()
+	}
+
+	launch(false)
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		timerC = timer.C
+	}
+outer:
+	for inflight > 0 {
+		select {
+		case <-timerC:
+			timerC = nil
+			if len(cancels) < len(eps) {
+				launch(true)
+				bp.hedgeStats(ctx, bck, stats.HedgeGetCount)
+			}
+		case r := <-resCh:
+			inflight--
+			if r.res.Err == nil {
+				winner = r
+				break outer
+			}
+			if r.i == 0 || errRes.Err == nil {
+				errRes = r.res
+			}
+			hedgeFree(r)
+			if ctx.Err() != nil || (r.i == 0 && !hedgeFailover(&r.res)) {
+				break outer // definitive (e.g., 404)
+			}
+			if inflight == 0 && len(cancels) < len(eps) {
+				launch(false)
+				bp.hedgeStats(ctx, bck, stats.FailoverGetCount)
+			}
+		}
+	}
+
+	// cancel the rest and cleanup in the background
+	for i, cancel := range cancels {
+		if winner == nil || i != winner.i {
+			cancel()
+		}
+	}
+	if inflight > 0 {
+		go hedgeDrain(resCh, inflight)
+	}
+
+	if winner == nil {
+		if len(cancels) > 1 && cmn.Rom.V(4, cos.ModBackend) {
+			nlog.Warningln("hedge:", lom.Cname(), "failed after", len(cancels), "attempts: [", errRes.Err, "]")
+		}
+		return errRes
+	}
+
+	hl.add(mono.SinceNano(winner.started))
+	if winner.hedged {
+		bp.hedgeStats(ctx, bck, stats.HedgeWonGetCount)
+	}
+	if winner.i > 0 && cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infoln("hedge:", lom.Cname(), "won by attempt", winner.i, eps[winner.i])
+	}
+
+	// the winner's attributes and reader
+	lom.CopyAttrs(winner.lom.ObjAttrs(), winner.lom.Checksum() == nil)
+	res := winner.res
+	res.R = &hedgeReader{ReadCloser: res.R, cancel: winner.cancel}
+	core.FreeLOM(winner.lom)
+	return res
+}
+
+// wait for the remaining (canceled) attempts to return, and free them
+func hedgeDrain(resCh chan *hedgeRes, pending int) {
+	for range pending {
+		r := <-resCh
+		if r.res.R != nil {
+			cos.Close(r.res.R)
+		}
+		hedgeFree(r)
+	}
+}
+
+func hedgeFree(r *hedgeRes) {
+	r.cancel()
+	core.FreeLOM(r.lom)
+}
+
+// whether to try the next equivalent endpoint
+func hedgeFailover(res *core.GetReaderResult) bool {
+	switch {
+	case res.ErrCode >= http.StatusInternalServerError, res.ErrCode == http.StatusTooManyRequests:
+		return true
+	case res.ErrCode == 0:
+		return !cos.IsNotExist(res.Err) && !errors.Is(res.Err, context.Canceled) // e.g., connection refused
+	default:
+		return cmn.IsErrTooManyRequests(res.Err)
+	}
+}
+
+func (bp *rlbackend) hedgeLat(bck *meta.Bck) *hedgeLat {
+	uhash := bck.HashUname("hedge")
+	v, ok := bp.t.ratelim.Load(uhash)
+	if !ok {
+		v, _ = bp.t.ratelim.LoadOrStore(uhash, &hedgeLat{})
+	}
+	hl := v.(*hedgeLat)
+	hl.lastUsed.Store(mono.NanoTime())
+	return hl
+}
+
+func (bp *rlbackend) hedgeStats(ctx context.Context, bck *meta.Bck, name string) {
+	vlabs := xact.GetCtxVlabs(ctx)
+	if vlabs == nil {
+		vlabs = map[string]string{stats.VlabBucket: bck.Cname(""), stats.VlabXkind: ""}
+	}
+	bp.t.statsT.IncWith(name, vlabs)
+}
+
+//////////////
+// hedgeLat //
+//////////////
+
+func (hl *hedgeLat) LastUsed() int64 { return hl.lastUsed.Load() }
+
+func (hl *hedgeLat) add(d int64) {
+	hl.mu.Lock()
+	hl.samples[hl.idx] = d
+	hl.idx = (hl.idx + 1) % hedgeSamples
+	hl.n = min(hl.n+1, hedgeSamples)
+	hl.mu.Unlock()
+}
+
+// hedging delay: configured percentile of recent latencies but no less than HedgeConf.MinDelay
+// (returns zero when not hedging - failover only)
+func (hl *hedgeLat) delay(conf *cmn.HedgeConf) time.Duration {
+	if conf.Percentile == 0 {
+		return 0
+	}
+	var (
+		sorted [hedgeSamples]int64
+		dflt   = conf.Delay()
+	)
+	hl.mu.Lock()
+	n := hl.n
+	copy(sorted[:], hl.samples[:n])
+	hl.mu.Unlock()
+	if n < hedgeMinSamples {
+		return dflt
+	}
+	slices.Sort(sorted[:n])
+	return max(time.Duration(sorted[n*conf.Percentile/100]), dflt)
+}
+
+/////////////////
+// hedgeReader //
+/////////////////
+
+func (r *hedgeReader) Close() error {
+	err := r.ReadCloser.Close()
+	r.cancel()
+	return err
+}
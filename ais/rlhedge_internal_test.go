@@ -0,0 +1,62 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestHedgeDelay(t *testing.T) {
+	var (
+		hl   = &hedgeLat{}
+		conf = &cmn.HedgeConf{Enabled: true, Percentile: 90, MinDelay: cos.Duration(10 * time.Millisecond)}
+	)
+	tassert.Fatalf(t, hl.delay(&cmn.HedgeConf{Enabled: true}) == 0, "expecting no hedging with zero percentile")
+
+	// not enough samples
+	for range hedgeMinSamples - 1 {
+		hl.add(int64(time.Second))
+	}
+	tassert.Errorf(t, hl.delay(conf) == conf.MinDelay.D(), "expecting min-delay, got %v", hl.delay(conf))
+
+	// 1ms..100ms (wrapping around the ring)
+	for i := range 3 * hedgeSamples {
+		hl.add(int64(time.Duration(i%100+1) * time.Millisecond))
+	}
+	d := hl.delay(conf)
+	tassert.Errorf(t, d >= 85*time.Millisecond && d <= 95*time.Millisecond, "expecting p90 ~90ms, got %v", d)
+
+	// lower bound
+	conf.MinDelay = cos.Duration(time.Second)
+	tassert.Errorf(t, hl.delay(conf) == time.Second, "expecting min-delay, got %v", hl.delay(conf))
+}
+
+func TestHedgeFailover(t *testing.T) {
+	tests := []struct {
+		res      core.GetReaderResult
+		failover bool
+	}{
+		{core.GetReaderResult{ErrCode: http.StatusServiceUnavailable, Err: errors.New("slow down")}, true},
+		{core.GetReaderResult{ErrCode: http.StatusInternalServerError, Err: errors.New("internal")}, true},
+		{core.GetReaderResult{ErrCode: http.StatusTooManyRequests, Err: errors.New("too many")}, true},
+		{core.GetReaderResult{Err: errors.New("connection refused")}, true},
+		{core.GetReaderResult{ErrCode: http.StatusNotFound, Err: errors.New("not found")}, false},
+		{core.GetReaderResult{ErrCode: http.StatusForbidden, Err: errors.New("forbidden")}, false},
+		{core.GetReaderResult{Err: context.Canceled}, false},
+	}
+	for _, test := range tests {
+		tassert.Errorf(t, hedgeFailover(&test.res) == test.failover, "%d %v: expecting failover=%t",
+			test.res.ErrCode, test.res.Err, test.failover)
+	}
+}
@@ -16,6 +16,12 @@ const (
 	QparamCORS              = "cors"
 	QparamPolicy            = "policy"
 	QparamACL               = "acl"
+	QparamTagging           = "tagging"
+	QparamObjLock           = "object-lock"
+	QparamRetention         = "retention"
+	QparamLegalHold         = "legal-hold"
+	QparamVersions          = "versions"           // List object versions
+	QparamVersionID         = "versionId"          // GET, HEAD, or DELETE specific object version
 	QparamMultiDelete       = "delete"             // Delete multiple objects in a single request
 	QparamMaxKeys           = "max-keys"           // Maximum number of objects to return in listing
 	QparamPrefix            = "prefix"             // Filter objects by key prefix
@@ -30,6 +36,14 @@ const (
 	QparamMptMaxUploads     = "max-uploads"
 	QparamMptUploadIDMarker = "upload-id-marker"
 
+	// list object versions
+	QparamKeyMarker       = "key-marker"
+	QparamVersionIDMarker = "version-id-marker"
+
+	// SelectObjectContent
+	QparamSelect     = "select"
+	QparamSelectType = "select-type"
+
 	QparamAccessKeyID = "AWSAccessKeyId"
 	QparamExpires     = "Expires"
 	QparamSignature   = "Signature"
@@ -0,0 +1,154 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Bucket CORS configuration
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketCors.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/cors.html
+
+const (
+	NoSuchCORSConfiguration = "NoSuchCORSConfiguration"
+	CORSForbidden           = "AccessForbidden"
+)
+
+type (
+	CORSConfiguration struct {
+		XMLName xml.Name   `xml:"CORSConfiguration"`
+		Ns      string     `xml:"xmlns,attr,omitempty"`
+		Rules   []CORSRule `xml:"CORSRule"`
+	}
+	CORSRule struct {
+		ID             string   `xml:"ID,omitempty"`
+		AllowedOrigins []string `xml:"AllowedOrigin"`
+		AllowedMethods []string `xml:"AllowedMethod"`
+		AllowedHeaders []string `xml:"AllowedHeader,omitempty"`
+		ExposeHeaders  []string `xml:"ExposeHeader,omitempty"`
+		MaxAgeSeconds  int      `xml:"MaxAgeSeconds,omitempty"`
+	}
+)
+
+func NewCORSConfiguration(conf *cmn.CORSConf) *CORSConfiguration {
+	r := &CORSConfiguration{Ns: s3Namespace, Rules: make([]CORSRule, 0, len(conf.Rules))}
+	for i := range conf.Rules {
+		src := &conf.Rules[i]
+		r.Rules = append(r.Rules, CORSRule{
+			ID:             src.ID,
+			AllowedOrigins: src.AllowedOrigins,
+			AllowedMethods: src.AllowedMethods,
+			AllowedHeaders: src.AllowedHeaders,
+			ExposeHeaders:  src.ExposeHeaders,
+			MaxAgeSeconds:  src.MaxAgeSeconds,
+		})
+	}
+	return r
+}
+
+func (r *CORSConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// convert to bucket props; the caller is expected to further validate the result
+// (see cmn.CORSConf.ValidateAsProps)
+func (r *CORSConfiguration) ToConf() (*cmn.CORSConf, error) {
+	if len(r.Rules) == 0 {
+		return nil, errors.New("CORS configuration must contain at least one rule")
+	}
+	conf := &cmn.CORSConf{Rules: make([]cmn.CORSRule, 0, len(r.Rules))}
+	for i := range r.Rules {
+		src := &r.Rules[i]
+		conf.Rules = append(conf.Rules, cmn.CORSRule{
+			ID:             src.ID,
+			AllowedOrigins: src.AllowedOrigins,
+			AllowedMethods: src.AllowedMethods,
+			AllowedHeaders: src.AllowedHeaders,
+			ExposeHeaders:  src.ExposeHeaders,
+			MaxAgeSeconds:  src.MaxAgeSeconds,
+		})
+	}
+	return conf, nil
+}
+
+//
+// CORS response headers
+//
+
+// actual (non-preflight) cross-origin request: add CORS response headers iff
+// the request carries `Origin` and the latter is allowed by the bucket's configuration
+func SetCORSHeaders(hdr http.Header, r *http.Request, conf *cmn.CORSConf) {
+	origin := r.Header.Get(cos.HdrOrigin)
+	if origin == "" || conf.IsEmpty() {
+		return
+	}
+	rule := conf.Match(origin, r.Method, nil)
+	if rule == nil {
+		return
+	}
+	setCORSOrigin(hdr, rule, origin)
+	if len(rule.ExposeHeaders) > 0 {
+		hdr.Set(cos.HdrACExposeHeaders, strings.Join(rule.ExposeHeaders, ", "))
+	}
+}
+
+// remove CORS response headers (e.g., prior to reverse-proxying to another node that will set its own)
+func DelCORSHeaders(hdr http.Header) {
+	for _, k := range [...]string{cos.HdrACAllowOrigin, cos.HdrACAllowCredentials, cos.HdrACExposeHeaders} {
+		hdr.Del(k)
+	}
+}
+
+// preflight (OPTIONS) request: returns false if the request is not allowed
+// (the caller then responds with 403)
+func SetCORSPreflight(hdr http.Header, r *http.Request, conf *cmn.CORSConf) bool {
+	var (
+		origin     = r.Header.Get(cos.HdrOrigin)
+		method     = r.Header.Get(cos.HdrACRequestMethod)
+		reqHeaders []string
+	)
+	if v := r.Header.Get(cos.HdrACRequestHeaders); v != "" {
+		reqHeaders = strings.Split(v, ",")
+	}
+	rule := conf.Match(origin, method, reqHeaders)
+	if rule == nil {
+		return false
+	}
+	setCORSOrigin(hdr, rule, origin)
+	hdr.Set(cos.HdrACAllowMethods, strings.Join(rule.AllowedMethods, ", "))
+	if len(reqHeaders) > 0 {
+		hdr.Set(cos.HdrACAllowHeaders, r.Header.Get(cos.HdrACRequestHeaders))
+	}
+	if len(rule.ExposeHeaders) > 0 {
+		hdr.Set(cos.HdrACExposeHeaders, strings.Join(rule.ExposeHeaders, ", "))
+	}
+	if rule.MaxAgeSeconds > 0 {
+		hdr.Set(cos.HdrACMaxAge, strconv.Itoa(rule.MaxAgeSeconds))
+	}
+	return true
+}
+
+// as per S3: wildcard-only rule => "*" (and no credentials); otherwise, echo the origin
+func setCORSOrigin(hdr http.Header, rule *cmn.CORSRule, origin string) {
+	if len(rule.AllowedOrigins) == 1 && rule.AllowedOrigins[0] == "*" {
+		hdr.Set(cos.HdrACAllowOrigin, "*")
+		return
+	}
+	hdr.Set(cos.HdrACAllowOrigin, origin)
+	hdr.Set(cos.HdrACAllowCredentials, "true")
+	hdr.Add(cos.HdrVary, cos.HdrOrigin)
+}
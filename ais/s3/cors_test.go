@@ -0,0 +1,103 @@
+// Package s3_test provides tests for the Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const corsBody = `<CORSConfiguration>
+  <CORSRule>
+    <AllowedOrigin>https://*.example.com</AllowedOrigin>
+    <AllowedMethod>GET</AllowedMethod>
+    <AllowedMethod>HEAD</AllowedMethod>
+    <AllowedHeader>x-amz-*</AllowedHeader>
+    <AllowedHeader>Range</AllowedHeader>
+    <ExposeHeader>ETag</ExposeHeader>
+    <MaxAgeSeconds>600</MaxAgeSeconds>
+  </CORSRule>
+  <CORSRule>
+    <AllowedOrigin>*</AllowedOrigin>
+    <AllowedMethod>GET</AllowedMethod>
+  </CORSRule>
+</CORSConfiguration>`
+
+func TestCORSPreflight(t *testing.T) {
+	cc := &s3.CORSConfiguration{}
+	tassert.CheckFatal(t, xml.Unmarshal([]byte(corsBody), cc))
+	conf, err := cc.ToConf()
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, conf.ValidateAsProps())
+
+	tests := []struct {
+		origin, method, headers string
+		allowed                 bool
+		allowOrigin             string
+	}{
+		{"https://ui.example.com", http.MethodHead, "X-Amz-Date, range", true, "https://ui.example.com"},
+		{"https://ui.example.com", http.MethodGet, "", true, "https://ui.example.com"},
+		{"https://ui.example.com", http.MethodHead, "Authorization", false, ""},
+		{"https://other.org", http.MethodGet, "", true, "*"},
+		{"https://other.org", http.MethodPut, "", false, ""},
+		{"http://ui.example.com", http.MethodHead, "", false, ""},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest(http.MethodOptions, "/s3/bucket/obj", http.NoBody)
+		r.Header.Set(cos.HdrOrigin, test.origin)
+		r.Header.Set(cos.HdrACRequestMethod, test.method)
+		if test.headers != "" {
+			r.Header.Set(cos.HdrACRequestHeaders, test.headers)
+		}
+		hdr := http.Header{}
+		allowed := s3.SetCORSPreflight(hdr, r, conf)
+		tassert.Fatalf(t, allowed == test.allowed, "%+v: expected allowed=%t", test, test.allowed)
+		tassert.Fatalf(t, hdr.Get(cos.HdrACAllowOrigin) == test.allowOrigin, "%+v: unexpected %s: %q",
+			test, cos.HdrACAllowOrigin, hdr.Get(cos.HdrACAllowOrigin))
+	}
+}
+
+func TestCORSHeaders(t *testing.T) {
+	cc := &s3.CORSConfiguration{}
+	tassert.CheckFatal(t, xml.Unmarshal([]byte(corsBody), cc))
+	conf, err := cc.ToConf()
+	tassert.CheckFatal(t, err)
+
+	r := httptest.NewRequest(http.MethodGet, "/s3/bucket/obj", http.NoBody)
+	r.Header.Set(cos.HdrOrigin, "https://ui.example.com")
+	hdr := http.Header{}
+	s3.SetCORSHeaders(hdr, r, conf)
+	tassert.Fatalf(t, hdr.Get(cos.HdrACAllowOrigin) == "https://ui.example.com", "unexpected allow-origin %q", hdr.Get(cos.HdrACAllowOrigin))
+	tassert.Fatalf(t, hdr.Get(cos.HdrACExposeHeaders) == "ETag", "unexpected expose-headers %q", hdr.Get(cos.HdrACExposeHeaders))
+
+	// no Origin - no CORS headers
+	r = httptest.NewRequest(http.MethodGet, "/s3/bucket/obj", http.NoBody)
+	hdr = http.Header{}
+	s3.SetCORSHeaders(hdr, r, conf)
+	tassert.Fatalf(t, len(hdr) == 0, "expecting no headers, got %v", hdr)
+}
+
+func TestCORSInvalid(t *testing.T) {
+	for _, body := range []string{
+		`<CORSConfiguration></CORSConfiguration>`,
+		`<CORSConfiguration><CORSRule><AllowedMethod>GET</AllowedMethod></CORSRule></CORSConfiguration>`,
+		`<CORSConfiguration><CORSRule><AllowedOrigin>*</AllowedOrigin><AllowedMethod>PATCH</AllowedMethod></CORSRule></CORSConfiguration>`,
+		`<CORSConfiguration><CORSRule><AllowedOrigin>*.*</AllowedOrigin><AllowedMethod>GET</AllowedMethod></CORSRule></CORSConfiguration>`,
+	} {
+		cc := &s3.CORSConfiguration{}
+		tassert.CheckFatal(t, xml.Unmarshal([]byte(body), cc))
+		conf, err := cc.ToConf()
+		if err == nil {
+			err = conf.ValidateAsProps()
+		}
+		tassert.Fatalf(t, err != nil, "expected error for %s", body)
+	}
+}
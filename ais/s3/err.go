@@ -128,6 +128,12 @@ func WriteErr(w http.ResponseWriter, r *http.Request, errInfo ErrInfo) {
 		}
 	case isErrNoSuchUpload(err):
 		out.Code = NoSuchUpload
+	case cmn.IsErrObjLocked(err):
+		out.Code = "AccessDenied"
+		in.Status = http.StatusForbidden
+	case cmn.IsErrPrecondFailed(err):
+		out.Code = "PreconditionFailed"
+		in.Status = http.StatusPreconditionFailed
 	case in.TypeCode != "":
 		out.Code = in.TypeCode
 	default:
@@ -0,0 +1,98 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+)
+
+// AWS event stream (binary) framing, as used by SelectObjectContent responses
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/RESTSelectObjectAppendix.html
+//
+// message := prelude | headers | payload | message CRC
+// prelude := total length (4) | headers length (4) | prelude CRC (4)
+// header  := name length (1) | name | value type (1, always 7 = string) | value length (2) | value
+// (all integers big-endian; CRC32 IEEE)
+
+const (
+	esPreludeLen = 12
+	esCRCLen     = 4
+	esHdrString  = 7
+
+	esMessageType = ":message-type"
+	esEventType   = ":event-type"
+	esContentType = ":content-type"
+	esErrorCode   = ":error-code"
+	esErrorMsg    = ":error-message"
+)
+
+type (
+	esHeader struct {
+		name, value string
+	}
+	eventStream struct {
+		w   io.Writer
+		buf []byte
+	}
+)
+
+func (es *eventStream) message(hdrs []esHeader, payload []byte) error {
+	hlen := 0
+	for _, h := range hdrs {
+		hlen += 1 + len(h.name) + 1 + 2 + len(h.value)
+	}
+	total := esPreludeLen + hlen + len(payload) + esCRCLen
+
+	b := es.buf[:0]
+	b = binary.BigEndian.AppendUint32(b, uint32(total))
+	b = binary.BigEndian.AppendUint32(b, uint32(hlen))
+	b = binary.BigEndian.AppendUint32(b, crc32.ChecksumIEEE(b))
+	for _, h := range hdrs {
+		b = append(b, byte(len(h.name)))
+		b = append(b, h.name...)
+		b = append(b, esHdrString)
+		b = binary.BigEndian.AppendUint16(b, uint16(len(h.value)))
+		b = append(b, h.value...)
+	}
+	b = append(b, payload...)
+	b = binary.BigEndian.AppendUint32(b, crc32.ChecksumIEEE(b))
+	es.buf = b
+
+	_, err := es.w.Write(b)
+	return err
+}
+
+func (es *eventStream) records(payload []byte) error {
+	return es.message([]esHeader{
+		{esEventType, "Records"},
+		{esContentType, "application/octet-stream"},
+		{esMessageType, "event"},
+	}, payload)
+}
+
+func (es *eventStream) stats(payload []byte) error {
+	return es.message([]esHeader{
+		{esEventType, "Stats"},
+		{esContentType, "text/xml"},
+		{esMessageType, "event"},
+	}, payload)
+}
+
+func (es *eventStream) end() error {
+	return es.message([]esHeader{
+		{esEventType, "End"},
+		{esMessageType, "event"},
+	}, nil)
+}
+
+func (es *eventStream) error(code, msg string) error {
+	return es.message([]esHeader{
+		{esErrorCode, code},
+		{esErrorMsg, msg},
+		{esMessageType, "error"},
+	}, nil)
+}
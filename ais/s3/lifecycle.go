@@ -0,0 +1,181 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Bucket lifecycle configuration
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketLifecycleConfiguration.html
+//
+// Supported subset:
+// - <Filter><Prefix> (and the legacy top-level <Prefix>)
+// - <Expiration><Days>
+// - <AbortIncompleteMultipartUpload><DaysAfterInitiation>
+// Not supported (rejected with 501 NotImplemented - see ErrLifecycleNotImpl): tag and size
+// filters, expiration by date, expired-object delete markers, transitions, and
+// noncurrent-version actions.
+
+const (
+	NoSuchLifecycleConfiguration = "NoSuchLifecycleConfiguration"
+
+	lifecycleEnabled  = "Enabled"
+	lifecycleDisabled = "Disabled"
+)
+
+type (
+	LifecycleConfiguration struct {
+		XMLName xml.Name        `xml:"LifecycleConfiguration"`
+		Ns      string          `xml:"xmlns,attr,omitempty"`
+		Rules   []LifecycleRule `xml:"Rule"`
+	}
+	LifecycleRule struct {
+		ID          string                `xml:"ID,omitempty"`
+		Prefix      *string               `xml:"Prefix"` // legacy (deprecated by AWS in favor of Filter)
+		Filter      *LifecycleFilter      `xml:"Filter"`
+		Status      string                `xml:"Status"`
+		Expiration  *LifecycleExpiration  `xml:"Expiration"`
+		AbortMpt    *LifecycleAbortMpt    `xml:"AbortIncompleteMultipartUpload"`
+		Transitions []LifecycleTransition `xml:"Transition"`
+		// not supported (parsed in order to reject)
+		NoncurrentExpiration  *struct{}  `xml:"NoncurrentVersionExpiration"`
+		NoncurrentTransitions []struct{} `xml:"NoncurrentVersionTransition"`
+	}
+	LifecycleFilter struct {
+		Prefix string    `xml:"Prefix"`
+		Tag    *struct{} `xml:"Tag"`
+		And    *struct{} `xml:"And"`
+		// not supported (parsed in order to reject)
+		SizeGreaterThan *struct{} `xml:"ObjectSizeGreaterThan"`
+		SizeLessThan    *struct{} `xml:"ObjectSizeLessThan"`
+	}
+	LifecycleExpiration struct {
+		Date string `xml:"Date,omitempty"`
+		Days int    `xml:"Days,omitempty"`
+		// not supported (parsed in order to reject)
+		ExpiredObjectDeleteMarker *struct{} `xml:"ExpiredObjectDeleteMarker"`
+	}
+	LifecycleAbortMpt struct {
+		DaysAfterInitiation int `xml:"DaysAfterInitiation"`
+	}
+	LifecycleTransition struct {
+		Days int `xml:"Days,omitempty"`
+	}
+)
+
+// lifecycle configuration is valid but (partially) not supported
+type ErrLifecycleNotImpl struct {
+	what string
+}
+
+func notImpl(what string) error { return &ErrLifecycleNotImpl{what} }
+
+func (e *ErrLifecycleNotImpl) Error() string { return e.what + " is not supported" }
+
+func IsErrLifecycleNotImpl(err error) bool {
+	var e *ErrLifecycleNotImpl
+	return errors.As(err, &e)
+}
+
+func NewLifecycleConfiguration(conf *cmn.LifecycleConf) *LifecycleConfiguration {
+	r := &LifecycleConfiguration{Ns: s3Namespace, Rules: make([]LifecycleRule, 0, len(conf.Rules))}
+	for i := range conf.Rules {
+		var (
+			src  = &conf.Rules[i]
+			rule = LifecycleRule{ID: src.ID, Status: lifecycleDisabled, Filter: &LifecycleFilter{Prefix: src.Prefix}}
+		)
+		if src.Enabled {
+			rule.Status = lifecycleEnabled
+		}
+		if src.ExpirationDays > 0 {
+			rule.Expiration = &LifecycleExpiration{Days: src.ExpirationDays}
+		}
+		if src.AbortMptDays > 0 {
+			rule.AbortMpt = &LifecycleAbortMpt{DaysAfterInitiation: src.AbortMptDays}
+		}
+		r.Rules = append(r.Rules, rule)
+	}
+	return r
+}
+
+func (r *LifecycleConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// convert to bucket props; the caller is expected to further validate the result
+// (see cmn.LifecycleConf.ValidateAsProps)
+func (r *LifecycleConfiguration) ToConf() (*cmn.LifecycleConf, error) {
+	if len(r.Rules) == 0 {
+		return nil, errors.New("lifecycle configuration must contain at least one rule")
+	}
+	conf := &cmn.LifecycleConf{Rules: make([]cmn.LifecycleRule, 0, len(r.Rules))}
+	for i := range r.Rules {
+		rule, err := r.Rules[i].toConf()
+		if err != nil {
+			return nil, fmt.Errorf("lifecycle rule #%d (%q): %w", i, r.Rules[i].ID, err)
+		}
+		conf.Rules = append(conf.Rules, rule)
+	}
+	return conf, nil
+}
+
+func (r *LifecycleRule) toConf() (rule cmn.LifecycleRule, _ error) {
+	rule.ID = r.ID
+	switch r.Status {
+	case lifecycleEnabled:
+		rule.Enabled = true
+	case lifecycleDisabled:
+	default:
+		return rule, fmt.Errorf("invalid status %q (expecting %q or %q)", r.Status, lifecycleEnabled, lifecycleDisabled)
+	}
+	switch {
+	case r.Filter != nil && r.Prefix != nil:
+		return rule, errors.New("filter and prefix are mutually exclusive")
+	case r.Filter != nil:
+		if r.Filter.Tag != nil || r.Filter.And != nil {
+			return rule, notImpl("tag-based filtering")
+		}
+		if r.Filter.SizeGreaterThan != nil || r.Filter.SizeLessThan != nil {
+			return rule, notImpl("size-based filtering")
+		}
+		rule.Prefix = r.Filter.Prefix
+	case r.Prefix != nil:
+		rule.Prefix = *r.Prefix
+	}
+	if len(r.Transitions) > 0 {
+		return rule, notImpl("storage class transitions")
+	}
+	if r.NoncurrentExpiration != nil || len(r.NoncurrentTransitions) > 0 {
+		return rule, notImpl("noncurrent-version actions")
+	}
+	if r.Expiration != nil {
+		if r.Expiration.Date != "" {
+			return rule, notImpl("expiration by date (use days)")
+		}
+		if r.Expiration.ExpiredObjectDeleteMarker != nil {
+			return rule, notImpl("expired-object delete marker")
+		}
+		if r.Expiration.Days <= 0 {
+			return rule, fmt.Errorf("invalid expiration days %d", r.Expiration.Days)
+		}
+		rule.ExpirationDays = r.Expiration.Days
+	}
+	if r.AbortMpt != nil {
+		if r.AbortMpt.DaysAfterInitiation <= 0 {
+			return rule, fmt.Errorf("invalid abort-incomplete-upload days %d", r.AbortMpt.DaysAfterInitiation)
+		}
+		rule.AbortMptDays = r.AbortMpt.DaysAfterInitiation
+	}
+	return rule, nil
+}
@@ -0,0 +1,103 @@
+// Package s3_test provides tests for the Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestLifecycleToConf(t *testing.T) {
+	const body = `<LifecycleConfiguration>
+  <Rule>
+    <ID>tmp</ID>
+    <Filter><Prefix>tmp/</Prefix></Filter>
+    <Status>Enabled</Status>
+    <Expiration><Days>7</Days></Expiration>
+  </Rule>
+  <Rule>
+    <ID>mpu</ID>
+    <Prefix></Prefix>
+    <Status>Disabled</Status>
+    <AbortIncompleteMultipartUpload><DaysAfterInitiation>3</DaysAfterInitiation></AbortIncompleteMultipartUpload>
+  </Rule>
+</LifecycleConfiguration>`
+
+	lc := &s3.LifecycleConfiguration{}
+	tassert.CheckFatal(t, xml.Unmarshal([]byte(body), lc))
+	conf, err := lc.ToConf()
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, conf.ValidateAsProps())
+
+	tassert.Fatalf(t, len(conf.Rules) == 2, "expected 2 rules, got %d", len(conf.Rules))
+	r0, r1 := conf.Rules[0], conf.Rules[1]
+	tassert.Fatalf(t, r0.ID == "tmp" && r0.Prefix == "tmp/" && r0.Enabled && r0.ExpirationDays == 7,
+		"unexpected rule #0: %+v", r0)
+	tassert.Fatalf(t, r1.ID == "mpu" && r1.Prefix == "" && !r1.Enabled && r1.AbortMptDays == 3,
+		"unexpected rule #1: %+v", r1)
+
+	tassert.Fatalf(t, conf.Expires(), "expected expiring rule")
+	tassert.Fatalf(t, !conf.AbortsMpt(), "abort-incomplete rule is disabled")
+	tassert.Fatalf(t, len(conf.Match("tmp/a")) == 1 && len(conf.Match("data/a")) == 0, "unexpected match")
+}
+
+func TestLifecycleRoundTrip(t *testing.T) {
+	conf := &cmn.LifecycleConf{Rules: []cmn.LifecycleRule{
+		{ID: "a", Prefix: "logs/", ExpirationDays: 30, AbortMptDays: 2, Enabled: true},
+	}}
+	sgl := memsys.PageMM().NewSGL(0)
+	defer sgl.Free()
+	s3.NewLifecycleConfiguration(conf).MustMarshal(sgl)
+
+	lc := &s3.LifecycleConfiguration{}
+	tassert.CheckFatal(t, xml.Unmarshal(sgl.Bytes(), lc))
+	out, err := lc.ToConf()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(out.Rules) == 1 && out.Rules[0] == conf.Rules[0],
+		"round trip mismatch: %+v vs %+v", out.Rules, conf.Rules)
+}
+
+func TestLifecycleUnsupported(t *testing.T) {
+	for _, body := range []string{
+		`<LifecycleConfiguration></LifecycleConfiguration>`,
+		`<LifecycleConfiguration><Rule><Status>Enabled</Status><Expiration><Date>2030-01-01T00:00:00Z</Date></Expiration></Rule></LifecycleConfiguration>`,
+		`<LifecycleConfiguration><Rule><Status>Enabled</Status><Filter><Tag><Key>k</Key><Value>v</Value></Tag></Filter><Expiration><Days>1</Days></Expiration></Rule></LifecycleConfiguration>`,
+		`<LifecycleConfiguration><Rule><Status>Enabled</Status><Transition><Days>1</Days></Transition></Rule></LifecycleConfiguration>`,
+		`<LifecycleConfiguration><Rule><Status>enabled</Status><Expiration><Days>1</Days></Expiration></Rule></LifecycleConfiguration>`,
+	} {
+		lc := &s3.LifecycleConfiguration{}
+		tassert.CheckFatal(t, xml.Unmarshal([]byte(body), lc))
+		_, err := lc.ToConf()
+		tassert.Fatalf(t, err != nil, "expected error for %s", body)
+	}
+}
+
+func TestLifecycleNotImpl(t *testing.T) {
+	for _, body := range []string{
+		`<LifecycleConfiguration><Rule><Status>Enabled</Status><Filter><ObjectSizeGreaterThan>1024</ObjectSizeGreaterThan></Filter><Expiration><Days>1</Days></Expiration></Rule></LifecycleConfiguration>`,
+		`<LifecycleConfiguration><Rule><Status>Enabled</Status><Filter><ObjectSizeLessThan>1024</ObjectSizeLessThan></Filter><Expiration><Days>1</Days></Expiration></Rule></LifecycleConfiguration>`,
+		`<LifecycleConfiguration><Rule><Status>Enabled</Status><NoncurrentVersionExpiration><NoncurrentDays>1</NoncurrentDays></NoncurrentVersionExpiration></Rule></LifecycleConfiguration>`,
+		`<LifecycleConfiguration><Rule><Status>Enabled</Status><NoncurrentVersionTransition><NoncurrentDays>1</NoncurrentDays></NoncurrentVersionTransition></Rule></LifecycleConfiguration>`,
+		`<LifecycleConfiguration><Rule><Status>Enabled</Status><Expiration><ExpiredObjectDeleteMarker>true</ExpiredObjectDeleteMarker></Expiration></Rule></LifecycleConfiguration>`,
+		`<LifecycleConfiguration><Rule><Status>Enabled</Status><Expiration><Days>1</Days><ExpiredObjectDeleteMarker>false</ExpiredObjectDeleteMarker></Expiration></Rule></LifecycleConfiguration>`,
+	} {
+		lc := &s3.LifecycleConfiguration{}
+		tassert.CheckFatal(t, xml.Unmarshal([]byte(body), lc))
+		_, err := lc.ToConf()
+		tassert.Fatalf(t, s3.IsErrLifecycleNotImpl(err), "expected not-implemented error for %s, got %v", body, err)
+	}
+
+	// invalid (rather than not supported)
+	lc := &s3.LifecycleConfiguration{}
+	body := `<LifecycleConfiguration><Rule><Status>enabled</Status><Expiration><Days>1</Days></Expiration></Rule></LifecycleConfiguration>`
+	tassert.CheckFatal(t, xml.Unmarshal([]byte(body), lc))
+	_, err := lc.ToConf()
+	tassert.Fatalf(t, err != nil && !s3.IsErrLifecycleNotImpl(err), "expected invalid-request error, got %v", err)
+}
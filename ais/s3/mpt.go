@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -148,3 +150,27 @@ slow:
 	}
 	return 0, nil
 }
+
+// parse `x-amz-copy-source-range: bytes=first-last` (UploadPartCopy);
+// returns the corresponding `Range` header value (empty when copying the entire object)
+// - both offsets are zero-based and inclusive; unlike HTTP ranges, open-ended and suffix ranges are not permitted
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPartCopy.html
+func ParseCopySrcRange(v string) (string, error) {
+	if v == "" {
+		return "", nil
+	}
+	spec, ok := strings.CutPrefix(v, cos.HdrRangeValPrefix)
+	if !ok {
+		return "", fmt.Errorf("invalid %s %q (expecting bytes=first-last)", cos.S3HdrObjSrcRange, v)
+	}
+	a, b, ok := strings.Cut(spec, "-")
+	if !ok {
+		return "", fmt.Errorf("invalid %s %q (expecting bytes=first-last)", cos.S3HdrObjSrcRange, v)
+	}
+	first, err1 := strconv.ParseInt(a, 10, 64)
+	last, err2 := strconv.ParseInt(b, 10, 64)
+	if err1 != nil || err2 != nil || first < 0 || last < first {
+		return "", fmt.Errorf("invalid %s %q (expecting bytes=first-last, first <= last)", cos.S3HdrObjSrcRange, v)
+	}
+	return v, nil
+}
@@ -0,0 +1,32 @@
+// Package s3_test provides tests for the Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestParseCopySrcRange(t *testing.T) {
+	for _, v := range []string{"", "bytes=0-0", "bytes=0-5242879", "bytes=100-200"} {
+		rng, err := s3.ParseCopySrcRange(v)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, rng == v, "expecting %q, got %q", v, rng)
+	}
+	for _, v := range []string{
+		"0-10",          // no unit
+		"bytes=10",      // no dash
+		"bytes=-10",     // suffix
+		"bytes=10-",     // open-ended
+		"bytes=10-9",    // reversed
+		"bytes=a-b",     // not a number
+		"bytes=0-1,3-4", // multi-range
+	} {
+		_, err := s3.ParseCopySrcRange(v)
+		tassert.Errorf(t, err != nil, "expecting %q to fail", v)
+	}
+}
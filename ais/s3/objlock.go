@@ -0,0 +1,239 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Object Lock (WORM): ais:// buckets only (see cmn/objlock)
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-lock.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectLockConfiguration.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectRetention.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectLegalHold.html
+
+const (
+	ObjLockConfigNotFound = "ObjectLockConfigurationNotFoundError"
+	NoSuchObjLockConfig   = "NoSuchObjectLockConfiguration"
+
+	objLockEnabled = "Enabled"
+)
+
+type (
+	ObjectLockConfiguration struct {
+		XMLName xml.Name     `xml:"ObjectLockConfiguration"`
+		Ns      string       `xml:"xmlns,attr,omitempty"`
+		Enabled string       `xml:"ObjectLockEnabled,omitempty"`
+		Rule    *ObjLockRule `xml:"Rule,omitempty"`
+	}
+	ObjLockRule struct {
+		DefaultRetention DefaultRetention `xml:"DefaultRetention"`
+	}
+	DefaultRetention struct {
+		Mode  string `xml:"Mode"`
+		Days  int    `xml:"Days,omitempty"`
+		Years int    `xml:"Years,omitempty"`
+	}
+
+	Retention struct {
+		XMLName xml.Name `xml:"Retention"`
+		Ns      string   `xml:"xmlns,attr,omitempty"`
+		Mode    string   `xml:"Mode,omitempty"`
+		Until   string   `xml:"RetainUntilDate,omitempty"`
+	}
+	LegalHold struct {
+		XMLName xml.Name `xml:"LegalHold"`
+		Ns      string   `xml:"xmlns,attr,omitempty"`
+		Status  string   `xml:"Status"`
+	}
+
+	// x-amz-object-lock-* request headers (PUT and multipart start)
+	ObjLockHdrs struct {
+		Until     time.Time
+		Mode      string
+		LegalHold bool
+	}
+)
+
+/////////////////////////////
+// ObjectLockConfiguration //
+/////////////////////////////
+
+func NewObjectLockConfiguration(conf *cmn.ObjLockConf) *ObjectLockConfiguration {
+	r := &ObjectLockConfiguration{Ns: s3Namespace, Enabled: objLockEnabled}
+	if conf.Mode != "" {
+		r.Rule = &ObjLockRule{DefaultRetention{Mode: conf.Mode, Days: conf.Days, Years: conf.Years}}
+	}
+	return r
+}
+
+func (r *ObjectLockConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// convert to bucket props; the caller is expected to further validate the result
+// (see cmn.ObjLockConf.ValidateAsProps)
+func (r *ObjectLockConfiguration) ToConf() (*cmn.ObjLockConf, error) {
+	if r.Enabled != objLockEnabled {
+		return nil, fmt.Errorf("invalid ObjectLockEnabled %q (expecting %q)", r.Enabled, objLockEnabled)
+	}
+	conf := &cmn.ObjLockConf{Enabled: true}
+	if r.Rule != nil {
+		dr := &r.Rule.DefaultRetention
+		if dr.Mode == "" {
+			return nil, errors.New("default retention requires mode")
+		}
+		conf.Mode, conf.Days, conf.Years = dr.Mode, dr.Days, dr.Years
+	}
+	return conf, nil
+}
+
+///////////////
+// Retention //
+///////////////
+
+func NewRetention(mode string, until time.Time) *Retention {
+	r := &Retention{Ns: s3Namespace, Mode: mode}
+	if mode != "" {
+		r.Until = until.UTC().Format(time.RFC3339)
+	}
+	return r
+}
+
+func (r *Retention) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// returns (mode, retain-until); empty mode removes retention
+func (r *Retention) Parse() (string, time.Time, error) {
+	if r.Mode == "" && r.Until == "" {
+		return "", time.Time{}, nil
+	}
+	return parseRetention(r.Mode, r.Until)
+}
+
+func parseRetention(mode, until string) (string, time.Time, error) {
+	if mode == "" || until == "" {
+		return "", time.Time{}, errors.New("retention mode and retain-until date must be specified together")
+	}
+	if err := cmn.ValidateRetentionMode(mode); err != nil {
+		return "", time.Time{}, err
+	}
+	t, err := time.Parse(time.RFC3339, until)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("invalid retain-until date %q: %v", until, err)
+	}
+	if !t.After(time.Now()) {
+		return "", time.Time{}, fmt.Errorf("retain-until date %s must be in the future", until)
+	}
+	return mode, t, nil
+}
+
+///////////////
+// LegalHold //
+///////////////
+
+func NewLegalHold(on bool) *LegalHold {
+	if on {
+		return &LegalHold{Ns: s3Namespace, Status: cmn.LegalHoldOn}
+	}
+	return &LegalHold{Ns: s3Namespace, Status: cmn.LegalHoldOff}
+}
+
+func (r *LegalHold) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+func (r *LegalHold) Parse() (bool, error) {
+	if err := cmn.ValidateLegalHold(r.Status); err != nil {
+		return false, err
+	}
+	return r.Status == cmn.LegalHoldOn, nil
+}
+
+/////////////////
+// ObjLockHdrs //
+/////////////////
+
+// parse x-amz-object-lock-* request headers; returns nil when there are none
+func ParseObjLockHdrs(hdr http.Header) (*ObjLockHdrs, error) {
+	var (
+		mode  = hdr.Get(cos.S3HdrObjLockMode)
+		until = hdr.Get(cos.S3HdrObjLockUntil)
+		hold  = hdr.Get(cos.S3HdrObjLegalHold)
+	)
+	if mode == "" && until == "" && hold == "" {
+		return nil, nil
+	}
+	h := &ObjLockHdrs{}
+	if mode != "" || until != "" {
+		var err error
+		if h.Mode, h.Until, err = parseRetention(mode, until); err != nil {
+			return nil, err
+		}
+	}
+	if hold != "" {
+		if err := cmn.ValidateLegalHold(hold); err != nil {
+			return nil, err
+		}
+		h.LegalHold = hold == cmn.LegalHoldOn
+	}
+	return h, nil
+}
+
+// apply to a new object (retention, if any, overrides the bucket's default - see core.PrewriteWORM)
+func (h *ObjLockHdrs) Set(lom *core.LOM) {
+	if h.Mode != "" {
+		lom.SetRetention(h.Mode, h.Until)
+	}
+	if h.LegalHold {
+		lom.SetLegalHold(true)
+	}
+}
+
+func (h *ObjLockHdrs) ToMD() cos.StrKVs {
+	md := make(cos.StrKVs, 3)
+	if h.Mode != "" {
+		md[cmn.ObjLockModeMD] = h.Mode
+		md[cmn.ObjLockUntilMD] = h.Until.UTC().Format(time.RFC3339)
+	}
+	if h.LegalHold {
+		md[cmn.ObjLegalHoldMD] = cmn.LegalHoldOn
+	}
+	return md
+}
+
+// x-amz-bypass-governance-retention: true
+func BypassGovernance(hdr http.Header) bool {
+	return strings.EqualFold(hdr.Get(cos.S3HdrBypassGovernance), "true")
+}
+
+// response headers (GET and HEAD)
+func setObjLockHeaders(hdr http.Header, lom *core.LOM) {
+	if mode, until := lom.Retention(); mode != "" {
+		hdr.Set(cos.S3HdrObjLockMode, mode)
+		hdr.Set(cos.S3HdrObjLockUntil, until.UTC().Format(time.RFC3339))
+	}
+	if lom.LegalHold() {
+		hdr.Set(cos.S3HdrObjLegalHold, cmn.LegalHoldOn)
+	}
+}
@@ -0,0 +1,120 @@
+// Package s3_test provides tests for the Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestObjLockConfiguration(t *testing.T) {
+	const body = `<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled>` +
+		`<Rule><DefaultRetention><Mode>COMPLIANCE</Mode><Years>2</Years></DefaultRetention></Rule></ObjectLockConfiguration>`
+	olc := &s3.ObjectLockConfiguration{}
+	tassert.CheckFatal(t, xml.Unmarshal([]byte(body), olc))
+	conf, err := olc.ToConf()
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, conf.ValidateAsProps())
+	tassert.Fatalf(t, conf.Enabled && conf.Mode == cmn.ObjLockCompliance && conf.Years == 2, "unexpected %+v", conf)
+
+	now := time.Now()
+	mode, until := conf.DefaultRetention(now)
+	tassert.Fatalf(t, mode == cmn.ObjLockCompliance && until.Equal(now.AddDate(2, 0, 0)), "unexpected default retention")
+
+	// round-trip
+	b, err := xml.Marshal(s3.NewObjectLockConfiguration(conf))
+	tassert.CheckFatal(t, err)
+	olc = &s3.ObjectLockConfiguration{}
+	tassert.CheckFatal(t, xml.Unmarshal(b, olc))
+	conf2, err := olc.ToConf()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, *conf2 == *conf, "round-trip: %+v vs %+v", conf2, conf)
+
+	// invalid
+	for _, body := range []string{
+		`<ObjectLockConfiguration><ObjectLockEnabled>Disabled</ObjectLockEnabled></ObjectLockConfiguration>`,
+		`<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>STRICT</Mode><Days>1</Days></DefaultRetention></Rule></ObjectLockConfiguration>`,
+		`<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>1</Days><Years>1</Years></DefaultRetention></Rule></ObjectLockConfiguration>`,
+		`<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>GOVERNANCE</Mode></DefaultRetention></Rule></ObjectLockConfiguration>`,
+	} {
+		olc := &s3.ObjectLockConfiguration{}
+		tassert.CheckFatal(t, xml.Unmarshal([]byte(body), olc))
+		conf, err := olc.ToConf()
+		if err == nil {
+			err = conf.ValidateAsProps()
+		}
+		tassert.Errorf(t, err != nil, "expecting %s to fail", body)
+	}
+}
+
+func TestObjLockRetention(t *testing.T) {
+	until := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	r := &s3.Retention{}
+	b, err := xml.Marshal(s3.NewRetention(cmn.ObjLockGovernance, until))
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, xml.Unmarshal(b, r))
+	mode, u, err := r.Parse()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, mode == cmn.ObjLockGovernance && u.Equal(until), "unexpected (%s, %s)", mode, u)
+
+	// AWS SDKs send milliseconds
+	r = &s3.Retention{Mode: cmn.ObjLockCompliance, Until: until.Format("2006-01-02T15:04:05.000Z")}
+	_, u, err = r.Parse()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, u.Equal(until), "unexpected %s", u)
+
+	for _, r := range []*s3.Retention{
+		{Mode: cmn.ObjLockGovernance},
+		{Mode: "LEGAL", Until: until.Format(time.RFC3339)},
+		{Mode: cmn.ObjLockGovernance, Until: "tomorrow"},
+		{Mode: cmn.ObjLockGovernance, Until: time.Now().Add(-time.Hour).Format(time.RFC3339)},
+	} {
+		_, _, err := r.Parse()
+		tassert.Errorf(t, err != nil, "expecting %+v to fail", r)
+	}
+
+	var hold s3.LegalHold
+	tassert.CheckFatal(t, xml.Unmarshal([]byte("<LegalHold><Status>ON</Status></LegalHold>"), &hold))
+	on, err := hold.Parse()
+	tassert.Fatalf(t, on && err == nil, "expecting legal hold ON, got (%t, %v)", on, err)
+	hold.Status = "on"
+	_, err = hold.Parse()
+	tassert.Errorf(t, err != nil, "expecting invalid legal hold status")
+}
+
+func TestObjLockHeaders(t *testing.T) {
+	h, err := s3.ParseObjLockHdrs(http.Header{})
+	tassert.Fatalf(t, h == nil && err == nil, "expecting no object lock headers")
+
+	until := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	hdr := http.Header{}
+	hdr.Set(cos.S3HdrObjLockMode, cmn.ObjLockCompliance)
+	hdr.Set(cos.S3HdrObjLockUntil, until)
+	hdr.Set(cos.S3HdrObjLegalHold, cmn.LegalHoldOn)
+	h, err = s3.ParseObjLockHdrs(hdr)
+	tassert.CheckFatal(t, err)
+	md := h.ToMD()
+	tassert.Fatalf(t, md[cmn.ObjLockModeMD] == cmn.ObjLockCompliance && md[cmn.ObjLockUntilMD] == until &&
+		md[cmn.ObjLegalHoldMD] == cmn.LegalHoldOn, "unexpected %v", md)
+
+	hdr.Del(cos.S3HdrObjLockUntil)
+	_, err = s3.ParseObjLockHdrs(hdr)
+	tassert.Errorf(t, err != nil, "expecting mode without retain-until date to fail")
+
+	hdr = http.Header{}
+	hdr.Set(cos.S3HdrObjLegalHold, cmn.LegalHoldOff)
+	h, err = s3.ParseObjLockHdrs(hdr)
+	tassert.Fatalf(t, err == nil && len(h.ToMD()) == 0, "expecting legal hold OFF to be a no-op")
+
+	hdr.Set(cos.S3HdrBypassGovernance, "True")
+	tassert.Errorf(t, s3.BypassGovernance(hdr), "expecting bypass")
+}
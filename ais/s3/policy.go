@@ -0,0 +1,241 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket policy
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketPolicy.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/example-bucket-policies.html
+//
+// Supported subset:
+// - Effect: Allow | Deny
+// - Principal: "*", {"AWS": ...} where each value is "*", an IAM user ARN
+//   (e.g. "arn:aws:iam::123456789012:user/alice"), or a plain user name; user names
+//   are matched against AIS (AuthN) user names
+// - Action: see `policyActions` below (case-insensitive); "s3:*"
+// - Resource: "arn:aws:s3:::<bucket>" and "arn:aws:s3:::<bucket>/<pattern>", where pattern
+//   is "*", "<prefix>*", or an exact object name
+// Not supported (rejected): NotPrincipal, NotAction, NotResource, and Condition.
+
+const (
+	NoSuchBucketPolicy = "NoSuchBucketPolicy"
+	MalformedPolicy    = "MalformedPolicy"
+
+	policyVersion = "2012-10-17"
+	arnS3Prefix   = "arn:aws:s3:::"
+	arnIAMPrefix  = "arn:aws:iam::"
+	principalAWS  = "AWS"
+)
+
+type policyAction struct {
+	access apc.AccessAttrs
+	obj    bool // object-level (vs bucket-level) action
+}
+
+var policyActions = map[string]policyAction{
+	"s3:getobject":                  {apc.AceGET | apc.AceObjHEAD, true},
+	"s3:putobject":                  {apc.AcePUT | apc.AceAPPEND, true},
+	"s3:deleteobject":               {apc.AceObjDELETE, true},
+	"s3:getobjecttagging":           {apc.AceObjHEAD, true},
+	"s3:putobjecttagging":           {apc.AceObjUpdate, true},
+	"s3:deleteobjecttagging":        {apc.AceObjUpdate, true},
+	"s3:listbucket":                 {apc.AceObjLIST | apc.AceBckHEAD, false},
+	"s3:listbucketmultipartuploads": {apc.AceObjLIST, false},
+	"s3:getbucketlocation":          {apc.AceBckHEAD, false},
+}
+
+type (
+	Policy struct {
+		Version   string            `json:"Version,omitempty"`
+		ID        string            `json:"Id,omitempty"`
+		Statement []PolicyStatement `json:"Statement"`
+	}
+	PolicyStatement struct {
+		Sid       string          `json:"Sid,omitempty"`
+		Effect    string          `json:"Effect"`
+		Principal PolicyPrincipal `json:"Principal"`
+		Action    strList         `json:"Action"`
+		Resource  strList         `json:"Resource"`
+
+		// not supported
+		NotPrincipal json.RawMessage `json:"NotPrincipal,omitempty"`
+		NotAction    json.RawMessage `json:"NotAction,omitempty"`
+		NotResource  json.RawMessage `json:"NotResource,omitempty"`
+		Condition    json.RawMessage `json:"Condition,omitempty"`
+	}
+
+	// "*" or {"AWS": "..." | [...]}
+	PolicyPrincipal []string
+
+	// JSON string or array of strings
+	strList []string
+)
+
+func NewPolicy(conf *cmn.PolicyConf, bucket string) *Policy {
+	p := &Policy{Version: policyVersion, Statement: make([]PolicyStatement, 0, len(conf.Statements))}
+	for i := range conf.Statements {
+		src := &conf.Statements[i]
+		stmt := PolicyStatement{
+			Sid:       src.Sid,
+			Effect:    src.Effect,
+			Principal: src.Principals,
+			Action:    src.Actions,
+		}
+		if src.Bucket {
+			stmt.Resource = append(stmt.Resource, arnS3Prefix+bucket)
+		}
+		for _, pattern := range src.Objects {
+			stmt.Resource = append(stmt.Resource, arnS3Prefix+bucket+"/"+pattern)
+		}
+		p.Statement = append(p.Statement, stmt)
+	}
+	return p
+}
+
+// convert to bucket props; the caller is expected to further validate the result
+// (see cmn.PolicyConf.ValidateAsProps)
+func (p *Policy) ToConf(bucket string) (*cmn.PolicyConf, error) {
+	if len(p.Statement) == 0 {
+		return nil, errors.New("policy must contain at least one statement")
+	}
+	conf := &cmn.PolicyConf{Statements: make([]cmn.PolicyStatement, 0, len(p.Statement))}
+	for i := range p.Statement {
+		stmt, err := p.Statement[i].toConf(bucket)
+		if err != nil {
+			return nil, fmt.Errorf("policy statement #%d (%q): %w", i, p.Statement[i].Sid, err)
+		}
+		conf.Statements = append(conf.Statements, stmt)
+	}
+	return conf, nil
+}
+
+func (s *PolicyStatement) toConf(bucket string) (stmt cmn.PolicyStatement, _ error) {
+	switch {
+	case s.NotPrincipal != nil || s.NotAction != nil || s.NotResource != nil:
+		return stmt, errors.New("NotPrincipal, NotAction, and NotResource are not supported")
+	case s.Condition != nil:
+		return stmt, errors.New("conditions are not supported")
+	case len(s.Action) == 0:
+		return stmt, errors.New("missing action")
+	case len(s.Resource) == 0:
+		return stmt, errors.New("missing resource")
+	}
+	stmt.Sid, stmt.Effect, stmt.Principals, stmt.Actions = s.Sid, s.Effect, s.Principal, s.Action
+
+	// resources
+	for _, res := range s.Resource {
+		name, ok := strings.CutPrefix(res, arnS3Prefix)
+		if !ok {
+			return stmt, fmt.Errorf("invalid resource %q (expecting %q prefix)", res, arnS3Prefix)
+		}
+		bname, pattern, isObj := strings.Cut(name, "/")
+		if bname != bucket {
+			return stmt, fmt.Errorf("resource %q does not belong to bucket %q", res, bucket)
+		}
+		if !isObj {
+			stmt.Bucket = true
+			continue
+		}
+		stmt.Objects = append(stmt.Objects, pattern)
+	}
+
+	// actions => access
+	var hasObj, hasBck bool
+	for _, action := range s.Action {
+		action = strings.ToLower(action)
+		if action == "s3:*" {
+			stmt.Access |= apc.AccessRW | apc.AceObjUpdate // (applies to whatever resources are specified)
+			continue
+		}
+		pa, ok := policyActions[action]
+		if !ok {
+			return stmt, fmt.Errorf("action %q is not supported", action)
+		}
+		stmt.Access |= pa.access
+		if pa.obj {
+			hasObj = true
+		} else {
+			hasBck = true
+		}
+	}
+	if (hasObj && len(stmt.Objects) == 0) || (hasBck && !stmt.Bucket) {
+		return stmt, errors.New("action does not apply to any resource(s) in the statement")
+	}
+	return stmt, nil
+}
+
+/////////////////////
+// PolicyPrincipal //
+/////////////////////
+
+func (pp *PolicyPrincipal) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		if s != cmn.PolicyAnyone {
+			return fmt.Errorf("invalid principal %q (expecting %q or {%q: ...})", s, cmn.PolicyAnyone, principalAWS)
+		}
+		*pp = PolicyPrincipal{cmn.PolicyAnyone}
+		return nil
+	}
+	var m map[string]strList
+	if err := json.Unmarshal(b, &m); err != nil {
+		return fmt.Errorf("invalid principal %s: %v", cos.BHead(b), err)
+	}
+	for k, vals := range m {
+		if k != principalAWS {
+			return fmt.Errorf("principal type %q is not supported (expecting %q)", k, principalAWS)
+		}
+		for _, v := range vals {
+			*pp = append(*pp, principalName(v))
+		}
+	}
+	return nil
+}
+
+func (pp PolicyPrincipal) MarshalJSON() ([]byte, error) {
+	if len(pp) == 1 && pp[0] == cmn.PolicyAnyone {
+		return json.Marshal(cmn.PolicyAnyone)
+	}
+	return json.Marshal(map[string][]string{principalAWS: pp})
+}
+
+// "arn:aws:iam::123456789012:user/alice" => "alice"
+func principalName(v string) string {
+	if !strings.HasPrefix(v, arnIAMPrefix) {
+		return v
+	}
+	if i := strings.LastIndexByte(v, '/'); i >= 0 {
+		return v[i+1:]
+	}
+	return v[strings.LastIndexByte(v, ':')+1:]
+}
+
+/////////////
+// strList //
+/////////////
+
+func (l *strList) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*l = strList{s}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(b, &list); err != nil {
+		return err
+	}
+	*l = list
+	return nil
+}
@@ -0,0 +1,125 @@
+// Package s3_test provides tests for the Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const policyBody = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "PublicRead",
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": ["s3:GetObject", "s3:ListBucket"],
+      "Resource": ["arn:aws:s3:::data", "arn:aws:s3:::data/public/*"]
+    },
+    {
+      "Sid": "Writers",
+      "Effect": "Allow",
+      "Principal": {"AWS": ["arn:aws:iam::123456789012:user/alice", "bob"]},
+      "Action": "s3:*",
+      "Resource": "arn:aws:s3:::data/*"
+    },
+    {
+      "Sid": "ProtectGold",
+      "Effect": "Deny",
+      "Principal": {"AWS": "*"},
+      "Action": "s3:DeleteObject",
+      "Resource": "arn:aws:s3:::data/gold/*"
+    }
+  ]
+}`
+
+func TestPolicyEvaluate(t *testing.T) {
+	policy := &s3.Policy{}
+	tassert.CheckFatal(t, json.Unmarshal([]byte(policyBody), policy))
+	conf, err := policy.ToConf("data")
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, conf.ValidateAsProps())
+
+	tests := []struct {
+		principal, objName string
+		ace                apc.AccessAttrs
+		allowed, denied    bool
+	}{
+		{"", "public/a.jpg", apc.AceGET, true, false},
+		{"", "private/a.jpg", apc.AceGET, false, false},
+		{"", "", apc.AceObjLIST, true, false},
+		{"", "public/a.jpg", apc.AcePUT, false, false},
+		{"alice", "private/a.jpg", apc.AcePUT, true, false},
+		{"bob", "x", apc.AceObjDELETE, true, false},
+		{"carol", "x", apc.AceObjDELETE, false, false},
+		{"alice", "gold/x", apc.AceObjDELETE, false, true},
+		{"alice", "", apc.AceObjDELETE, false, true}, // (unknown object name: conservative)
+		{"alice", "", apc.AcePATCH, false, false},
+	}
+	for _, test := range tests {
+		allowed, denied := conf.Evaluate(test.principal, test.objName, test.ace)
+		tassert.Fatalf(t, allowed == test.allowed && denied == test.denied,
+			"%+v: got allowed=%t, denied=%t", test, allowed, denied)
+	}
+
+	// list and multi-object operations (prefix)
+	prefixTests := []struct {
+		principal, prefix string
+		ace               apc.AccessAttrs
+		allowed, denied   bool
+	}{
+		{"", "public/", apc.AceGET, true, false},
+		{"", "public/2026/", apc.AceGET, true, false},
+		{"", "pub", apc.AceGET, false, false}, // (may include non-public objects)
+		{"alice", "silver/", apc.AceObjDELETE, true, false},
+		{"alice", "gold/2026/", apc.AceObjDELETE, false, true},
+		{"alice", "go", apc.AceObjDELETE, false, true}, // (may include gold/*)
+		{"alice", "", apc.AceObjDELETE, false, true},
+	}
+	for _, test := range prefixTests {
+		allowed, denied := conf.EvaluatePrefix(test.principal, test.prefix, test.ace)
+		tassert.Fatalf(t, allowed == test.allowed && denied == test.denied,
+			"%+v: got allowed=%t, denied=%t", test, allowed, denied)
+	}
+
+	// round trip
+	b, err := json.Marshal(s3.NewPolicy(conf, "data"))
+	tassert.CheckFatal(t, err)
+	out := &s3.Policy{}
+	tassert.CheckFatal(t, json.Unmarshal(b, out))
+	conf2, err := out.ToConf("data")
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(conf2.Statements) == 3 && conf2.Statements[1].Principals[0] == "alice" &&
+		conf2.Statements[0].Access == conf.Statements[0].Access, "round trip mismatch: %s", string(b))
+}
+
+func TestPolicyUnsupported(t *testing.T) {
+	for _, body := range []string{
+		`{"Statement":[]}`,
+		`{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::other/*"}]}`,
+		`{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:PutBucketAcl","Resource":"arn:aws:s3:::data"}]}`,
+		`{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::data"}]}`,
+		`{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::data/*",
+			"Condition":{"IpAddress":{"aws:SourceIp":"10.0.0.0/8"}}}]}`,
+		`{"Statement":[{"Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::data/*"}]}`,
+		`{"Statement":[{"Effect":"Maybe","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::data/*"}]}`,
+	} {
+		policy := &s3.Policy{}
+		err := json.Unmarshal([]byte(body), policy)
+		if err == nil {
+			var conf interface{ ValidateAsProps(...any) error }
+			conf, err = policy.ToConf("data")
+			if err == nil {
+				err = conf.ValidateAsProps()
+			}
+		}
+		tassert.Fatalf(t, err != nil, "expected error for %s", body)
+	}
+}
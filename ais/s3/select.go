@@ -0,0 +1,555 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// SelectObjectContent: SQL subset (see selectsql.go) over CSV and JSON objects
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_SelectObjectContent.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/s3-select-sql-reference.html
+//
+// The query runs on the target that stores the object; the response is
+// a stream of Records events followed by Stats and End (see eventstream.go).
+// Once the response has started, errors are reported as an error event.
+
+const (
+	SelectType2 = "2" // the only `select-type` that S3 defines
+
+	selectSQL    = "SQL"
+	selectNone   = "NONE"
+	selectGzip   = "GZIP"
+	selectBzip2  = "BZIP2"
+	selectUse    = "USE"
+	selectIgnore = "IGNORE"
+	selectLines  = "LINES"
+	selectDoc    = "DOCUMENT"
+	selectAlways = "ALWAYS"
+
+	selectFlushSize = 256 * 1024
+)
+
+type (
+	SelectRequest struct {
+		query *selectQuery // (parsed Expression)
+
+		XMLName             xml.Name         `xml:"SelectObjectContentRequest"`
+		Expression          string           `xml:"Expression"`
+		ExpressionType      string           `xml:"ExpressionType"`
+		InputSerialization  SelectInput      `xml:"InputSerialization"`
+		OutputSerialization SelectOutput     `xml:"OutputSerialization"`
+		ScanRange           *SelectScanRange `xml:"ScanRange"`
+	}
+	SelectInput struct {
+		CSV             *SelectCSVInput  `xml:"CSV"`
+		JSON            *SelectJSONInput `xml:"JSON"`
+		Parquet         *struct{}        `xml:"Parquet"`
+		CompressionType string           `xml:"CompressionType"`
+	}
+	SelectCSVInput struct {
+		FileHeaderInfo             string `xml:"FileHeaderInfo"`
+		Comments                   string `xml:"Comments"`
+		QuoteEscapeCharacter       string `xml:"QuoteEscapeCharacter"`
+		RecordDelimiter            string `xml:"RecordDelimiter"`
+		FieldDelimiter             string `xml:"FieldDelimiter"`
+		QuoteCharacter             string `xml:"QuoteCharacter"`
+		AllowQuotedRecordDelimiter bool   `xml:"AllowQuotedRecordDelimiter"`
+	}
+	SelectJSONInput struct {
+		Type string `xml:"Type"`
+	}
+	SelectOutput struct {
+		CSV  *SelectCSVOutput  `xml:"CSV"`
+		JSON *SelectJSONOutput `xml:"JSON"`
+	}
+	SelectCSVOutput struct {
+		QuoteFields     string `xml:"QuoteFields"`
+		RecordDelimiter string `xml:"RecordDelimiter"`
+		FieldDelimiter  string `xml:"FieldDelimiter"`
+		QuoteCharacter  string `xml:"QuoteCharacter"`
+	}
+	SelectJSONOutput struct {
+		RecordDelimiter string `xml:"RecordDelimiter"`
+	}
+	SelectScanRange struct {
+		Start int64 `xml:"Start"`
+		End   int64 `xml:"End"`
+	}
+)
+
+// (internal) evaluation
+type (
+	selectRun struct {
+		req    *SelectRequest
+		es     eventStream
+		out    bytes.Buffer
+		row    []selectField
+		header map[string]int // CSV FileHeaderInfo=USE: column name => index
+		names  []string       // ditto, in order
+		cnt    int64          // matching records
+		nout   int64          // bytes returned
+	}
+	selectField struct {
+		name string
+		v    sqlVal
+	}
+	csvRec struct {
+		run    *selectRun
+		fields []string
+	}
+	jsonRec struct {
+		m    map[string]any
+		keys []string // (top-level, in order)
+	}
+	countingReader struct {
+		r io.Reader
+		n int64
+	}
+)
+
+// validate and parse the SQL expression
+func (req *SelectRequest) Validate() (err error) {
+	if req.ExpressionType != selectSQL {
+		return fmt.Errorf("invalid expression type %q (expecting %q)", req.ExpressionType, selectSQL)
+	}
+	if req.ScanRange != nil {
+		return errors.New("scan range is not supported")
+	}
+	in := &req.InputSerialization
+	switch ct := strings.ToUpper(in.CompressionType); ct {
+	case "", selectNone, selectGzip, selectBzip2:
+	default:
+		return fmt.Errorf("unsupported compression type %q", in.CompressionType)
+	}
+	switch {
+	case in.Parquet != nil:
+		return errors.New("Parquet input is not supported")
+	case in.CSV != nil && in.JSON != nil, in.CSV == nil && in.JSON == nil:
+		return errors.New("input serialization must specify either CSV or JSON")
+	case in.CSV != nil:
+		if err := in.CSV.validate(); err != nil {
+			return err
+		}
+	default:
+		switch strings.ToUpper(in.JSON.Type) {
+		case "", selectLines, selectDoc:
+		default:
+			return fmt.Errorf("invalid JSON type %q", in.JSON.Type)
+		}
+	}
+	out := &req.OutputSerialization
+	if (out.CSV != nil) == (out.JSON != nil) {
+		return errors.New("output serialization must specify either CSV or JSON")
+	}
+	if out.CSV != nil && len(out.CSV.QuoteCharacter) > 1 {
+		return fmt.Errorf("invalid output quote character %q", out.CSV.QuoteCharacter)
+	}
+	req.query, err = parseSelect(req.Expression)
+	return err
+}
+
+func (in *SelectCSVInput) validate() error {
+	if in.QuoteCharacter != "" && in.QuoteCharacter != `"` {
+		return fmt.Errorf("unsupported quote character %q", in.QuoteCharacter)
+	}
+	if in.QuoteEscapeCharacter != "" && in.QuoteEscapeCharacter != `"` {
+		return fmt.Errorf("unsupported quote escape character %q", in.QuoteEscapeCharacter)
+	}
+	switch in.RecordDelimiter {
+	case "", "\n", "\r\n":
+	default:
+		return fmt.Errorf("unsupported record delimiter %q", in.RecordDelimiter)
+	}
+	if in.FieldDelimiter != "" && utf8.RuneCountInString(in.FieldDelimiter) != 1 {
+		return fmt.Errorf("invalid field delimiter %q", in.FieldDelimiter)
+	}
+	if in.Comments != "" && utf8.RuneCountInString(in.Comments) != 1 {
+		return fmt.Errorf("invalid comments character %q", in.Comments)
+	}
+	switch strings.ToUpper(in.FileHeaderInfo) {
+	case "", selectNone, selectUse, selectIgnore:
+	default:
+		return fmt.Errorf("invalid file header info %q", in.FileHeaderInfo)
+	}
+	return nil
+}
+
+// run the (validated) request over the object's content `r` and write
+// the resulting event stream to `w`
+func (req *SelectRequest) Run(w io.Writer, r io.Reader) (err error) {
+	var (
+		run = &selectRun{req: req, es: eventStream{w: w}}
+		rc  = &countingReader{r: r}
+		rp  = &countingReader{}
+	)
+	switch strings.ToUpper(req.InputSerialization.CompressionType) {
+	case selectGzip:
+		var gzr *gzip.Reader
+		if gzr, err = gzip.NewReader(rc); err != nil {
+			return run.fail("InvalidCompressionFormat", err)
+		}
+		defer gzr.Close()
+		rp.r = gzr
+	case selectBzip2:
+		rp.r = bzip2.NewReader(rc)
+	default:
+		rp.r = rc
+	}
+
+	if req.InputSerialization.CSV != nil {
+		err = run.csv(rp)
+	} else {
+		err = run.json(rp)
+	}
+	if err != nil {
+		return err
+	}
+	if req.query.count {
+		cnt := strconv.FormatInt(run.cnt, 10)
+		run.row = append(run.row[:0], selectField{name: "_1", v: newJSONVal(json.Number(cnt))})
+		run.emit()
+	}
+	if err = run.flush(); err != nil {
+		return err
+	}
+	stats := fmt.Sprintf("<Stats><BytesScanned>%d</BytesScanned><BytesProcessed>%d</BytesProcessed>"+
+		"<BytesReturned>%d</BytesReturned></Stats>", rc.n, rp.n, run.nout)
+	if err = run.es.stats([]byte(stats)); err != nil {
+		return err
+	}
+	err = run.es.end()
+	run.sync()
+	return err
+}
+
+func (run *selectRun) csv(r io.Reader) error {
+	var (
+		in  = run.req.InputSerialization.CSV
+		cr  = csv.NewReader(r)
+		hdr = strings.ToUpper(in.FileHeaderInfo)
+	)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	cr.ReuseRecord = true
+	if in.FieldDelimiter != "" {
+		cr.Comma, _ = utf8.DecodeRuneInString(in.FieldDelimiter)
+	}
+	if in.Comments != "" {
+		cr.Comment, _ = utf8.DecodeRuneInString(in.Comments)
+	}
+	rec := &csvRec{run: run}
+	for first := true; ; first = false {
+		fields, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return run.fail("CSVParsingError", err)
+		}
+		if first && (hdr == selectUse || hdr == selectIgnore) {
+			if hdr == selectUse {
+				run.header = make(map[string]int, len(fields))
+				run.names = make([]string, len(fields))
+				for i, name := range fields {
+					run.names[i] = name
+					run.header[name] = i
+				}
+			}
+			continue
+		}
+		rec.fields = fields
+		if done, err := run.process(rec); done || err != nil {
+			return err
+		}
+	}
+}
+
+func (run *selectRun) json(r io.Reader) error {
+	dec := json.NewDecoder(bufio.NewReader(r))
+	dec.UseNumber()
+	for {
+		var raw json.RawMessage
+		err := dec.Decode(&raw)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return run.fail("JSONParsingError", err)
+		}
+		// DOCUMENT: top-level array of records
+		if raw = bytes.TrimSpace(raw); len(raw) > 0 && raw[0] == '[' {
+			var arr []json.RawMessage
+			if err := json.Unmarshal(raw, &arr); err != nil {
+				return run.fail("JSONParsingError", err)
+			}
+			for _, elem := range arr {
+				if done, err := run.jsonRec(elem); done || err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if done, err := run.jsonRec(raw); done || err != nil {
+			return err
+		}
+	}
+}
+
+func (run *selectRun) jsonRec(raw json.RawMessage) (bool, error) {
+	rec, err := decodeJSONRec(raw)
+	if err != nil {
+		return true, run.fail("JSONParsingError", err)
+	}
+	return run.process(rec)
+}
+
+// decode JSON object preserving the order of its top-level keys
+func decodeJSONRec(raw json.RawMessage) (*jsonRec, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != '{' {
+		return nil, fmt.Errorf("expecting JSON object, got %q", cutJSON(raw))
+	}
+	rec := &jsonRec{m: make(map[string]any, 8)}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key := tok.(string)
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			return nil, err
+		}
+		if _, ok := rec.m[key]; !ok {
+			rec.keys = append(rec.keys, key)
+		}
+		rec.m[key] = v
+	}
+	return rec, nil
+}
+
+func cutJSON(raw []byte) string {
+	if len(raw) > 32 {
+		return string(raw[:32]) + "..."
+	}
+	return string(raw)
+}
+
+// filter, project, and buffer a single record; returns true when the LIMIT is reached
+func (run *selectRun) process(rec selectRec) (bool, error) {
+	q := run.req.query
+	if q.limit >= 0 && run.cnt >= q.limit && !q.count {
+		return true, nil
+	}
+	if q.where != nil && !q.where.match(rec) {
+		return false, nil
+	}
+	run.cnt++
+	if q.count {
+		return false, nil
+	}
+	run.row = run.row[:0]
+	if q.cols == nil {
+		run.row = rec.(interface {
+			all([]selectField) []selectField
+		}).all(run.row)
+	} else {
+		for _, col := range q.cols {
+			run.row = append(run.row, selectField{name: col.name, v: rec.get(col.ref)})
+		}
+	}
+	run.emit()
+	if run.out.Len() >= selectFlushSize {
+		if err := run.flush(); err != nil {
+			return true, err
+		}
+	}
+	return q.limit >= 0 && run.cnt >= q.limit, nil
+}
+
+// serialize the current row
+func (run *selectRun) emit() {
+	out := &run.req.OutputSerialization
+	if out.JSON != nil {
+		run.out.WriteByte('{')
+		for i, f := range run.row {
+			if i > 0 {
+				run.out.WriteByte(',')
+			}
+			b, _ := json.Marshal(f.name)
+			run.out.Write(b)
+			run.out.WriteByte(':')
+			switch {
+			case f.v.null:
+				run.out.WriteString("null")
+			case f.v.raw != nil:
+				b, _ = json.Marshal(f.v.raw)
+				run.out.Write(b)
+			default:
+				b, _ = json.Marshal(f.v.s)
+				run.out.Write(b)
+			}
+		}
+		run.out.WriteByte('}')
+		run.out.WriteString(cos.Left(out.JSON.RecordDelimiter, "\n"))
+		return
+	}
+
+	var (
+		delim  = cos.Left(out.CSV.FieldDelimiter, ",")
+		quote  = cos.Left(out.CSV.QuoteCharacter, `"`)
+		always = strings.EqualFold(out.CSV.QuoteFields, selectAlways)
+		rdelim = cos.Left(out.CSV.RecordDelimiter, "\n")
+	)
+	for i, f := range run.row {
+		if i > 0 {
+			run.out.WriteString(delim)
+		}
+		if f.v.null {
+			continue
+		}
+		s := f.v.s
+		if always || strings.Contains(s, delim) || strings.Contains(s, quote) || strings.ContainsAny(s, "\r\n") {
+			run.out.WriteString(quote)
+			run.out.WriteString(strings.ReplaceAll(s, quote, quote+quote))
+			run.out.WriteString(quote)
+		} else {
+			run.out.WriteString(s)
+		}
+	}
+	run.out.WriteString(rdelim)
+}
+
+func (run *selectRun) flush() error {
+	if run.out.Len() == 0 {
+		return nil
+	}
+	run.nout += int64(run.out.Len())
+	err := run.es.records(run.out.Bytes())
+	run.out.Reset()
+	run.sync()
+	return err
+}
+
+func (run *selectRun) sync() {
+	if f, ok := run.es.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// report error event (the response is already under way)
+func (run *selectRun) fail(code string, err error) error {
+	if errN := run.es.error(code, err.Error()); errN != nil {
+		return errN
+	}
+	run.sync()
+	return err
+}
+
+////////////
+// csvRec //
+////////////
+
+func (rec *csvRec) get(ref *colRef) sqlVal {
+	idx := ref.idx
+	if idx < 0 && len(ref.path) == 1 && rec.run.header != nil {
+		name := ref.path[0]
+		i, ok := rec.run.header[name]
+		if !ok && !ref.quoted[0] {
+			for j, n := range rec.run.names {
+				if strings.EqualFold(n, name) {
+					i, ok = j, true
+					break
+				}
+			}
+		}
+		if ok {
+			idx = i
+		}
+	}
+	if idx < 0 || idx >= len(rec.fields) {
+		return sqlVal{null: true}
+	}
+	return newStrVal(rec.fields[idx])
+}
+
+func (rec *csvRec) all(row []selectField) []selectField {
+	for i, s := range rec.fields {
+		var name string
+		if i < len(rec.run.names) {
+			name = rec.run.names[i]
+		} else {
+			name = "_" + strconv.Itoa(i+1)
+		}
+		row = append(row, selectField{name: name, v: sqlVal{s: s}})
+	}
+	return row
+}
+
+/////////////
+// jsonRec //
+/////////////
+
+func (rec *jsonRec) get(ref *colRef) sqlVal {
+	var cur any = rec.m
+	for i, key := range ref.path {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return sqlVal{null: true}
+		}
+		v, ok := m[key]
+		if !ok && !ref.quoted[i] {
+			for k, vv := range m {
+				if strings.EqualFold(k, key) {
+					v, ok = vv, true
+					break
+				}
+			}
+		}
+		if !ok {
+			return sqlVal{null: true}
+		}
+		cur = v
+	}
+	return newJSONVal(cur)
+}
+
+func (rec *jsonRec) all(row []selectField) []selectField {
+	for _, key := range rec.keys {
+		row = append(row, selectField{name: key, v: newJSONVal(rec.m[key])})
+	}
+	return row
+}
+
+////////////////////
+// countingReader //
+////////////////////
+
+func (cr *countingReader) Read(p []byte) (n int, err error) {
+	n, err = cr.r.Read(p)
+	cr.n += int64(n)
+	return n, err
+}
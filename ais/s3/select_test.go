@@ -0,0 +1,187 @@
+// Package s3_test provides tests for the Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/xml"
+	"hash/crc32"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const selectCSV = `name,age,city
+alice,30,"Paris, FR"
+bob,25,Berlin
+carol,41,Paris
+dave,,Rome
+`
+
+const selectJSON = `{"name":"alice","age":30,"addr":{"city":"Paris"}}
+{"name":"bob","age":25,"addr":{"city":"Berlin"}}
+{"name":"carol","age":41,"addr":{"city":"Paris"},"tags":["x"]}
+`
+
+type esMsg struct {
+	hdrs    map[string]string
+	payload []byte
+}
+
+// decode (and verify) event stream messages
+func decodeEventStream(t *testing.T, b []byte) (msgs []esMsg) {
+	for len(b) > 0 {
+		tassert.Fatalf(t, len(b) >= 16, "short message: %d", len(b))
+		total := int(binary.BigEndian.Uint32(b))
+		hlen := int(binary.BigEndian.Uint32(b[4:]))
+		tassert.Fatalf(t, crc32.ChecksumIEEE(b[:8]) == binary.BigEndian.Uint32(b[8:]), "prelude CRC mismatch")
+		tassert.Fatalf(t, crc32.ChecksumIEEE(b[:total-4]) == binary.BigEndian.Uint32(b[total-4:]), "message CRC mismatch")
+		msg := esMsg{hdrs: make(map[string]string)}
+		for h := b[12 : 12+hlen]; len(h) > 0; {
+			nlen := int(h[0])
+			name := string(h[1 : 1+nlen])
+			tassert.Fatalf(t, h[1+nlen] == 7, "expecting string header type")
+			vlen := int(binary.BigEndian.Uint16(h[2+nlen:]))
+			msg.hdrs[name] = string(h[4+nlen : 4+nlen+vlen])
+			h = h[4+nlen+vlen:]
+		}
+		msg.payload = b[12+hlen : total-4]
+		msgs = append(msgs, msg)
+		b = b[total:]
+	}
+	return msgs
+}
+
+func runSelect(t *testing.T, body, data string) (records string, msgs []esMsg) {
+	req := &s3.SelectRequest{}
+	tassert.CheckFatal(t, xml.Unmarshal([]byte(body), req))
+	tassert.CheckFatal(t, req.Validate())
+	var out bytes.Buffer
+	tassert.CheckFatal(t, req.Run(&out, strings.NewReader(data)))
+	msgs = decodeEventStream(t, out.Bytes())
+	tassert.Fatalf(t, len(msgs) >= 2, "expecting at least Stats and End, got %d", len(msgs))
+	tassert.Fatalf(t, msgs[len(msgs)-1].hdrs[":event-type"] == "End", "expecting End, got %v", msgs[len(msgs)-1].hdrs)
+	tassert.Fatalf(t, msgs[len(msgs)-2].hdrs[":event-type"] == "Stats", "expecting Stats")
+	var sb strings.Builder
+	for _, msg := range msgs[:len(msgs)-2] {
+		tassert.Fatalf(t, msg.hdrs[":event-type"] == "Records", "expecting Records, got %v", msg.hdrs)
+		sb.Write(msg.payload)
+	}
+	return sb.String(), msgs
+}
+
+func selectBody(expr, in, out string) string {
+	return "<SelectObjectContentRequest><Expression>" + expr + "</Expression><ExpressionType>SQL</ExpressionType>" +
+		"<InputSerialization>" + in + "</InputSerialization><OutputSerialization>" + out + "</OutputSerialization>" +
+		"</SelectObjectContentRequest>"
+}
+
+func TestSelectCSV(t *testing.T) {
+	const (
+		use    = "<CSV><FileHeaderInfo>USE</FileHeaderInfo></CSV>"
+		ignore = "<CSV><FileHeaderInfo>IGNORE</FileHeaderInfo></CSV>"
+		csvOut = "<CSV/>"
+	)
+	tests := []struct {
+		expr, in, out, expected string
+	}{
+		{"SELECT * FROM S3Object", ignore, csvOut, "alice,30,\"Paris, FR\"\nbob,25,Berlin\ncarol,41,Paris\ndave,,Rome\n"},
+		{"SELECT s.name, s.age FROM S3Object s WHERE s.age &gt; 26", use, csvOut, "alice,30\ncarol,41\n"},
+		{"SELECT _1 FROM S3Object WHERE _3 = 'Paris' OR _3 = 'Rome'", ignore, csvOut, "carol\ndave\n"},
+		{"SELECT name FROM S3Object WHERE age = ''", use, csvOut, "dave\n"},
+		{"SELECT name FROM S3Object WHERE NOT (city = 'Berlin') LIMIT 2", use, csvOut, "alice\ncarol\n"},
+		{"SELECT COUNT(*) FROM S3Object WHERE CAST(age AS INT) &gt;= 30", use, csvOut, "2\n"},
+		{"SELECT name AS n, city FROM S3Object LIMIT 1", use, "<JSON/>", "{\"n\":\"alice\",\"city\":\"Paris, FR\"}\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			records, _ := runSelect(t, selectBody(test.expr, test.in, test.out), selectCSV)
+			tassert.Fatalf(t, records == test.expected, "expected %q, got %q", test.expected, records)
+		})
+	}
+}
+
+func TestSelectJSON(t *testing.T) {
+	const lines = "<JSON><Type>LINES</Type></JSON>"
+	tests := []struct {
+		expr, out, expected string
+	}{
+		{"SELECT * FROM S3Object[*] s WHERE s.addr.city = 'Paris'", "<JSON/>",
+			"{\"name\":\"alice\",\"age\":30,\"addr\":{\"city\":\"Paris\"}}\n" +
+				"{\"name\":\"carol\",\"age\":41,\"addr\":{\"city\":\"Paris\"},\"tags\":[\"x\"]}\n"},
+		{"SELECT s.name, s.age FROM S3Object s WHERE s.age &lt; 40", "<CSV/>", "alice,30\nbob,25\n"},
+		{"SELECT name FROM S3Object WHERE tags IS NOT NULL", "<JSON/>", "{\"name\":\"carol\"}\n"},
+		{"SELECT COUNT(*) FROM S3Object", "<JSON/>", "{\"_1\":3}\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			records, _ := runSelect(t, selectBody(test.expr, lines, test.out), selectJSON)
+			tassert.Fatalf(t, records == test.expected, "expected %q, got %q", test.expected, records)
+		})
+	}
+}
+
+func TestSelectGzipStats(t *testing.T) {
+	var zbuf bytes.Buffer
+	zw := gzip.NewWriter(&zbuf)
+	_, err := zw.Write([]byte(selectCSV))
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, zw.Close())
+
+	body := selectBody("SELECT name FROM S3Object WHERE city = 'Berlin'",
+		"<CompressionType>GZIP</CompressionType><CSV><FileHeaderInfo>USE</FileHeaderInfo></CSV>", "<CSV/>")
+	records, msgs := runSelect(t, body, zbuf.String())
+	tassert.Fatalf(t, records == "bob\n", "unexpected records %q", records)
+
+	stats := struct {
+		Scanned   int `xml:"BytesScanned"`
+		Processed int `xml:"BytesProcessed"`
+		Returned  int `xml:"BytesReturned"`
+	}{}
+	tassert.CheckFatal(t, xml.Unmarshal(msgs[len(msgs)-2].payload, &stats))
+	tassert.Fatalf(t, stats.Scanned == zbuf.Len() && stats.Processed == len(selectCSV) && stats.Returned == 4,
+		"unexpected stats %+v", stats)
+}
+
+func TestSelectInvalid(t *testing.T) {
+	for _, expr := range []string{
+		"SELECT",
+		"SELECT * FROM table",
+		"SELECT * FROM S3Object WHERE",
+		"SELECT * FROM S3Object WHERE a = 'unterminated",
+		"SELECT * FROM S3Object LIMIT -1",
+		"SELECT a, FROM S3Object",
+		"DELETE FROM S3Object",
+	} {
+		req := &s3.SelectRequest{}
+		tassert.CheckFatal(t, xml.Unmarshal([]byte(selectBody(expr, "<CSV/>", "<CSV/>")), req))
+		tassert.Errorf(t, req.Validate() != nil, "expecting %q to fail", expr)
+	}
+
+	// Parquet input, no output serialization
+	for _, body := range []string{
+		selectBody("SELECT * FROM S3Object", "<Parquet/>", "<CSV/>"),
+		selectBody("SELECT * FROM S3Object", "<CSV/>", ""),
+	} {
+		req := &s3.SelectRequest{}
+		tassert.CheckFatal(t, xml.Unmarshal([]byte(body), req))
+		tassert.Errorf(t, req.Validate() != nil, "expecting %s to fail", body)
+	}
+
+	// runtime error => error event
+	req := &s3.SelectRequest{}
+	tassert.CheckFatal(t, xml.Unmarshal([]byte(selectBody("SELECT * FROM S3Object", "<JSON/>", "<JSON/>")), req))
+	tassert.CheckFatal(t, req.Validate())
+	var out bytes.Buffer
+	tassert.Fatalf(t, req.Run(&out, strings.NewReader("{\"a\":1}\n[1,\n")) != nil, "expecting JSON parsing error")
+	msgs := decodeEventStream(t, out.Bytes())
+	last := msgs[len(msgs)-1]
+	tassert.Fatalf(t, last.hdrs[":message-type"] == "error" && last.hdrs[":error-code"] == "JSONParsingError",
+		"expecting error event, got %v", last.hdrs)
+}
@@ -0,0 +1,608 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SQL subset for SelectObjectContent (see select.go):
+//
+//	SELECT * | COUNT(*) | <column> [[AS] <alias>], ...
+//	FROM S3Object[[*]] [[AS] <alias>]
+//	[WHERE <condition>]
+//	[LIMIT <n>]
+//
+// where:
+// - <column> is a positional CSV column (_1, _2, ...), a CSV header name (with FileHeaderInfo=USE),
+//   or a (dotted) JSON path; optionally prefixed with the table alias (e.g., s._1, s."Name", s.a.b);
+//   unquoted names are case-insensitive
+// - <condition> combines comparisons (=, !=, <>, <, <=, >, >=, IS [NOT] NULL) with AND, OR, NOT,
+//   and parentheses; operands are columns, 'string' and numeric literals, and CAST(<operand> AS <type>)
+// - values that both parse as numbers are compared numerically, otherwise as strings
+
+const sqlS3Object = "s3object"
+
+type (
+	selectQuery struct {
+		cols  []*selectCol // nil: SELECT *
+		where sqlCond      // nil: all records
+		alias string       // FROM S3Object <alias> (lowercase)
+		limit int64        // -1: no limit
+		count bool         // SELECT COUNT(*)
+	}
+	selectCol struct {
+		ref  *colRef
+		name string // output name
+	}
+	colRef struct {
+		path   []string
+		quoted []bool
+		idx    int // CSV positional column (0-based); -1 when not applicable
+	}
+
+	sqlVal struct {
+		raw  any // JSON value (when available)
+		s    string
+		f    float64
+		num  bool
+		null bool
+	}
+
+	// record (CSV row or JSON object)
+	selectRec interface {
+		get(ref *colRef) sqlVal
+	}
+
+	sqlCond interface {
+		match(rec selectRec) bool
+	}
+	sqlOperand interface {
+		value(rec selectRec) sqlVal
+	}
+
+	orCond  struct{ l, r sqlCond }
+	andCond struct{ l, r sqlCond }
+	notCond struct{ c sqlCond }
+	cmpCond struct {
+		l, r sqlOperand
+		op   string
+	}
+	nullCond struct {
+		x   sqlOperand
+		not bool
+	}
+	literal  struct{ v sqlVal }
+	castExpr struct {
+		x   sqlOperand
+		typ string
+	}
+)
+
+////////////
+// parser //
+////////////
+
+const (
+	tkEOF = iota
+	tkIdent
+	tkQIdent
+	tkString
+	tkNumber
+	tkPunct
+)
+
+type (
+	sqlTok struct {
+		s    string
+		kind int
+	}
+	sqlParser struct {
+		toks []sqlTok
+		pos  int
+	}
+)
+
+func parseSelect(expr string) (*selectQuery, error) {
+	toks, err := sqlLex(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &sqlParser{toks: toks}
+	q, err := p.query()
+	if err != nil {
+		return nil, fmt.Errorf("invalid SQL expression %q: %v", expr, err)
+	}
+	return q, nil
+}
+
+func sqlLex(s string) (toks []sqlTok, _ error) {
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '\'' || c == '"':
+			var (
+				sb strings.Builder
+				j  = i + 1
+			)
+			for ; j < len(s); j++ {
+				if s[j] == c {
+					if j+1 < len(s) && s[j+1] == c { // (escaped)
+						sb.WriteByte(c)
+						j++
+						continue
+					}
+					break
+				}
+				sb.WriteByte(s[j])
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated quote at position %d", i)
+			}
+			kind := tkString
+			if c == '"' {
+				kind = tkQIdent
+			}
+			toks = append(toks, sqlTok{kind: kind, s: sb.String()})
+			i = j + 1
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(s) && (s[j] >= '0' && s[j] <= '9' || s[j] == '.' || s[j] == 'e' || s[j] == 'E') {
+				j++
+			}
+			toks = append(toks, sqlTok{kind: tkNumber, s: s[i:j]})
+			i = j
+		case c == '_' || (c|0x20 >= 'a' && c|0x20 <= 'z'):
+			j := i
+			for j < len(s) && (s[j] == '_' || (s[j] >= '0' && s[j] <= '9') || (s[j]|0x20 >= 'a' && s[j]|0x20 <= 'z')) {
+				j++
+			}
+			toks = append(toks, sqlTok{kind: tkIdent, s: s[i:j]})
+			i = j
+		case c == '<' || c == '>' || c == '!':
+			if i+1 < len(s) && (s[i+1] == '=' || (c == '<' && s[i+1] == '>')) {
+				toks = append(toks, sqlTok{kind: tkPunct, s: s[i : i+2]})
+				i += 2
+			} else if c == '!' {
+				return nil, fmt.Errorf("unexpected %q at position %d", c, i)
+			} else {
+				toks = append(toks, sqlTok{kind: tkPunct, s: s[i : i+1]})
+				i++
+			}
+		case strings.IndexByte("=*(),.[]-;", c) >= 0:
+			toks = append(toks, sqlTok{kind: tkPunct, s: s[i : i+1]})
+			i++
+		default:
+			return nil, fmt.Errorf("unexpected %q at position %d", c, i)
+		}
+	}
+	return append(toks, sqlTok{kind: tkEOF}), nil
+}
+
+func (p *sqlParser) peek() *sqlTok { return &p.toks[p.pos] }
+
+func (p *sqlParser) next() *sqlTok {
+	t := &p.toks[p.pos]
+	if t.kind != tkEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *sqlParser) isKw(kw string) bool {
+	t := p.peek()
+	return t.kind == tkIdent && strings.EqualFold(t.s, kw)
+}
+
+func (p *sqlParser) acceptKw(kw string) bool {
+	if p.isKw(kw) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *sqlParser) accept(punct string) bool {
+	if t := p.peek(); t.kind == tkPunct && t.s == punct {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *sqlParser) expect(punct string) error {
+	if !p.accept(punct) {
+		return fmt.Errorf("expecting %q, got %q", punct, p.peek().s)
+	}
+	return nil
+}
+
+func (p *sqlParser) query() (q *selectQuery, err error) {
+	q = &selectQuery{limit: -1}
+	if !p.acceptKw("select") {
+		return nil, errors.New("expecting SELECT")
+	}
+	// projection
+	switch {
+	case p.accept("*"):
+	case p.isKw("count") && p.toks[p.pos+1].s == "(":
+		p.pos++
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		if err := p.expect("*"); err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		q.count = true
+	default:
+		for {
+			ref, err := p.colref()
+			if err != nil {
+				return nil, err
+			}
+			col := &selectCol{ref: ref}
+			if p.acceptKw("as") || (p.peek().kind == tkIdent && !p.isKw("from")) || p.peek().kind == tkQIdent {
+				t := p.next()
+				if t.kind != tkIdent && t.kind != tkQIdent {
+					return nil, fmt.Errorf("invalid column alias %q", t.s)
+				}
+				col.name = t.s
+			}
+			q.cols = append(q.cols, col)
+			if !p.accept(",") {
+				break
+			}
+		}
+	}
+
+	// FROM
+	if !p.acceptKw("from") {
+		return nil, errors.New("expecting FROM")
+	}
+	if t := p.next(); t.kind != tkIdent || !strings.EqualFold(t.s, sqlS3Object) {
+		return nil, fmt.Errorf("expecting FROM S3Object, got %q", t.s)
+	}
+	if p.accept("[") {
+		if err := p.expect("*"); err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+	}
+	if p.acceptKw("as") || (p.peek().kind == tkIdent && !p.isKw("where") && !p.isKw("limit")) {
+		t := p.next()
+		if t.kind != tkIdent {
+			return nil, fmt.Errorf("invalid table alias %q", t.s)
+		}
+		q.alias = strings.ToLower(t.s)
+	}
+
+	// WHERE
+	if p.acceptKw("where") {
+		if q.where, err = p.or(); err != nil {
+			return nil, err
+		}
+	}
+
+	// LIMIT
+	if p.acceptKw("limit") {
+		t := p.next()
+		n, err := strconv.ParseInt(t.s, 10, 64)
+		if t.kind != tkNumber || err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid LIMIT %q", t.s)
+		}
+		q.limit = n
+	}
+	p.accept(";")
+	if t := p.peek(); t.kind != tkEOF {
+		return nil, fmt.Errorf("unexpected %q", t.s)
+	}
+
+	// finally, resolve column names
+	for _, col := range q.cols {
+		q.resolve(col.ref)
+		if col.name == "" {
+			col.name = col.ref.path[len(col.ref.path)-1]
+		}
+	}
+	q.walk(q.where)
+	return q, nil
+}
+
+func (p *sqlParser) or() (sqlCond, error) {
+	l, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptKw("or") {
+		r, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		l = &orCond{l, r}
+	}
+	return l, nil
+}
+
+func (p *sqlParser) and() (sqlCond, error) {
+	l, err := p.not()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptKw("and") {
+		r, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		l = &andCond{l, r}
+	}
+	return l, nil
+}
+
+func (p *sqlParser) not() (sqlCond, error) {
+	if p.acceptKw("not") {
+		c, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		return &notCond{c}, nil
+	}
+	if p.accept("(") {
+		c, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		return c, p.expect(")")
+	}
+	return p.cmp()
+}
+
+func (p *sqlParser) cmp() (sqlCond, error) {
+	l, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+	if p.acceptKw("is") {
+		not := p.acceptKw("not")
+		if !p.acceptKw("null") {
+			return nil, errors.New("expecting IS [NOT] NULL")
+		}
+		return &nullCond{x: l, not: not}, nil
+	}
+	t := p.next()
+	switch t.s {
+	case "=", "!=", "<>", "<", "<=", ">", ">=":
+	default:
+		return nil, fmt.Errorf("expecting comparison operator, got %q", t.s)
+	}
+	if t.kind != tkPunct {
+		return nil, fmt.Errorf("expecting comparison operator, got %q", t.s)
+	}
+	r, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+	op := t.s
+	if op == "<>" {
+		op = "!="
+	}
+	return &cmpCond{l: l, r: r, op: op}, nil
+}
+
+func (p *sqlParser) operand() (sqlOperand, error) {
+	t := p.peek()
+	switch {
+	case t.kind == tkString:
+		p.pos++
+		return &literal{newStrVal(t.s)}, nil
+	case t.kind == tkNumber || (t.kind == tkPunct && t.s == "-"):
+		p.pos++
+		s := t.s
+		if t.kind == tkPunct {
+			if t = p.next(); t.kind != tkNumber {
+				return nil, fmt.Errorf("expecting number, got %q", t.s)
+			}
+			s = "-" + t.s
+		}
+		v := newStrVal(s)
+		if !v.num {
+			return nil, fmt.Errorf("invalid number %q", s)
+		}
+		return &literal{v}, nil
+	case p.isKw("null"):
+		p.pos++
+		return &literal{sqlVal{null: true}}, nil
+	case p.isKw("cast") && p.toks[p.pos+1].s == "(":
+		p.pos += 2
+		x, err := p.operand()
+		if err != nil {
+			return nil, err
+		}
+		if !p.acceptKw("as") {
+			return nil, errors.New("expecting CAST(... AS <type>)")
+		}
+		typ := p.next()
+		if typ.kind != tkIdent {
+			return nil, fmt.Errorf("invalid CAST type %q", typ.s)
+		}
+		switch strings.ToLower(typ.s) {
+		case "int", "integer", "float", "decimal", "numeric", "string", "bool", "boolean":
+		default:
+			return nil, fmt.Errorf("unsupported CAST type %q", typ.s)
+		}
+		return &castExpr{x: x, typ: strings.ToLower(typ.s)}, p.expect(")")
+	default:
+		return p.colref()
+	}
+}
+
+func (p *sqlParser) colref() (*colRef, error) {
+	ref := &colRef{idx: -1}
+	for {
+		t := p.next()
+		if t.kind != tkIdent && t.kind != tkQIdent {
+			return nil, fmt.Errorf("expecting column name, got %q", t.s)
+		}
+		ref.path = append(ref.path, t.s)
+		ref.quoted = append(ref.quoted, t.kind == tkQIdent)
+		if !p.accept(".") {
+			return ref, nil
+		}
+	}
+}
+
+// strip table alias; CSV positional columns
+func (q *selectQuery) resolve(ref *colRef) {
+	if len(ref.path) > 1 && !ref.quoted[0] {
+		if first := strings.ToLower(ref.path[0]); first == sqlS3Object || (q.alias != "" && first == q.alias) {
+			ref.path, ref.quoted = ref.path[1:], ref.quoted[1:]
+		}
+	}
+	if len(ref.path) == 1 && !ref.quoted[0] && len(ref.path[0]) > 1 && ref.path[0][0] == '_' {
+		if n, err := strconv.Atoi(ref.path[0][1:]); err == nil && n > 0 {
+			ref.idx = n - 1
+		}
+	}
+}
+
+func (q *selectQuery) walk(c any) {
+	switch c := c.(type) {
+	case *orCond:
+		q.walk(c.l)
+		q.walk(c.r)
+	case *andCond:
+		q.walk(c.l)
+		q.walk(c.r)
+	case *notCond:
+		q.walk(c.c)
+	case *cmpCond:
+		q.walk(c.l)
+		q.walk(c.r)
+	case *nullCond:
+		q.walk(c.x)
+	case *castExpr:
+		q.walk(c.x)
+	case *colRef:
+		q.resolve(c)
+	}
+}
+
+////////////
+// values //
+////////////
+
+func newStrVal(s string) sqlVal {
+	v := sqlVal{s: s}
+	if f, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err == nil {
+		v.f, v.num = f, true
+	}
+	return v
+}
+
+func newJSONVal(x any) sqlVal {
+	switch x := x.(type) {
+	case nil:
+		return sqlVal{null: true}
+	case string:
+		v := newStrVal(x)
+		v.raw = x
+		return v
+	case json.Number:
+		v := newStrVal(x.String())
+		v.raw = x
+		return v
+	case bool:
+		return sqlVal{s: strconv.FormatBool(x), raw: x}
+	default:
+		b, _ := json.Marshal(x)
+		return sqlVal{s: string(b), raw: x}
+	}
+}
+
+func (v *sqlVal) compare(o *sqlVal) int {
+	if v.num && o.num {
+		switch {
+		case v.f < o.f:
+			return -1
+		case v.f > o.f:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(v.s, o.s)
+}
+
+///////////////////////////
+// conditions, operands  //
+///////////////////////////
+
+func (c *orCond) match(rec selectRec) bool  { return c.l.match(rec) || c.r.match(rec) }
+func (c *andCond) match(rec selectRec) bool { return c.l.match(rec) && c.r.match(rec) }
+func (c *notCond) match(rec selectRec) bool { return !c.c.match(rec) }
+
+func (c *nullCond) match(rec selectRec) bool {
+	v := c.x.value(rec)
+	return v.null != c.not
+}
+
+func (c *cmpCond) match(rec selectRec) bool {
+	l, r := c.l.value(rec), c.r.value(rec)
+	if l.null || r.null {
+		return false
+	}
+	n := l.compare(&r)
+	switch c.op {
+	case "=":
+		return n == 0
+	case "!=":
+		return n != 0
+	case "<":
+		return n < 0
+	case "<=":
+		return n <= 0
+	case ">":
+		return n > 0
+	default:
+		return n >= 0
+	}
+}
+
+func (l *literal) value(selectRec) sqlVal { return l.v }
+
+func (ref *colRef) value(rec selectRec) sqlVal { return rec.get(ref) }
+
+func (c *castExpr) value(rec selectRec) sqlVal {
+	v := c.x.value(rec)
+	if v.null {
+		return v
+	}
+	switch c.typ {
+	case "string":
+		return sqlVal{s: v.s}
+	case "bool", "boolean":
+		return sqlVal{s: strings.ToLower(strings.TrimSpace(v.s))}
+	case "int", "integer":
+		if !v.num {
+			return sqlVal{null: true}
+		}
+		n := int64(v.f)
+		return sqlVal{s: strconv.FormatInt(n, 10), f: float64(n), num: true}
+	default:
+		if !v.num {
+			return sqlVal{null: true}
+		}
+		return v
+	}
+}
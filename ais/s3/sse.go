@@ -0,0 +1,458 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/md5" //nolint:gosec // (SSE-C key MD5 - S3 API requirement)
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// Server-side encryption at rest (ais:// buckets)
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/UsingServerSideEncryption.html (SSE-S3)
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/ServerSideEncryptionCustomerKeys.html (SSE-C)
+//
+// Each object gets its own random 256-bit data key and IV. The payload is encrypted
+// with AES-256-CTR, which preserves size and allows random access (range reads).
+// The data key is wrapped (AES-256-GCM) by:
+// - SSE-S3: the cluster key (see env.AisSSEClusterKey) that all targets share;
+// - SSE-C:  the customer-provided key that must accompany each request.
+// The wrapped key, the IV, and the rest are stored in the object's custom metadata.
+//
+// Multipart uploads encrypt each part as a separate CTR segment (see partIV).
+
+const (
+	SSEAlgoAES256 = "AES256"
+
+	sseModeS3 = "SSE-S3"
+	sseModeC  = "SSE-C"
+
+	// custom metadata keys
+	SSEObjMD       = cmn.SSEObjMD // (mode)
+	sseKeyObjMD    = "s3-sse-key"
+	sseIVObjMD     = "s3-sse-iv"
+	sseKeyMD5ObjMD = "s3-sse-c-key-md5"
+	ssePartsObjMD  = "s3-sse-parts"
+
+	sseKeyLen = 32
+)
+
+type (
+	// SSE parameters of a given request
+	SSEParams struct {
+		ckey    []byte // SSE-C: customer key
+		ckeyMD5 string // ditto, base64 MD5
+		mode    string
+	}
+	// SSE object (as in: object's metadata)
+	SSE struct {
+		mode    string
+		wrapped string // data key wrapped by the cluster or customer key (base64)
+		keyMD5  string // SSE-C: customer key MD5 (base64)
+		iv      []byte
+		parts   bool // multipart: each part is a separate segment
+	}
+
+	ErrSSE struct {
+		msg    string
+		code   string
+		status int
+	}
+
+	sseEncReader struct {
+		r      io.ReadCloser
+		stream cipher.Stream
+	}
+	sseSeg struct {
+		iv         []byte
+		start, end int64
+	}
+	sseDecReader struct {
+		ra     io.ReaderAt
+		block  cipher.Block
+		stream cipher.Stream
+		segs   []sseSeg
+		seg    int // current segment when stream != nil
+		off    int64
+		size   int64
+	}
+)
+
+var (
+	ckOnce sync.Once
+	ckKey  []byte
+	ckErr  error
+)
+
+// interface guard
+var _ io.ReadSeeker = (*sseDecReader)(nil)
+
+////////////
+// ErrSSE //
+////////////
+
+func (e *ErrSSE) Error() string { return e.msg }
+
+func newErrSSE(status int, code, format string, a ...any) *ErrSSE {
+	return &ErrSSE{msg: fmt.Sprintf(format, a...), code: code, status: status}
+}
+
+func WriteSSEErr(w http.ResponseWriter, r *http.Request, err error) {
+	var e *ErrSSE
+	if errors.As(err, &e) {
+		WriteErr(w, r, ErrInfo{Err: err, Status: e.status, Code: e.code})
+		return
+	}
+	WriteErr(w, r, ErrInfo{Err: err})
+}
+
+///////////////
+// SSEParams //
+///////////////
+
+// parse SSE request headers; returns nil when none specified
+func ParseSSE(hdr http.Header) (*SSEParams, error) {
+	var (
+		algo  = hdr.Get(cos.S3HdrSSE)
+		calgo = hdr.Get(cos.S3HdrSSECAlgo)
+	)
+	switch {
+	case algo == "" && calgo == "":
+		if hdr.Get(cos.S3HdrSSECKey) != "" {
+			return nil, newErrSSE(http.StatusBadRequest, "InvalidArgument",
+				"%s requires %s", cos.S3HdrSSECKey, cos.S3HdrSSECAlgo)
+		}
+		return nil, nil
+	case algo != "" && calgo != "":
+		return nil, newErrSSE(http.StatusBadRequest, "InvalidArgument",
+			"%s and %s are mutually exclusive", cos.S3HdrSSE, cos.S3HdrSSECAlgo)
+	case algo != "":
+		if algo != SSEAlgoAES256 {
+			return nil, newErrSSE(http.StatusNotImplemented, "NotImplemented",
+				"server-side encryption %q is not supported (expecting %q)", algo, SSEAlgoAES256)
+		}
+		return &SSEParams{mode: sseModeS3}, nil
+	}
+
+	// SSE-C
+	if calgo != SSEAlgoAES256 {
+		return nil, newErrSSE(http.StatusBadRequest, "InvalidArgument",
+			"invalid %s %q (expecting %q)", cos.S3HdrSSECAlgo, calgo, SSEAlgoAES256)
+	}
+	ckey, err := base64.StdEncoding.DecodeString(hdr.Get(cos.S3HdrSSECKey))
+	if err != nil || len(ckey) != sseKeyLen {
+		return nil, newErrSSE(http.StatusBadRequest, "InvalidArgument",
+			"invalid %s: expecting base64-encoded 256-bit key", cos.S3HdrSSECKey)
+	}
+	sum := md5.Sum(ckey) //nolint:gosec // (ditto)
+	p := &SSEParams{mode: sseModeC, ckey: ckey, ckeyMD5: base64.StdEncoding.EncodeToString(sum[:])}
+	if v := hdr.Get(cos.S3HdrSSECKeyMD5); v != "" && v != p.ckeyMD5 {
+		return nil, newErrSSE(http.StatusBadRequest, "InvalidArgument",
+			"%s does not match the provided key", cos.S3HdrSSECKeyMD5)
+	}
+	return p, nil
+}
+
+func (p *SSEParams) kek() ([]byte, error) {
+	if p.mode == sseModeC {
+		return p.ckey, nil
+	}
+	return clusterKey()
+}
+
+func clusterKey() ([]byte, error) {
+	ckOnce.Do(func() {
+		s := os.Getenv(env.AisSSEClusterKey)
+		if s == "" {
+			ckErr = newErrSSE(http.StatusNotImplemented, "NotImplemented",
+				"server-side encryption (%s) is not configured: missing %s", sseModeS3, env.AisSSEClusterKey)
+			return
+		}
+		ckKey, ckErr = base64.StdEncoding.DecodeString(s)
+		if ckErr == nil && len(ckKey) != sseKeyLen {
+			ckErr = fmt.Errorf("invalid %s: expecting base64-encoded 256-bit key, got %d bytes",
+				env.AisSSEClusterKey, len(ckKey))
+		}
+	})
+	return ckKey, ckErr
+}
+
+/////////
+// SSE //
+/////////
+
+// generate new data key and IV; returns SSE (to store with the object) and the data key
+func NewSSE(p *SSEParams, parts bool) (*SSE, []byte, error) {
+	kek, err := p.kek()
+	if err != nil {
+		return nil, nil, err
+	}
+	dek := make([]byte, sseKeyLen+aes.BlockSize)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, nil, err
+	}
+	sse := &SSE{mode: p.mode, keyMD5: p.ckeyMD5, iv: dek[sseKeyLen:], parts: parts}
+	dek = dek[:sseKeyLen]
+	if sse.wrapped, err = wrapKey(kek, dek); err != nil {
+		return nil, nil, err
+	}
+	return sse, dek, nil
+}
+
+// returns nil when the object is not encrypted
+func GetSSE(lom *core.LOM) (*SSE, error) {
+	mode, ok := lom.GetCustomKey(SSEObjMD)
+	if !ok {
+		return nil, nil
+	}
+	md := cos.StrKVs{SSEObjMD: mode}
+	for _, k := range []string{sseKeyObjMD, sseIVObjMD, sseKeyMD5ObjMD, ssePartsObjMD} {
+		if v, ok := lom.GetCustomKey(k); ok {
+			md[k] = v
+		}
+	}
+	return SSEFromMD(md)
+}
+
+func SSEFromMD(md cos.StrKVs) (*SSE, error) {
+	sse := &SSE{mode: md[SSEObjMD], wrapped: md[sseKeyObjMD], keyMD5: md[sseKeyMD5ObjMD], parts: md[ssePartsObjMD] == "true"}
+	iv, err := base64.StdEncoding.DecodeString(md[sseIVObjMD])
+	if err != nil || len(iv) != aes.BlockSize || sse.wrapped == "" || (sse.mode != sseModeS3 && sse.mode != sseModeC) {
+		return nil, fmt.Errorf("invalid server-side encryption metadata (mode %q)", sse.mode)
+	}
+	sse.iv = iv
+	return sse, nil
+}
+
+func (sse *SSE) ToMD() cos.StrKVs {
+	md := cos.StrKVs{
+		SSEObjMD:    sse.mode,
+		sseKeyObjMD: sse.wrapped,
+		sseIVObjMD:  base64.StdEncoding.EncodeToString(sse.iv),
+	}
+	if sse.keyMD5 != "" {
+		md[sseKeyMD5ObjMD] = sse.keyMD5
+	}
+	if sse.parts {
+		md[ssePartsObjMD] = "true"
+	}
+	return md
+}
+
+func (sse *SSE) Set(lom *core.LOM) {
+	for k, v := range sse.ToMD() {
+		lom.SetCustomKey(k, v)
+	}
+}
+
+// unwrap the data key given the request headers (SSE-C: the customer key)
+func (sse *SSE) DataKey(hdr http.Header) ([]byte, error) {
+	p, err := ParseSSE(hdr)
+	if err != nil {
+		return nil, err
+	}
+	if sse.mode == sseModeC {
+		if p == nil || p.mode != sseModeC {
+			return nil, newErrSSE(http.StatusBadRequest, "InvalidRequest",
+				"the object was stored using %s: the same customer key must be provided", sseModeC)
+		}
+		if p.ckeyMD5 != sse.keyMD5 {
+			return nil, newErrSSE(http.StatusForbidden, "AccessDenied", "the provided customer key does not match")
+		}
+	} else {
+		p = &SSEParams{mode: sseModeS3}
+	}
+	kek, err := p.kek()
+	if err != nil {
+		return nil, err
+	}
+	dek, err := unwrapKey(kek, sse.wrapped)
+	if err != nil {
+		return nil, newErrSSE(http.StatusForbidden, "AccessDenied", "failed to unwrap data key: %v", err)
+	}
+	return dek, nil
+}
+
+func (sse *SSE) SetHeaders(hdr http.Header) {
+	if sse.mode == sseModeC {
+		hdr.Set(cos.S3HdrSSECAlgo, SSEAlgoAES256)
+		hdr.Set(cos.S3HdrSSECKeyMD5, sse.keyMD5)
+	} else {
+		hdr.Set(cos.S3HdrSSE, SSEAlgoAES256)
+	}
+}
+
+// encrypt the entire object (partNum = 0) or a given part of the multipart upload
+func (sse *SSE) NewEncReader(r io.ReadCloser, dek []byte, partNum int) (io.ReadCloser, error) {
+	block, err := aes.NewCipher(dek)
+	if err != nil {
+		return nil, err
+	}
+	return &sseEncReader{r: r, stream: cipher.NewCTR(block, partIV(sse.iv, partNum))}, nil
+}
+
+func (sse *SSE) Multipart() bool { return sse.parts }
+
+// decrypt object's content given its size and, if multipart, the sizes of its parts
+func (sse *SSE) NewDecReader(ra io.ReaderAt, dek []byte, size int64, partSizes []int64) (io.ReadSeeker, error) {
+	block, err := aes.NewCipher(dek)
+	if err != nil {
+		return nil, err
+	}
+	dr := &sseDecReader{ra: ra, block: block, size: size}
+	if !sse.parts {
+		dr.segs = []sseSeg{{iv: sse.iv, end: size}}
+		return dr, nil
+	}
+	var off int64
+	dr.segs = make([]sseSeg, 0, len(partSizes))
+	for i, psize := range partSizes {
+		dr.segs = append(dr.segs, sseSeg{iv: partIV(sse.iv, i+1), start: off, end: off + psize})
+		off += psize
+	}
+	if off != size || len(partSizes) == 0 {
+		return nil, fmt.Errorf("encrypted parts total %d bytes, expecting %d", off, size)
+	}
+	return dr, nil
+}
+
+// part IVs are spaced 2^96 blocks apart - no counter overlap
+func partIV(iv []byte, partNum int) []byte {
+	piv := make([]byte, aes.BlockSize)
+	copy(piv, iv)
+	binary.BigEndian.PutUint32(piv, binary.BigEndian.Uint32(piv)^uint32(partNum))
+	return piv
+}
+
+func wrapKey(kek, dek []byte) (string, error) {
+	gcm, err := newGCM(kek)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize(), gcm.NonceSize()+len(dek)+gcm.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, dek, nil)), nil
+}
+
+func unwrapKey(kek []byte, wrapped string) ([]byte, error) {
+	gcm, err := newGCM(kek)
+	if err != nil {
+		return nil, err
+	}
+	b, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < gcm.NonceSize() {
+		return nil, errors.New("wrapped key is too short")
+	}
+	return gcm.Open(nil, b[:gcm.NonceSize()], b[gcm.NonceSize():], nil)
+}
+
+func newGCM(kek []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+//////////////////
+// sseEncReader //
+//////////////////
+
+func (er *sseEncReader) Read(p []byte) (n int, err error) {
+	n, err = er.r.Read(p)
+	er.stream.XORKeyStream(p[:n], p[:n])
+	return n, err
+}
+
+func (er *sseEncReader) Close() error { return er.r.Close() }
+
+//////////////////
+// sseDecReader //
+//////////////////
+
+func (dr *sseDecReader) Read(p []byte) (n int, err error) {
+	if dr.off >= dr.size {
+		return 0, io.EOF
+	}
+	if dr.stream == nil {
+		dr.seek()
+	}
+	seg := &dr.segs[dr.seg]
+	if rem := seg.end - dr.off; int64(len(p)) > rem {
+		p = p[:rem]
+	}
+	n, err = dr.ra.ReadAt(p, dr.off)
+	dr.stream.XORKeyStream(p[:n], p[:n])
+	dr.off += int64(n)
+	if dr.off >= seg.end {
+		dr.stream = nil
+	}
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+	return n, err
+}
+
+// position the keystream at the current offset
+func (dr *sseDecReader) seek() {
+	i := 0
+	for i < len(dr.segs)-1 && dr.off >= dr.segs[i].end {
+		i++
+	}
+	var (
+		seg = &dr.segs[i]
+		rel = dr.off - seg.start
+		ctr = make([]byte, aes.BlockSize)
+	)
+	copy(ctr, seg.iv)
+	// 128-bit big-endian counter += rel / block-size
+	lo := binary.BigEndian.Uint64(ctr[8:])
+	sum := lo + uint64(rel/aes.BlockSize)
+	binary.BigEndian.PutUint64(ctr[8:], sum)
+	if sum < lo {
+		binary.BigEndian.PutUint64(ctr, binary.BigEndian.Uint64(ctr)+1)
+	}
+	dr.seg, dr.stream = i, cipher.NewCTR(dr.block, ctr)
+	if skip := rel % aes.BlockSize; skip > 0 {
+		var tmp [aes.BlockSize]byte
+		dr.stream.XORKeyStream(tmp[:skip], tmp[:skip])
+	}
+}
+
+func (dr *sseDecReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += dr.off
+	case io.SeekEnd:
+		offset += dr.size
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+	dr.off, dr.stream = offset, nil
+	return offset, nil
+}
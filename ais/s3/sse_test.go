@@ -0,0 +1,159 @@
+// Package s3_test provides tests for the Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"bytes"
+	"crypto/md5" //nolint:gosec // (SSE-C key MD5)
+	"encoding/base64"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"testing"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func sseCHeader(key []byte) http.Header {
+	sum := md5.Sum(key) //nolint:gosec // (ditto)
+	hdr := http.Header{}
+	hdr.Set(cos.S3HdrSSECAlgo, s3.SSEAlgoAES256)
+	hdr.Set(cos.S3HdrSSECKey, base64.StdEncoding.EncodeToString(key))
+	hdr.Set(cos.S3HdrSSECKeyMD5, base64.StdEncoding.EncodeToString(sum[:]))
+	return hdr
+}
+
+func sseEncrypt(t *testing.T, sse *s3.SSE, dek, data []byte, partNum int) []byte {
+	r, err := sse.NewEncReader(io.NopCloser(bytes.NewReader(data)), dek, partNum)
+	tassert.CheckFatal(t, err)
+	b, err := io.ReadAll(r)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(b) == len(data) && !bytes.Equal(b, data), "expecting same-size ciphertext")
+	return b
+}
+
+// read random ranges and compare with the plaintext
+func sseCheckRanges(t *testing.T, rs io.ReadSeeker, data []byte) {
+	b, err := io.ReadAll(rs)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, bytes.Equal(b, data), "full read: plaintext mismatch")
+	for range 100 {
+		off := rand.Int64N(int64(len(data)))
+		n := rand.Int64N(int64(len(data))-off) + 1
+		_, err := rs.Seek(off, io.SeekStart)
+		tassert.CheckFatal(t, err)
+		b := make([]byte, n)
+		_, err = io.ReadFull(rs, b)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, bytes.Equal(b, data[off:off+n]), "range [%d, %d): plaintext mismatch", off, off+n)
+	}
+}
+
+func TestParseSSE(t *testing.T) {
+	p, err := s3.ParseSSE(http.Header{})
+	tassert.Fatalf(t, p == nil && err == nil, "expecting no SSE")
+
+	hdr := http.Header{}
+	hdr.Set(cos.S3HdrSSE, "aws:kms")
+	_, err = s3.ParseSSE(hdr)
+	tassert.Fatalf(t, err != nil, "expecting aws:kms to fail")
+
+	key := bytes.Repeat([]byte{7}, 32)
+	hdr = sseCHeader(key)
+	p, err = s3.ParseSSE(hdr)
+	tassert.Fatalf(t, p != nil && err == nil, "expecting valid SSE-C, got %v", err)
+
+	hdr.Set(cos.S3HdrSSECKeyMD5, base64.StdEncoding.EncodeToString([]byte("0123456789abcdef")))
+	_, err = s3.ParseSSE(hdr)
+	tassert.Fatalf(t, err != nil, "expecting key MD5 mismatch")
+
+	hdr = sseCHeader(key[:16])
+	_, err = s3.ParseSSE(hdr)
+	tassert.Fatalf(t, err != nil, "expecting invalid key length")
+
+	hdr = sseCHeader(key)
+	hdr.Set(cos.S3HdrSSE, s3.SSEAlgoAES256)
+	_, err = s3.ParseSSE(hdr)
+	tassert.Fatalf(t, err != nil, "expecting SSE-S3 and SSE-C to be mutually exclusive")
+}
+
+func TestSSECustomerKey(t *testing.T) {
+	var (
+		key  = bytes.Repeat([]byte{1}, 32)
+		data = make([]byte, 100*1024+3)
+	)
+	for i := range data {
+		data[i] = byte(rand.IntN(256))
+	}
+	p, err := s3.ParseSSE(sseCHeader(key))
+	tassert.CheckFatal(t, err)
+	sse, dek, err := s3.NewSSE(p, false /*parts*/)
+	tassert.CheckFatal(t, err)
+	ciphertext := sseEncrypt(t, sse, dek, data, 0)
+
+	// (as in: load from object's metadata)
+	sse, err = s3.SSEFromMD(sse.ToMD())
+	tassert.CheckFatal(t, err)
+
+	// no key, wrong key
+	var e *s3.ErrSSE
+	_, err = sse.DataKey(http.Header{})
+	tassert.Fatalf(t, errors.As(err, &e), "expecting SSE-C key required, got %v", err)
+	_, err = sse.DataKey(sseCHeader(bytes.Repeat([]byte{2}, 32)))
+	tassert.Fatalf(t, errors.As(err, &e), "expecting SSE-C key mismatch, got %v", err)
+
+	dek2, err := sse.DataKey(sseCHeader(key))
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, bytes.Equal(dek, dek2), "data key mismatch")
+	rs, err := sse.NewDecReader(bytes.NewReader(ciphertext), dek2, int64(len(data)), nil)
+	tassert.CheckFatal(t, err)
+	sseCheckRanges(t, rs, data)
+
+	hdr := http.Header{}
+	sse.SetHeaders(hdr)
+	tassert.Fatalf(t, hdr.Get(cos.S3HdrSSECAlgo) == s3.SSEAlgoAES256 && hdr.Get(cos.S3HdrSSECKeyMD5) != "",
+		"unexpected response headers %v", hdr)
+}
+
+func TestSSEClusterKeyMultipart(t *testing.T) {
+	t.Setenv(env.AisSSEClusterKey, base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{9}, 32)))
+
+	hdr := http.Header{}
+	hdr.Set(cos.S3HdrSSE, s3.SSEAlgoAES256)
+	p, err := s3.ParseSSE(hdr)
+	tassert.CheckFatal(t, err)
+	sse, dek, err := s3.NewSSE(p, true /*parts*/)
+	tassert.CheckFatal(t, err)
+
+	var (
+		partSizes  = []int64{5*1024 + 7, 64 * 1024, 1}
+		data       []byte
+		ciphertext []byte
+	)
+	for i, size := range partSizes {
+		part := make([]byte, size)
+		for j := range part {
+			part[j] = byte(rand.IntN(256))
+		}
+		data = append(data, part...)
+		ciphertext = append(ciphertext, sseEncrypt(t, sse, dek, part, i+1)...)
+	}
+
+	sse, err = s3.SSEFromMD(sse.ToMD())
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, sse.Multipart(), "expecting multipart")
+	dek2, err := sse.DataKey(http.Header{}) // (SSE-S3: no headers required)
+	tassert.CheckFatal(t, err)
+	rs, err := sse.NewDecReader(bytes.NewReader(ciphertext), dek2, int64(len(data)), partSizes)
+	tassert.CheckFatal(t, err)
+	sseCheckRanges(t, rs, data)
+
+	_, err = sse.NewDecReader(bytes.NewReader(ciphertext), dek2, int64(len(data)), partSizes[:2])
+	tassert.Fatalf(t, err != nil, "expecting parts size mismatch")
+}
@@ -0,0 +1,140 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Object tagging
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-tagging.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectTagging.html
+//
+// Tags are stored in the object's custom metadata under `TaggingObjMD`
+// as a (sorted) URL-encoded query string - the same format that S3 clients
+// use with the `x-amz-tagging` header, e.g. "split=train&version=v2"
+
+const (
+	TaggingObjMD = "s3-tagging" // (custom metadata key)
+
+	maxTags        = 10
+	maxTagKeyLen   = 128
+	maxTagValueLen = 256
+)
+
+const InvalidTag = "InvalidTag"
+
+type (
+	Tagging struct {
+		XMLName xml.Name `xml:"Tagging"`
+		Ns      string   `xml:"xmlns,attr,omitempty"`
+		TagSet  []Tag    `xml:"TagSet>Tag"`
+	}
+	Tag struct {
+		Key   string `xml:"Key"`
+		Value string `xml:"Value"`
+	}
+)
+
+// parse `x-amz-tagging` header value
+func ParseTagging(s string) (*Tagging, error) {
+	q, err := url.ParseQuery(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tagging %q: %v", s, err)
+	}
+	tagging := &Tagging{TagSet: make([]Tag, 0, len(q))}
+	for k, vs := range q {
+		if len(vs) != 1 {
+			return nil, fmt.Errorf("invalid tagging %q: duplicate tag key %q", s, k)
+		}
+		tagging.TagSet = append(tagging.TagSet, Tag{Key: k, Value: vs[0]})
+	}
+	if err := tagging.Validate(); err != nil {
+		return nil, err
+	}
+	tagging.sort()
+	return tagging, nil
+}
+
+func NewTagging(lom *core.LOM) (*Tagging, error) {
+	tagging := &Tagging{Ns: s3Namespace, TagSet: []Tag{}}
+	if v, ok := lom.GetCustomKey(TaggingObjMD); ok && v != "" {
+		t, err := ParseTagging(v)
+		if err != nil {
+			return nil, err
+		}
+		tagging.TagSet = t.TagSet
+	}
+	return tagging, nil
+}
+
+func (t *Tagging) Validate() error {
+	if len(t.TagSet) > maxTags {
+		return fmt.Errorf("number of tags (%d) exceeds the maximum (%d)", len(t.TagSet), maxTags)
+	}
+	keys := make(map[string]struct{}, len(t.TagSet))
+	for _, tag := range t.TagSet {
+		if tag.Key == "" {
+			return errors.New("tag key cannot be empty")
+		}
+		if l := utf8.RuneCountInString(tag.Key); l > maxTagKeyLen {
+			return fmt.Errorf("tag key %q is too long (%d > %d)", tag.Key, l, maxTagKeyLen)
+		}
+		if l := utf8.RuneCountInString(tag.Value); l > maxTagValueLen {
+			return fmt.Errorf("tag %q: value is too long (%d > %d)", tag.Key, l, maxTagValueLen)
+		}
+		if _, ok := keys[tag.Key]; ok {
+			return fmt.Errorf("duplicate tag key %q", tag.Key)
+		}
+		keys[tag.Key] = struct{}{}
+	}
+	return nil
+}
+
+// encode (sorted by key) for storing in custom metadata; empty if no tags
+func (t *Tagging) Encode() string {
+	if len(t.TagSet) == 0 {
+		return ""
+	}
+	q := make(url.Values, len(t.TagSet))
+	for _, tag := range t.TagSet {
+		q.Set(tag.Key, tag.Value)
+	}
+	return q.Encode() // (sorts by key)
+}
+
+func (t *Tagging) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write(cos.UnsafeB(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(t)
+	debug.AssertNoErr(err)
+}
+
+// number of tags (for `x-amz-tagging-count` header); zero if none
+func TagCount(lom *core.LOM) int {
+	v, ok := lom.GetCustomKey(TaggingObjMD)
+	if !ok || v == "" {
+		return 0
+	}
+	q, err := url.ParseQuery(v)
+	if err != nil {
+		return 0
+	}
+	return len(q)
+}
+
+// sorted, for deterministic output
+func (t *Tagging) sort() {
+	sort.Slice(t.TagSet, func(i, j int) bool { return t.TagSet[i].Key < t.TagSet[j].Key })
+}
@@ -0,0 +1,56 @@
+// Package s3_test provides tests for the Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestTaggingParse(t *testing.T) {
+	tagging, err := s3.ParseTagging("version=v2&split=train&empty=")
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(tagging.TagSet) == 3, "expected 3 tags, got %d", len(tagging.TagSet))
+	tassert.Fatalf(t, tagging.TagSet[0].Key == "empty" && tagging.TagSet[2].Key == "version",
+		"expecting tags sorted by key: %+v", tagging.TagSet)
+	tassert.Fatalf(t, tagging.Encode() == "empty=&split=train&version=v2", "unexpected encoding %q", tagging.Encode())
+
+	var many []string
+	for i := range 11 {
+		many = append(many, "k"+strconv.Itoa(i)+"=v")
+	}
+	for _, s := range []string{
+		"a=1&a=2",
+		"=v",
+		strings.Join(many, "&"),
+		strings.Repeat("k", 129) + "=v",
+		"k=" + strings.Repeat("v", 257),
+	} {
+		_, err := s3.ParseTagging(s)
+		tassert.Fatalf(t, err != nil, "expected error for %q", s)
+	}
+}
+
+func TestTaggingXML(t *testing.T) {
+	const body = `<Tagging><TagSet><Tag><Key>split</Key><Value>val</Value></Tag><Tag><Key>ver</Key><Value>1</Value></Tag></TagSet></Tagging>`
+	tagging := &s3.Tagging{}
+	tassert.CheckFatal(t, xml.Unmarshal([]byte(body), tagging))
+	tassert.CheckFatal(t, tagging.Validate())
+	encoded := tagging.Encode()
+	tassert.Fatalf(t, encoded == "split=val&ver=1", "unexpected encoding %q", encoded)
+
+	sgl := memsys.PageMM().NewSGL(0)
+	defer sgl.Free()
+	tagging.MustMarshal(sgl)
+	out := &s3.Tagging{}
+	tassert.CheckFatal(t, xml.Unmarshal(sgl.Bytes(), out))
+	tassert.Fatalf(t, out.Encode() == encoded, "round trip mismatch: %q vs %q", out.Encode(), encoded)
+}
@@ -65,6 +65,14 @@ type (
 		ETag         string `xml:"ETag"`
 	}
 
+	// Response for UploadPartCopy — emits <CopyPartResult>
+	// https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPartCopy.html#API_UploadPartCopy_ResponseSyntax
+	CopyPartResult struct {
+		XMLName      xml.Name `xml:"CopyPartResult"`
+		LastModified string   `xml:"LastModified"`
+		ETag         string   `xml:"ETag"`
+	}
+
 	// Multipart upload start response — emits <InitiateMultipartUploadResult> per AWS S3 spec
 	// https://docs.aws.amazon.com/AmazonS3/latest/API/API_CreateMultipartUpload.html#API_CreateMultipartUpload_ResponseSyntax
 	InitiateMptUploadResult struct {
@@ -240,10 +248,25 @@ func SetS3Headers(hdr http.Header, lom *core.LOM) {
 	if hdr.Get(cos.S3VersionHeader) == "" {
 		if v, ok := lom.GetCustomKey(cmn.VersionObjMD); ok {
 			hdr.Set(cos.S3VersionHeader, v)
+		} else if lom.RetainsVersions() && lom.Version() != "" {
+			hdr.Set(cos.S3VersionHeader, lom.Version())
 		}
 	}
 
-	// 4. finally, user metadata (X-Amz-Meta-...)
+	// 4. x-amz-tagging-count
+	if n := TagCount(lom); n > 0 {
+		hdr.Set(cos.S3HdrTaggingCount, strconv.Itoa(n))
+	}
+
+	// 5. server-side encryption
+	if sse, _ := GetSSE(lom); sse != nil {
+		sse.SetHeaders(hdr)
+	}
+
+	// 6. object lock
+	setObjLockHeaders(hdr, lom)
+
+	// 7. finally, user metadata (X-Amz-Meta-...)
 	for k, v := range lom.GetCustomMD() {
 		if strings.HasPrefix(k, HeaderMetaPrefix) {
 			hdr.Set(k, v)
@@ -257,6 +280,12 @@ func (r *CopyObjectResult) MustMarshal(sgl *memsys.SGL) {
 	debug.AssertNoErr(err)
 }
 
+func (r *CopyPartResult) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write(cos.UnsafeB(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
 func (r *InitiateMptUploadResult) MustMarshal(sgl *memsys.SGL) {
 	sgl.Write(cos.UnsafeB(xml.Header))
 	err := xml.NewEncoder(sgl).Encode(r)
@@ -0,0 +1,183 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Object versions: ais:// buckets that retain prior versions (see core/lversion)
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_ListObjectVersions.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/versioning-workflows.html
+//
+// Each target lists the versions it stores; the proxy then merges per-target results
+// and applies the same markers and max-keys (see Finalize).
+
+const (
+	NoSuchVersion = "NoSuchVersion"
+
+	storageClassStandard = "STANDARD"
+)
+
+type (
+	// List object versions response — emits <ListVersionsResult> per AWS S3 ListObjectVersions spec
+	ListVersionsResult struct {
+		XMLName             xml.Name            `xml:"ListVersionsResult"`
+		Ns                  string              `xml:"xmlns,attr"`
+		Name                string              `xml:"Name"`
+		Prefix              string              `xml:"Prefix"`
+		KeyMarker           string              `xml:"KeyMarker"`
+		VersionIDMarker     string              `xml:"VersionIdMarker"`
+		NextKeyMarker       string              `xml:"NextKeyMarker,omitempty"`
+		NextVersionIDMarker string              `xml:"NextVersionIdMarker,omitempty"`
+		Versions            []*ObjVersionInfo   `xml:"Version"`
+		DeleteMarkers       []*DeleteMarkerInfo `xml:"DeleteMarker"`
+		MaxKeys             int                 `xml:"MaxKeys"`
+		IsTruncated         bool                `xml:"IsTruncated"`
+	}
+	ObjVersionInfo struct {
+		Key          string `xml:"Key"`
+		VersionID    string `xml:"VersionId"`
+		IsLatest     bool   `xml:"IsLatest"`
+		LastModified string `xml:"LastModified"`
+		ETag         string `xml:"ETag"`
+		Size         int64  `xml:"Size"`
+		Class        string `xml:"StorageClass"`
+	}
+	DeleteMarkerInfo struct {
+		Key          string `xml:"Key"`
+		VersionID    string `xml:"VersionId"`
+		IsLatest     bool   `xml:"IsLatest"`
+		LastModified string `xml:"LastModified"`
+	}
+)
+
+// (internal) versions and delete markers, sorted together
+type verEntry struct {
+	v   *ObjVersionInfo
+	dm  *DeleteMarkerInfo
+	key string
+	ver uint64
+}
+
+func NewListVersionsResult(bucket string, q url.Values) *ListVersionsResult {
+	r := &ListVersionsResult{
+		Name:            bucket,
+		Ns:              s3Namespace,
+		Prefix:          q.Get(QparamPrefix),
+		KeyMarker:       q.Get(QparamKeyMarker),
+		VersionIDMarker: q.Get(QparamVersionIDMarker),
+		MaxKeys:         apc.MaxPageSizeAWS,
+	}
+	if n, err := strconv.Atoi(q.Get(QparamMaxKeys)); err == nil && n > 0 && n < r.MaxKeys {
+		r.MaxKeys = n
+	}
+	return r
+}
+
+func (r *ListVersionsResult) Add(objName string, ov *core.ObjVersion, isLatest bool) {
+	mtime := cos.FormatTime(ov.Mtime, cos.ISO8601)
+	if ov.DeleteMarker {
+		r.DeleteMarkers = append(r.DeleteMarkers, &DeleteMarkerInfo{
+			Key:          objName,
+			VersionID:    ov.Version,
+			IsLatest:     isLatest,
+			LastModified: mtime,
+		})
+		return
+	}
+	vi := &ObjVersionInfo{
+		Key:          objName,
+		VersionID:    ov.Version,
+		IsLatest:     isLatest,
+		LastModified: mtime,
+		Size:         ov.Size,
+		Class:        storageClassStandard,
+	}
+	if ov.ETag != "" {
+		vi.ETag = cmn.QuoteETag(ov.ETag)
+	}
+	r.Versions = append(r.Versions, vi)
+}
+
+// merge (e.g., per-target) results
+func (r *ListVersionsResult) Merge(other *ListVersionsResult) {
+	r.Versions = append(r.Versions, other.Versions...)
+	r.DeleteMarkers = append(r.DeleteMarkers, other.DeleteMarkers...)
+	r.IsTruncated = r.IsTruncated || other.IsTruncated
+}
+
+// sort by key and (descending) version, skip entries up to and including the markers,
+// and limit the result to max-keys
+func (r *ListVersionsResult) Finalize() {
+	all := make([]verEntry, 0, len(r.Versions)+len(r.DeleteMarkers))
+	for _, v := range r.Versions {
+		n, _ := strconv.ParseUint(v.VersionID, 10, 64)
+		all = append(all, verEntry{v: v, key: v.Key, ver: n})
+	}
+	for _, dm := range r.DeleteMarkers {
+		n, _ := strconv.ParseUint(dm.VersionID, 10, 64)
+		all = append(all, verEntry{dm: dm, key: dm.Key, ver: n})
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].key != all[j].key {
+			return all[i].key < all[j].key
+		}
+		return all[i].ver > all[j].ver
+	})
+
+	// markers
+	if r.KeyMarker != "" {
+		var (
+			markerVer, _ = strconv.ParseUint(r.VersionIDMarker, 10, 64)
+			i            int
+		)
+		for ; i < len(all); i++ {
+			e := &all[i]
+			if e.key > r.KeyMarker {
+				break
+			}
+			if e.key == r.KeyMarker && r.VersionIDMarker != "" && e.ver < markerVer {
+				break
+			}
+		}
+		all = all[i:]
+	}
+
+	// max-keys
+	if len(all) > r.MaxKeys {
+		all = all[:r.MaxKeys]
+		r.IsTruncated = true
+	}
+	if r.IsTruncated && len(all) > 0 {
+		last := &all[len(all)-1]
+		r.NextKeyMarker, r.NextVersionIDMarker = last.key, strconv.FormatUint(last.ver, 10)
+	}
+
+	r.Versions, r.DeleteMarkers = r.Versions[:0], r.DeleteMarkers[:0]
+	for i := range all {
+		if all[i].v != nil {
+			r.Versions = append(r.Versions, all[i].v)
+		} else {
+			r.DeleteMarkers = append(r.DeleteMarkers, all[i].dm)
+		}
+	}
+}
+
+func (r *ListVersionsResult) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write(cos.UnsafeB(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
@@ -0,0 +1,73 @@
+// Package s3_test provides tests for the Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestListVersionsFinalize(t *testing.T) {
+	var (
+		now = time.Now()
+		t1  = &s3.ListVersionsResult{}
+		t2  = &s3.ListVersionsResult{}
+	)
+	// target 1: "a" (current 3, prior 2 and 1), "c" (deleted: marker 2, prior 1)
+	t1.Add("a", &core.ObjVersion{Version: "3", Mtime: now, Size: 30, ETag: "e3"}, true)
+	t1.Add("a", &core.ObjVersion{Version: "2", Mtime: now, Size: 20}, false)
+	t1.Add("a", &core.ObjVersion{Version: "1", Mtime: now, Size: 10}, false)
+	t1.Add("c", &core.ObjVersion{Version: "2", Mtime: now, DeleteMarker: true}, true)
+	t1.Add("c", &core.ObjVersion{Version: "1", Mtime: now, Size: 1}, false)
+	// target 2: "b" (current 10, prior 9)
+	t2.Add("b", &core.ObjVersion{Version: "10", Mtime: now, Size: 100}, true)
+	t2.Add("b", &core.ObjVersion{Version: "9", Mtime: now, Size: 90}, false)
+
+	// page 1
+	all := s3.NewListVersionsResult("bucket", url.Values{s3.QparamMaxKeys: []string{"4"}})
+	all.Merge(t2)
+	all.Merge(t1)
+	all.Finalize()
+	tassert.Fatalf(t, all.IsTruncated && all.NextKeyMarker == "b" && all.NextVersionIDMarker == "10",
+		"page 1: unexpected %+v", all)
+	tassert.Fatalf(t, len(all.Versions) == 4 && all.Versions[0].Key == "a" && all.Versions[0].VersionID == "3" &&
+		all.Versions[0].ETag == `"e3"` && all.Versions[2].VersionID == "1" && all.Versions[3].Key == "b",
+		"page 1: unexpected versions %+v", all.Versions)
+
+	// page 2 (next markers)
+	q := url.Values{
+		s3.QparamKeyMarker:       []string{all.NextKeyMarker},
+		s3.QparamVersionIDMarker: []string{all.NextVersionIDMarker},
+	}
+	all = s3.NewListVersionsResult("bucket", q)
+	all.Merge(t1)
+	all.Merge(t2)
+	all.Finalize()
+	tassert.Fatalf(t, !all.IsTruncated, "page 2: not expecting truncation")
+	tassert.Fatalf(t, len(all.Versions) == 2 && all.Versions[0].Key == "b" && all.Versions[0].VersionID == "9" &&
+		all.Versions[1].Key == "c", "page 2: unexpected versions %+v", all.Versions)
+	tassert.Fatalf(t, len(all.DeleteMarkers) == 1 && all.DeleteMarkers[0].IsLatest,
+		"page 2: unexpected delete markers %+v", all.DeleteMarkers)
+
+	// XML
+	sgl := memsys.PageMM().NewSGL(0)
+	defer sgl.Free()
+	all.MustMarshal(sgl)
+	b := sgl.ReadAll()
+	tassert.Fatalf(t, strings.Contains(string(b), "<ListVersionsResult") &&
+		strings.Contains(string(b), "<DeleteMarker><Key>c</Key><VersionId>2</VersionId><IsLatest>true</IsLatest>"),
+		"unexpected XML: %s", string(b))
+	out := &s3.ListVersionsResult{}
+	tassert.CheckFatal(t, xml.Unmarshal(b, out))
+	tassert.Fatalf(t, len(out.Versions) == 2 && len(out.DeleteMarkers) == 1, "XML round trip: %+v", out)
+}
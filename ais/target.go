@@ -36,6 +36,7 @@ import (
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/health"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/mirror"
 	"github.com/NVIDIA/aistore/nl"
@@ -47,6 +48,8 @@ import (
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
 	"github.com/NVIDIA/aistore/xact/xs"
+
+	jsoniter "github.com/json-iterator/go"
 )
 
 const dbName = "ais.db"
@@ -71,6 +74,7 @@ type (
 		fsprg    fsprungroup
 		txns     txns
 		ups      ups
+		lsoq     lsoq
 		htrun    // common w/ proxy
 		regstate regstate
 	}
@@ -116,8 +120,9 @@ func (*target) interruptedRestarted() (i, r bool) {
 // A misconfigured global credential (e.g., bad GOOGLE_APPLICATION_CREDENTIALS)
 // will not prevent target (or cluster) startup.
 //
-// Per-bucket credentials (e.g., `extra.aws.profile`, `extra.gcp.application_creds`)
-// bypass global defaults entirely and are resolved independently on demand.
+// Per-bucket credentials (e.g., `extra.aws.profile`, `extra.gcp.application_creds`,
+// `extra.<provider>.secret`) bypass global defaults entirely and are resolved
+// independently on demand; named secrets are also reloaded upon change (see backend/secrets.go).
 //
 // The same initBuiltTagged logic runs via `ais advanced enable-backend`
 // and `ais advanced disable-backend` CLI/API, allowing runtime (re)configuration
@@ -157,10 +162,10 @@ func (t *target) Backend(bck *meta.Bck) core.Backend { // as core.Target
 }
 
 func (t *target) _rlbp(bp core.Backend, bprops *cmn.Bprops, provider string) core.Backend {
-	if bprops == nil || !bprops.RateLimit.Backend.Enabled {
+	if bprops == nil || (!bprops.RateLimit.Backend.Enabled && !bprops.Hedge.Enabled) {
 		return bp
 	}
-	// with rate limit
+	// with rate limit and/or hedging
 	return t.rlbps[provider]
 }
 
@@ -219,6 +224,10 @@ func (t *target) initBuiltTagged(config *cmn.Config, startingUp bool) error {
 			bp, err = backend.NewOCI(t, tstats, startingUp)
 		case apc.HT:
 			bp, err = backend.NewHT(t, config, tstats, startingUp)
+		case apc.File:
+			bp, err = backend.NewFile(t, config, tstats, startingUp)
+		case apc.HDFS:
+			bp, err = backend.NewHDFS(t, config, tstats, startingUp)
 		case apc.AIS:
 			continue
 		default:
@@ -493,6 +502,9 @@ func (t *target) Run() error {
 	}
 
 	t.txns.init(t)
+	t.regLifecycle()
+	t.regRepl()
+	hk.Reg("backend-secrets"+hk.NameSuffix, backend.RefreshSecrets, hk.SecretsIval)
 
 	t.reb = reb.New(config)
 	t.res = res.New()
@@ -627,6 +639,7 @@ func (t *target) initRecvHandlers() {
 		networkHandler{r: apc.EC, h: t.ecHandler, net: accessNetIntraControl},
 		networkHandler{r: apc.Vote, h: t.voteHandler, net: accessNetIntraControl},
 		networkHandler{r: apc.Txn, h: t.txnHandler, net: accessNetIntraControl},
+		networkHandler{r: apc.Events, h: t.eventsHandler, net: accessNetIntraControl},
 		networkHandler{r: apc.ObjStream, h: transport.RxAnyStream, net: accessControlData},
 
 		networkHandler{r: apc.Download, h: t.downloadHandler, net: accessNetIntraControl},
@@ -1068,9 +1081,13 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 			break
 		}
 		// do
+		var a *putA2I
 		lom.Lock(true)
-		ecode, err = t.putApndArch(r, lom, started, dpq)
+		a, ecode, err = t.putApndArch(r, lom, started, dpq)
 		lom.Unlock(true)
+		if err == nil && a.edits != nil && a.mime == archive.ExtTar {
+			a.reindex()
+		}
 	case apndTy != "":
 		a := &apndOI{
 			started: started,
@@ -1166,7 +1183,7 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 		return
 	}
 
-	ecode, err := t.DeleteObject(lom, evict)
+	ecode, err := t.deleteObject(lom, evict, false /*bypass governance*/, t.isReplicated(r))
 	if err == nil && ecode == 0 {
 		// EC cleanup if EC is enabled
 		ec.ECM.CleanupObject(lom)
@@ -1577,7 +1594,7 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 }
 
 // called under lock
-func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq *dpq) (int, error) {
+func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq *dpq) (*putA2I, int, error) {
 	var (
 		mime     = dpq.arch.mime // apc.QparamArchmime
 		filename = dpq.arch.path // apc.QparamArchpath
@@ -1591,10 +1608,15 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 	if s := r.Header.Get(apc.HdrPutApndArchFlags); s != "" {
 		var errV error
 		if flags, errV = strconv.ParseInt(s, 10, 64); errV != nil {
-			return http.StatusBadRequest,
+			return nil, http.StatusBadRequest,
 				fmt.Errorf("failed to archive %s: invalid flags %q in the request", lom.Cname(), s)
 		}
 	}
+	edit := flags & (apc.ArchReplace | apc.ArchDelete)
+	if edit == apc.ArchReplace|apc.ArchDelete {
+		return nil, http.StatusBadRequest,
+			fmt.Errorf("failed to archive %s: replace and delete are mutually exclusive", lom.Cname())
+	}
 	a := &putA2I{
 		started:  started,
 		t:        t,
@@ -1606,10 +1628,11 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 	}
 	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
 		if !cos.IsNotExist(err) {
-			return http.StatusInternalServerError, err
+			return nil, http.StatusInternalServerError, err
 		}
-		if flags == apc.ArchAppend {
-			return http.StatusNotFound, err
+		if flags == apc.ArchAppend || edit == apc.ArchDelete ||
+			(edit == apc.ArchReplace && flags&apc.ArchAppendIfExist == 0) {
+			return nil, http.StatusNotFound, err
 		}
 		a.put = true
 	} else {
@@ -1620,17 +1643,47 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 			a.size = size
 		}
 	}
+
+	switch {
+	case edit == apc.ArchDelete:
+		// names to delete: archpath and, optionally, JSON list in the body
+		a.edits = archive.Edits{filename: {}}
+		if a.size > 0 {
+			var names []string
+			if err := jsoniter.NewDecoder(a.r).Decode(&names); err != nil {
+				return nil, http.StatusBadRequest,
+					fmt.Errorf("failed to delete from %s: invalid list of archived filenames: %v", lom.Cname(), err)
+			}
+			for _, name := range names {
+				if err := cos.ValidateArchpath(name); err != nil {
+					return nil, http.StatusBadRequest, err
+				}
+				a.edits[name] = &archive.Edit{}
+			}
+		}
+		a.r, a.size = http.NoBody, 0
+		code, err := a.do()
+		return a, code, err
+	case edit == apc.ArchReplace && !a.put:
+		a.edits = archive.Edits{filename: {R: a.r}}
+	}
 	if a.size == 0 {
-		return http.StatusBadRequest, fmt.Errorf("failed to archive %s: missing %q in the request",
+		return nil, http.StatusBadRequest, fmt.Errorf("failed to archive %s: missing %q in the request",
 			lom.Cname(), cos.HdrContentLength)
 	}
-	return a.do()
+	code, err := a.do()
+	return a, code, err
 }
 
-func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
+func (t *target) DeleteObject(lom *core.LOM, evict bool) (int, error) {
+	return t.deleteObject(lom, evict, false /*bypass governance*/, false /*replicated*/)
+}
+
+// (bypassGovernance: see cmn/objlock; replicated: see tgtrepl)
+func (t *target) deleteObject(lom *core.LOM, evict, bypassGovernance, replicated bool) (code int, err error) {
 	var isback bool
 	lom.Lock(true)
-	code, err, isback = t.delobj(lom, evict)
+	code, err, isback = t.delobj(lom, evict, bypassGovernance)
 	lom.Unlock(true)
 
 	// special corner-case retry (quote):
@@ -1650,10 +1703,15 @@ func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
 	switch {
 	case err == nil:
 		t.statsT.IncWith(stats.DeleteCount, vlabs)
+		if !evict && !replicated {
+			t.delRepl(lom)
+		}
 	case cos.IsNotExist(err, code) || cmn.IsErrObjNought(err):
 		if !evict {
 			t.statsT.IncWith(stats.ErrDeleteCount, vlabs)
 		}
+	case cmn.IsErrObjLocked(err):
+		t.statsT.IncWith(stats.ErrDeleteCount, vlabs)
 	default:
 		// not to confuse with `stats.RemoteDeletedDelCount` that counts against
 		// QparamLatestVer, 'versioning.validate_warm_get' and friends
@@ -1724,7 +1782,7 @@ func (t *target) copyObject(lom *core.LOM, bck *meta.Bck, objName string, dpq *d
 }
 
 // NOTE: s3 will return err=nil with OK status to indicate (not deleting) non-existing object (see also aws.go)
-func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
+func (t *target) delobj(lom *core.LOM, evict, bypassGovernance bool) (int, error, bool) {
 	var (
 		aisErr, backendErr         error
 		aisErrCode, backendErrCode int
@@ -1747,15 +1805,27 @@ func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
 			return http.StatusNotFound, cos.NewErrNotFound(t, lom.Cname()), false
 		}
 	} else {
+		if err := lom.CheckDelWORM(bypassGovernance); err != nil {
+			return http.StatusForbidden, err, false
+		}
 		delFromAIS = true
 	}
 
 	// do
 	if delFromBackend {
 		backendErrCode, backendErr = t.Backend(lom.Bck()).DeleteObj(context.Background(), lom)
+		if backendErr == nil {
+			t.lsoChanged(lom.Bck(), lom.ObjName)
+		}
 	}
 	if delFromAIS {
 		size := lom.Lsize()
+		if lom.RetainsVersions() {
+			// retain the current version and add delete marker (see core/lversion)
+			if _, err := lom.AddDeleteMarker(); err != nil {
+				return 0, err, false
+			}
+		}
 		aisErr = lom.RemoveObj()
 		if aisErr != nil {
 			if !cos.IsNotExist(aisErr) {
@@ -1782,15 +1852,24 @@ func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
 // rename obj
 // TODO: (copy, delete) under a single wlock
 func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) error {
-	if lom.Bck().IsRemote() {
-		return fmt.Errorf("%s: cannot rename object %s from remote bucket", t.si, lom)
-	}
 	if lom.ECEnabled() {
 		return fmt.Errorf("%s: cannot rename erasure-coded object %s", t.si, lom)
 	}
 	if msg.Name == lom.ObjName {
 		return fmt.Errorf("%s: cannot rename/move object %s onto itself", t.si, lom)
 	}
+	if lom.Bck().IsRemote() {
+		return t.objMvRemote(lom, msg.Name)
+	}
+	// object lock (WORM): check early (fail fast) and again under wlock prior to deleting
+	if lom.Bprops().ObjLock.Enabled {
+		lom.Lock(true)
+		err := mvCheckWORM(lom)
+		lom.Unlock(true)
+		if err != nil {
+			return err
+		}
+	}
 
 	buf, slab := t.gmm.Alloc()
 	coiParams := xs.AllocCOI()
@@ -1811,6 +1890,11 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) error {
 	}
 
 	lom.Lock(true)
+	if err := mvCheckWORM(lom); err != nil {
+		// locked while being copied: keep the source intact
+		lom.Unlock(true)
+		return fmt.Errorf("failed to rename %s => %s (the latter was created): %w", lom, msg.Name, err)
+	}
 	if err := lom.RemoveObj(); err != nil {
 		nlog.Warningf("%s: failed to delete renamed object %s (new name %s): %v", t, lom, msg.Name, err)
 	}
@@ -1818,6 +1902,42 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) error {
 	return nil
 }
 
+// (expecting wlock)
+func mvCheckWORM(lom *core.LOM) error {
+	if !lom.Bprops().ObjLock.Enabled {
+		return nil
+	}
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return nil // (not found and such is handled elsewhere)
+	}
+	return lom.CheckWORM(false /*bypass*/)
+}
+
+// server-side rename (see core.BackendRenamer), and evict in-cluster copy if present
+func (t *target) objMvRemote(lom *core.LOM, objNameTo string) error {
+	bp, ok := t.bps[lom.Bck().RemoteBck().Provider].(core.BackendRenamer)
+	if !ok {
+		return fmt.Errorf("%s: cannot rename object %s from remote bucket", t.si, lom)
+	}
+	lom.Lock(true)
+	if err := mvCheckWORM(lom); err != nil {
+		lom.Unlock(true)
+		return err
+	}
+	if _, err := bp.RenameObj(context.Background(), lom, objNameTo); err != nil {
+		lom.Unlock(true)
+		return err
+	}
+	t.lsoChanged(lom.Bck(), lom.ObjName, objNameTo)
+	if err := lom.RemoveObj(); err != nil {
+		nlog.Warningf("%s: failed to evict renamed object %s (new name %s): %v", t, lom, objNameTo, err)
+	}
+	lom.Unlock(true)
+
+	t.evictCached(lom.Bck(), objNameTo) // (overwritten remotely)
+	return nil
+}
+
 // compare running the same via (generic) t.xstart
 func (t *target) blobdl(params *core.BlobParams, oa *cmn.ObjAttrs, whdr http.Header) (string, *xs.XactBlobDl, error) {
 	// cap
@@ -8,9 +8,12 @@ import (
 	"archive/tar"
 	"fmt"
 	"math/rand/v2"
+	"net/http"
 	"net/url"
 	"path"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -21,6 +24,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/tabular"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/tools"
 	"github.com/NVIDIA/aistore/tools/readers"
@@ -96,6 +100,12 @@ func TestGetFromArch(t *testing.T) {
 				{
 					ext: archive.ExtTarLz4, nested: true, autodetect: true, mime: true,
 				},
+				{
+					ext: archive.ExtTarZst, nested: false, autodetect: false, mime: false,
+				},
+				{
+					ext: archive.ExtTarZst, nested: true, autodetect: true, mime: true,
+				},
 				{
 					ext: archive.ExtTar, nested: false, autodetect: false, mime: false, idx: "fresh",
 				},
@@ -322,6 +332,9 @@ func testArch(t *testing.T, bck *meta.Bck) {
 			{
 				ext: archive.ExtTarLz4, list: false,
 			},
+			{
+				ext: archive.ExtTarZst, list: true, apnd: true,
+			},
 		}
 	)
 	if testing.Short() {
@@ -548,6 +561,9 @@ func TestAppendToArch(t *testing.T) {
 			{
 				ext: archive.ExtTarLz4, multi: true,
 			},
+			{
+				ext: archive.ExtTarZst, multi: false,
+			},
 		}
 	)
 	if !testing.Short() { // test-long, and see one other Skip below
@@ -672,3 +688,124 @@ func TestAppendToArch(t *testing.T) {
 		})
 	}
 }
+
+// exercises api.PutApndArch(apc.ArchReplace) and api.DeleteFromArch
+func TestEditArch(t *testing.T) {
+	var (
+		bck        = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		names      = []string{"s1.cls", "s1.jpg", "s2.cls", "s2.jpg", "s3.cls", "s3.jpg"}
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+
+	putArch := func(t *testing.T, archName, archpath string, content []byte, flags int64) {
+		args := api.PutApndArchArgs{
+			PutArgs: api.PutArgs{
+				BaseParams: baseParams,
+				Bck:        bck,
+				ObjName:    archName,
+				Reader:     readers.NewBytes(content),
+				Size:       uint64(len(content)),
+			},
+			ArchPath: archpath,
+			Flags:    flags,
+		}
+		tassert.CheckFatal(t, api.PutApndArch(&args))
+	}
+
+	for _, ext := range []string{archive.ExtTar, archive.ExtTarLz4, archive.ExtZip, archive.ExtTarZst} {
+		t.Run(ext, func(t *testing.T) {
+			archName := "shard" + ext
+			for i, name := range names {
+				putArch(t, archName, name, []byte(name), cos.Ternary(i == 0, int64(0), int64(apc.ArchAppend)))
+			}
+
+			// replace in place
+			props, err := api.HeadObject(baseParams, bck, archName, api.HeadArgs{})
+			tassert.CheckFatal(t, err)
+			replacement := []byte("replaced " + ext)
+			putArch(t, archName, "s2.jpg", replacement, apc.ArchReplace)
+
+			// delete
+			tassert.CheckFatal(t, api.DeleteFromArch(baseParams, bck, archName, []string{"s1.cls", "s1.jpg"}))
+
+			// each edit is a new version
+			ver, _ := strconv.Atoi(props.Version())
+			props, err = api.HeadObject(baseParams, bck, archName, api.HeadArgs{})
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, props.Version() == strconv.Itoa(ver+2), "expected version %d, got %s", ver+2, props.Version())
+			err = api.DeleteFromArch(baseParams, bck, archName, []string{"nonexistent"})
+			tassert.Errorf(t, api.HTTPStatus(err) == http.StatusNotFound, "expected 404, got %v", err)
+
+			// validate
+			lsmsg := &apc.LsoMsg{Prefix: archName}
+			lsmsg.SetFlag(apc.LsArchDir)
+			lst, err := api.ListObjects(baseParams, bck, lsmsg, api.ListArgs{})
+			tassert.CheckFatal(t, err)
+			expected := []string{archName, archName + "/s2.cls", archName + "/s2.jpg", archName + "/s3.cls", archName + "/s3.jpg"}
+			tassert.Fatalf(t, len(lst.Entries) == len(expected), "expected %d entries, got %d", len(expected), len(lst.Entries))
+			for i, en := range lst.Entries {
+				tassert.Errorf(t, en.Name == expected[i], "expected %q, got %q", expected[i], en.Name)
+			}
+
+			var sb strings.Builder
+			getArgs := api.GetArgs{
+				Writer: &sb,
+				Query:  url.Values{apc.QparamArchpath: []string{"s2.jpg"}},
+			}
+			_, err = api.GetObject(baseParams, bck, archName, &getArgs)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, sb.String() == string(replacement), "expected %q, got %q", replacement, sb.String())
+		})
+	}
+}
+
+// Parquet row groups as archived entries (see cmn/tabular)
+func TestTabularRowGroups(t *testing.T) {
+	var (
+		bck        = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		objName    = "table" + tabular.ExtParquet
+		rowGroups  = []int{5, 3, 17}
+		content    = tarch.CreateParquet(rowGroups...)
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+	tools.PutObject(t, bck, objName, readers.NewBytes(content), uint64(len(content)))
+
+	// list
+	lsmsg := &apc.LsoMsg{Prefix: objName}
+	lsmsg.SetFlag(apc.LsArchDir)
+	lst, err := api.ListObjects(baseParams, bck, lsmsg, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(lst.Entries) == len(rowGroups)+1, "expected %d entries, got %d", len(rowGroups)+1, len(lst.Entries))
+	tassert.Errorf(t, lst.Entries[0].Name == objName && lst.Entries[0].IsAnyFlagSet(apc.EntryIsArchive), "expected %q (archive)", objName)
+	for i, n := range rowGroups {
+		en := lst.Entries[i+1]
+		name := objName + "/" + tabular.RowGroupName(i)
+		tassert.Errorf(t, en.Name == name && en.IsAnyFlagSet(apc.EntryInArch), "expected %q (in archive), got %q", name, en.Name)
+		numRows := cmn.S2CustomVal(en.Custom, cmn.LsoNumRows)
+		tassert.Errorf(t, numRows == strconv.Itoa(n), "%s: expected %d rows, got %q", en.Name, n, numRows)
+	}
+
+	// GET rowgroup/N: Arrow IPC stream
+	for i := range rowGroups {
+		var (
+			sb      strings.Builder
+			getArgs = api.GetArgs{
+				Writer: &sb,
+				Query:  url.Values{apc.QparamArchpath: []string{tabular.RowGroupName(i)}},
+			}
+		)
+		oah, err := api.GetObject(baseParams, bck, objName, &getArgs)
+		tassert.CheckFatal(t, err)
+		s := sb.String()
+		tassert.Errorf(t, oah.Size() == int64(len(s)), "%s: size %d vs %d", tabular.RowGroupName(i), oah.Size(), len(s))
+		tassert.Errorf(t, strings.HasPrefix(s, "\xff\xff\xff\xff") && strings.HasSuffix(s, "\xff\xff\xff\xff\x00\x00\x00\x00"),
+			"%s: expecting Arrow IPC stream", tabular.RowGroupName(i))
+	}
+	getArgs := api.GetArgs{Query: url.Values{apc.QparamArchpath: []string{tabular.RowGroupName(len(rowGroups))}}}
+	_, err = api.GetObject(baseParams, bck, objName, &getArgs)
+	tassert.Errorf(t, api.HTTPStatus(err) == http.StatusNotFound, "expected 404, got %v", err)
+}
@@ -97,7 +97,7 @@ func (c *mossConfig) name() (s string) {
 // Allow 1% tolerance for compressed TAR formats while requiring exact equality for the rest.
 func equalSize(outputFormat string, size1, size2 int) bool {
 	switch outputFormat {
-	case archive.ExtTgz, archive.ExtTarGz, archive.ExtTarLz4:
+	case archive.ExtTgz, archive.ExtTarGz, archive.ExtTarLz4, archive.ExtTarZst:
 		if size1 == size2 {
 			return true
 		}
@@ -174,12 +174,14 @@ func TestMoss(t *testing.T) {
 		// (streaming; read plain objects and format output as something other than TAR)
 		{inputFormat: "", outputFormat: archive.ExtTgz, streaming: true},
 		{inputFormat: "", outputFormat: archive.ExtTarLz4, continueOnErr: true, withMissing: true, streaming: true},
+		{inputFormat: "", outputFormat: archive.ExtTarZst, continueOnErr: true, withMissing: true, streaming: true},
 		{inputFormat: "", outputFormat: archive.ExtZip, continueOnErr: true, onlyObjName: true, withMissing: true, streaming: true},
 
 		// (streaming; read from shards and format output as ...)
 		{inputFormat: archive.ExtTar, outputFormat: archive.ExtTgz, streaming: true},
 		{inputFormat: archive.ExtTar, outputFormat: archive.ExtTarLz4, continueOnErr: true, withMissing: true, streaming: true},
 		{inputFormat: archive.ExtTarLz4, outputFormat: archive.ExtZip, continueOnErr: true, onlyObjName: true, withMissing: true, streaming: true},
+		{inputFormat: archive.ExtTarZst, outputFormat: archive.ExtTarZst, continueOnErr: true, withMissing: true, streaming: true},
 
 		// Indexed TAR variants: exercise NewArchpathReader's shard-index paths in moss.
 		// "fresh" hits the fast-path seek; "stale" hits IsStale + fallback to scan.
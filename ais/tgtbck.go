@@ -678,6 +678,20 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 			return
 		}
 		_, err = t.runRechunk(msg.UUID, apireq.bck, rechunkMsg)
+	case apc.ActSyncBck:
+		syncMsg := &apc.SyncBckMsg{}
+		if err = cos.MorphMarshal(msg.Value, syncMsg); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		bckTo, errV := newBckFromQuname(r.URL.Query(), true /*required*/)
+		if errV != nil {
+			t.writeErr(w, r, errV)
+			return
+		}
+		if err = bckTo.Init(t.owner.bmd); err == nil {
+			_, err = t.runSyncBck(msg.UUID, &xreg.SyncBckArgs{BckFrom: apireq.bck, BckTo: bckTo, Msg: syncMsg})
+		}
 	case apc.ActIndexShard:
 		sishMsg := &apc.IndexShardMsg{}
 		if err = cos.MorphMarshal(msg.Value, sishMsg); err != nil {
@@ -717,6 +731,30 @@ func (t *target) runRechunk(xactID string, bck *meta.Bck, rechunkMsg *apc.Rechun
 	return xctn.ID(), nil
 }
 
+func (t *target) runSyncBck(xactID string, args *xreg.SyncBckArgs) (xid string, err error) {
+	if !args.Msg.Force {
+		if err := xreg.LimitedCoexistence(t.si, args.BckFrom, apc.ActSyncBck, args.BckTo); err != nil {
+			return "", err
+		}
+	}
+	rns := xreg.RenewSyncBck(xactID, args)
+	if rns.Err != nil {
+		return "", rns.Err
+	}
+	xctn := rns.Entry.Get()
+	notif := &xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xctn,
+	}
+	xctn.AddNotif(notif)
+
+	if cmn.Rom.V(5, cos.ModAIS) {
+		nlog.Infoln("start sync", args.BckFrom.String(), "=>", args.BckTo.String(), "xid", xactID)
+	}
+	xact.GoRunW(xctn)
+	return xctn.ID(), nil
+}
+
 func (t *target) runIndexShard(xactID string, bck *meta.Bck, msg *apc.IndexShardMsg) (xid string, err error) {
 	if err := xreg.LimitedCoexistence(t.si, bck, apc.ActIndexShard); err != nil {
 		return "", err
@@ -170,6 +170,7 @@ func (t *target) daeputMsg(w http.ResponseWriter, r *http.Request) {
 
 	case apc.ActReloadBackendCreds:
 		provider := msg.Name
+		backend.ReloadSecrets() // per-bucket (see extra.<provider>.secret)
 
 		// all
 		if provider == "" {
@@ -193,6 +194,10 @@ func (t *target) daeputMsg(w http.ResponseWriter, r *http.Request) {
 			bp, err = backend.NewAzure(t, t.statsT, false)
 		case apc.OCI:
 			bp, err = backend.NewOCI(t, t.statsT, false)
+		case apc.File:
+			bp, err = backend.NewFile(t, cmn.GCO.Get(), t.statsT, false)
+		case apc.HDFS:
+			bp, err = backend.NewHDFS(t, cmn.GCO.Get(), t.statsT, false)
 		}
 		if err != nil {
 			t.writeErr(w, r, err)
@@ -310,6 +315,10 @@ func (t *target) enableBackend(w http.ResponseWriter, r *http.Request, provider,
 			bp, err = backend.NewAzure(t, t.statsT, false /*starting up*/)
 		case apc.OCI:
 			bp, err = backend.NewOCI(t, t.statsT, false /*starting up*/)
+		case apc.File:
+			bp, err = backend.NewFile(t, config, t.statsT, false /*starting up*/)
+		case apc.HDFS:
+			bp, err = backend.NewHDFS(t, config, t.statsT, false /*starting up*/)
 		}
 		if err != nil {
 			t.writeErr(w, r, err)
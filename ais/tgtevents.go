@@ -0,0 +1,185 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+// change notifications from the proxy (see prxevents)
+func (t *target) eventsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		cmn.WriteErr405(w, r, http.MethodPost)
+		return
+	}
+	var events bckEvents
+	if err := cmn.ReadJSON(w, r, &events); err != nil {
+		return
+	}
+	if len(events.Evict) > 0 {
+		if err := t.checkIntraCall(r, false /*from primary*/); err != nil {
+			t.writeErr(w, r, err, http.StatusForbidden)
+			return
+		}
+		for i := range events.Evict {
+			t._evictCached(&events.Evict[i])
+		}
+	}
+	var n int
+	for _, ev := range events.Events {
+		n += xs.InvalidateLsoCache(ev.Provider, ev.Bucket, ev.ObjName)
+	}
+	if n > 0 && cmn.Rom.V(4, cos.ModAIS) {
+		nlog.Infoln(t.String(), "lso-cache: invalidated", n, "page(s) upon", len(events.Events), "event(s)")
+	}
+}
+
+// in-cluster change (PUT, DELETE, rename) of remote object(s) in a bucket with listing cache:
+// invalidate cached pages here and, asynchronously and in batches, on all other targets
+// (any target can be designated to list the next page - see xs.LsoXact)
+// - callers may hold object locks: no network IO here
+func (t *target) lsoChanged(bck *meta.Bck, objNames ...string) {
+	if !bck.IsRemote() || !bck.Props.LsoCache.Enabled {
+		return
+	}
+	var (
+		n    int
+		rbck = bck.RemoteBck()
+	)
+	for _, objName := range objNames {
+		n += xs.InvalidateLsoCache(rbck.Provider, rbck.Name, objName)
+	}
+	if n > 0 && cmn.Rom.V(4, cos.ModAIS) {
+		nlog.Infoln(t.String(), "lso-cache: invalidated", n, "page(s) upon", bck.Cname(objNames[0]))
+	}
+	t.lsoq.add(t, rbck, objNames)
+}
+
+// remote object changed out of band (e.g., native server-side copy or rename):
+// evict its (now stale) in-cluster copy, if any, at the target that owns it
+func (t *target) evictCached(bck *meta.Bck, objName string) {
+	var (
+		smap    = t.owner.smap.get()
+		ev      = objEvict{Bck: *bck.Bucket(), ObjName: objName}
+		tsi, er = smap.HrwName2T(bck.MakeUname(objName))
+	)
+	if er != nil {
+		nlog.Warningln(t.String(), "failed to evict", bck.Cname(objName), "[", er, "]")
+		return
+	}
+	if tsi.ID() == t.SID() {
+		t._evictCached(&ev)
+		return
+	}
+	cargs := allocCargs()
+	{
+		cargs.si = tsi
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodPost,
+			Base:   tsi.URL(cmn.NetIntraControl),
+			Path:   apc.URLPathEvents.S,
+			Body:   cos.MustMarshal(&bckEvents{Evict: []objEvict{ev}}),
+		}
+		cargs.timeout = cmn.Rom.CplaneOperation()
+	}
+	res := t.call(cargs, smap)
+	freeCargs(cargs)
+	if res.err != nil {
+		nlog.Warningln(t.String(), "failed to evict", bck.Cname(objName), "at", tsi.StringEx(), "[", res.err, "]")
+	}
+	freeCR(res)
+}
+
+func (t *target) _evictCached(ev *objEvict) {
+	lom := core.AllocLOM(ev.ObjName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitCmnBck(&ev.Bck); err != nil {
+		nlog.Warningln(t.String(), "failed to evict", ev.Bck.Cname(ev.ObjName), "[", err, "]")
+		return
+	}
+	ecode, err := t.deleteObject(lom, true /*evict*/, false /*bypass governance*/, true /*replicated*/)
+	if err != nil && !cos.IsNotExist(err, ecode) && !cmn.IsErrObjNought(err) {
+		nlog.Warningln(t.String(), "failed to evict", lom.Cname(), "[", err, "]")
+	}
+}
+
+//////////
+// lsoq //
+//////////
+
+const (
+	lsoqDelay = 100 * time.Millisecond // batching interval
+	lsoqMax   = 1000                   // max events per batch; beyond that, invalidate entire bucket(s)
+)
+
+type lsoq struct {
+	pending map[bckEvent]struct{}
+	mu      sync.Mutex
+	sched   bool // flush scheduled
+}
+
+func (q *lsoq) add(t *target, rbck *cmn.Bck, objNames []string) {
+	q.mu.Lock()
+	if q.pending == nil {
+		q.pending = make(map[bckEvent]struct{}, 16)
+	}
+	for _, objName := range objNames {
+		q.pending[bckEvent{Provider: rbck.Provider, Bucket: rbck.Name, ObjName: objName}] = struct{}{}
+	}
+	if len(q.pending) > lsoqMax {
+		q.collapse()
+	}
+	if !q.sched {
+		q.sched = true
+		time.AfterFunc(lsoqDelay, func() { q.flush(t) })
+	}
+	q.mu.Unlock()
+}
+
+// (under lock)
+func (q *lsoq) collapse() {
+	all := make(map[bckEvent]struct{}, 4)
+	for ev := range q.pending {
+		ev.ObjName = ""
+		all[ev] = struct{}{}
+	}
+	q.pending = all
+}
+
+func (q *lsoq) flush(t *target) {
+	q.mu.Lock()
+	events := make([]bckEvent, 0, len(q.pending))
+	for ev := range q.pending {
+		events = append(events, ev)
+	}
+	clear(q.pending)
+	q.sched = false
+	q.mu.Unlock()
+	if len(events) == 0 {
+		return
+	}
+
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodPost, Path: apc.URLPathEvents.S, Body: cos.MustMarshal(&bckEvents{Events: events})}
+	args.to = core.Targets
+	results := t.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			nlog.Warningln(t.String(), "lso-cache: failed to notify", res.si.StringEx(), "upon", len(events), "event(s), err:", res.err)
+		}
+	}
+	freeBcastRes(results)
+}
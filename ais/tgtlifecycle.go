@@ -0,0 +1,104 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Bucket lifecycle (see cmn/lifecycle.go):
+// periodically, each target independently
+// - starts x-lifecycle to expire (locally stored) objects in ais:// buckets, and
+// - aborts incomplete multipart uploads (that it owns)
+// in all buckets that have enabled lifecycle rules.
+
+const lifecycleHKName = "lifecycle" + hk.NameSuffix
+
+func (t *target) regLifecycle() {
+	hk.Reg(lifecycleHKName, t.housekeepLifecycle, hk.LifecycleIval)
+}
+
+func (t *target) housekeepLifecycle(int64) time.Duration {
+	if !t.ClusterStarted() || t.regstate.disabled.Load() {
+		return hk.LifecycleIval
+	}
+	var (
+		bmd = t.owner.bmd.get()
+		now = time.Now()
+	)
+	bmd.Range(nil /*any provider*/, nil /*any namespace*/, func(bck *meta.Bck) bool {
+		conf := &bck.Props.Lifecycle
+		if conf.IsEmpty() {
+			return false
+		}
+		if conf.AbortsMpt() {
+			t.ups.abortStale(bck, conf, now)
+		}
+		if conf.Expires() && bck.IsAIS() { // (see cmn.LifecycleConf.ValidateAsProps)
+			t.runLifecycle(bck)
+		}
+		return false
+	})
+	return hk.LifecycleIval
+}
+
+func (t *target) runLifecycle(bck *meta.Bck) {
+	if err := xreg.LimitedCoexistence(t.si, bck, apc.ActLifecycle); err != nil {
+		if cmn.Rom.V(4, cos.ModAIS) {
+			nlog.Infoln(t.String(), "postponing lifecycle", bck.Cname(""), "[", err, "]")
+		}
+		return
+	}
+	rns := xreg.RenewLifecycle(bck, cos.GenUUID())
+	if rns.Err != nil {
+		if !cmn.IsErrXactUsePrev(rns.Err) {
+			nlog.Errorln(t.String(), "failed to start lifecycle", bck.Cname(""), "[", rns.Err, "]")
+		}
+		return
+	}
+	if rns.IsRunning() {
+		return
+	}
+	xact.GoRunW(rns.Entry.Get())
+}
+
+// abort multipart uploads that match enabled lifecycle rules and are older than the rule's
+// (abort-incomplete) number of days
+func (ups *ups) abortStale(bck *meta.Bck, conf *cmn.LifecycleConf, now time.Time) {
+	for _, manifest := range ups.toSlice() {
+		lom := manifest.Lom()
+		if !lom.Bck().Equal(bck, true /*same BID*/, true /*same backend*/) {
+			continue
+		}
+		if !_staleMpt(conf, manifest, now) {
+			continue
+		}
+		ecode, err := ups.abort(nil /*request*/, lom, manifest.ID())
+		if err != nil && !cos.IsNotExist(err, ecode) {
+			nlog.Warningln("lifecycle: failed to abort stale upload [", manifest.ID(), lom.Cname(), err, "]")
+			continue
+		}
+		nlog.Infoln("lifecycle: aborted stale upload [", manifest.ID(), lom.Cname(), manifest.Created(), "]")
+	}
+}
+
+func _staleMpt(conf *cmn.LifecycleConf, manifest *core.Ufest, now time.Time) bool {
+	for _, rule := range conf.Match(manifest.Lom().ObjName) {
+		if rule.StaleMpt(manifest.Created(), now) {
+			return true
+		}
+	}
+	return false
+}
@@ -15,6 +15,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -33,8 +34,9 @@ const (
 
 type (
 	up struct {
-		u   *core.Ufest
-		rmd map[string]string
+		u    *core.Ufest
+		rmd  map[string]string
+		lock cos.StrKVs // S3 object lock: retention and/or legal hold to apply upon completion
 	}
 	ups struct {
 		t *target
@@ -62,7 +64,9 @@ type (
 		parts       apc.MptCompletedParts
 		isS3        bool
 		skipBackend bool
-		locked      bool // true if the LOM is already locked by the caller
+		owt         cmn.OWT         // OwtPut unless PUT-chunked on behalf of (e.g.) rebalance
+		cond        *core.WriteCond // conditional write (If-Match, If-None-Match)
+		locked      bool            // true if the LOM is already locked by the caller
 	}
 	// partCksums holds checksum state for a single part upload
 	partCksums struct {
@@ -97,7 +101,7 @@ func (ups *ups) _add(id string, manifest *core.Ufest, rmd map[string]string) (er
 		debug.AssertNoErr(err)
 		return
 	}
-	ups.m[id] = up{manifest, rmd}
+	ups.m[id] = up{u: manifest, rmd: rmd}
 	return
 }
 
@@ -159,6 +163,64 @@ func (ups *ups) toSlice() (all []*core.Ufest) {
 	return
 }
 
+// upload metadata is stored with the (partial) manifest and applied to the object upon completion:
+// - S3 server-side encryption: parts are encrypted on arrival, and the object cannot be decrypted without it
+// - S3 object tags
+func (ups *ups) addMeta(id string, md cos.StrKVs) {
+	ups.RLock()
+	if up, ok := ups.m[id]; ok {
+		all := make(cos.StrKVs, len(md)+2)
+		for k, v := range up.u.Meta() {
+			all[k] = v
+		}
+		for k, v := range md {
+			all[k] = v
+		}
+		up.u.SetMeta(all)
+	}
+	ups.RUnlock()
+}
+
+func (ups *ups) getMeta(id string) (md cos.StrKVs) {
+	ups.RLock()
+	if up, ok := ups.m[id]; ok {
+		md = up.u.Meta()
+	}
+	ups.RUnlock()
+	return
+}
+
+func (ups *ups) setTagging(id, tagging string)   { ups.addMeta(id, cos.StrKVs{s3.TaggingObjMD: tagging}) }
+func (ups *ups) setSSE(id string, md cos.StrKVs) { ups.addMeta(id, md) }
+
+// nil when not encrypted
+func (ups *ups) getSSE(id string) cos.StrKVs {
+	md := ups.getMeta(id)
+	if md[s3.SSEObjMD] == "" {
+		return nil
+	}
+	return md
+}
+
+// NOTE: in-memory only - not preserved across (feat.ResumeInterruptedMPU) restarts
+func (ups *ups) setObjLock(id string, md cos.StrKVs) {
+	ups.Lock()
+	if up, ok := ups.m[id]; ok {
+		up.lock = md
+		ups.m[id] = up
+	}
+	ups.Unlock()
+}
+
+func (ups *ups) getObjLock(id string) (md cos.StrKVs) {
+	ups.RLock()
+	if up, ok := ups.m[id]; ok {
+		md = up.lock
+	}
+	ups.RUnlock()
+	return
+}
+
 func (ups *ups) del(id string) {
 	ups.Lock()
 	delete(ups.m, id)
@@ -492,6 +554,12 @@ func (ups *ups) complete(args *completeArgs) (string, int, error) {
 	if etag != "" {
 		lom.SetCustomKey(cmn.ETag, cmn.UnquoteCEV(etag))
 	}
+	for k, v := range ups.getMeta(uploadID) {
+		lom.SetCustomKey(k, v)
+	}
+	for k, v := range ups.getObjLock(uploadID) {
+		lom.SetCustomKey(k, v)
+	}
 
 	// Whole-object checksum: use streaming checksum if valid, otherwise CRC32C combination
 	var locked bool
@@ -500,6 +568,21 @@ func (ups *ups) complete(args *completeArgs) (string, int, error) {
 		locked = true
 	}
 
+	if err := lom.CheckWriteCond(args.cond); err != nil {
+		if locked {
+			lom.Unlock(true)
+		}
+		return "", ecodeWriteCond(err), err
+	}
+	if args.owt < cmn.OwtRebalance {
+		if err := lom.PrewriteWORM(); err != nil {
+			if locked {
+				lom.Unlock(true)
+			}
+			return "", http.StatusForbidden, err
+		}
+	}
+
 	cksum, err := manifest.WholeChecksum()
 	if err != nil {
 		if locked {
@@ -509,6 +592,11 @@ func (ups *ups) complete(args *completeArgs) (string, int, error) {
 	}
 	lom.SetCksum(cksum)
 
+	var replTs int64
+	if args.owt < cmn.OwtRebalance {
+		replTs = t.replStamp(lom, args.r)
+	}
+
 	// atomically flip: persist manifest, mark chunked, persist main
 	// NOTE: coldGET implies the LOM's lock has been promoted to wlock
 	err = lom.CompleteUfest(manifest, args.locked || locked)
@@ -521,6 +609,7 @@ func (ups *ups) complete(args *completeArgs) (string, int, error) {
 	}
 
 	ups.del(uploadID)
+	t.putRepl(lom, replTs)
 
 	if cmn.Rom.V(4, cos.ModAIS) {
 		nlog.Infoln(uploadID, "completed")
@@ -547,6 +636,7 @@ func (ups *ups) _completeRemote(r *http.Request, lom *core.LOM, uploadID string,
 	if err != nil {
 		return "", ecode, err
 	}
+	ups.t.lsoChanged(bck, lom.ObjName)
 
 	lom.SetCustomKey(cmn.SourceObjMD, provider)
 	if version != "" {
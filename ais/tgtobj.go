@@ -29,6 +29,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/cmn/tabular"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ec"
@@ -50,26 +51,28 @@ import (
 type (
 	putOI struct {
 		oreq        *http.Request
-		r           io.ReadCloser // content reader
-		xctn        core.Xact     // xaction that puts
-		t           *target       // this
-		lom         *core.LOM     // obj
-		cksumToUse  *cos.Cksum    // if available (not `none`), can be validated and will be stored
-		config      *cmn.Config   // (during this request)
-		resphdr     http.Header   // as implied
-		workFQN     string        // temp fqn to be renamed
-		atime       int64         // access time.Now()
-		ltime       int64         // mono.NanoTime, to measure latency
-		rltime      int64         // mono.NanoTime, to measure remote bucket latency
-		size        int64         // aka Content-Length
-		owt         cmn.OWT       // object write transaction enum { OwtPut, ..., OwtGet* }
-		restful     bool          // being invoked via RESTful API
-		t2t         bool          // by another target
-		skipEC      bool          // do not erasure-encode when finalizing
-		skipVC      bool          // skip loading existing Version and skip comparing Checksums (skip VC)
-		skipBackend bool          // don't write to backend (e.g., cold-GET caching, rechunk)
-		locked      bool          // true if the LOM is already locked by the caller
-		remoteErr   bool          // to exclude `putRemote` errors when counting soft IO errors
+		r           io.ReadCloser   // content reader
+		xctn        core.Xact       // xaction that puts
+		t           *target         // this
+		lom         *core.LOM       // obj
+		cksumToUse  *cos.Cksum      // if available (not `none`), can be validated and will be stored
+		cond        *core.WriteCond // conditional write (If-Match, If-None-Match)
+		config      *cmn.Config     // (during this request)
+		resphdr     http.Header     // as implied
+		workFQN     string          // temp fqn to be renamed
+		atime       int64           // access time.Now()
+		ltime       int64           // mono.NanoTime, to measure latency
+		rltime      int64           // mono.NanoTime, to measure remote bucket latency
+		size        int64           // aka Content-Length
+		owt         cmn.OWT         // object write transaction enum { OwtPut, ..., OwtGet* }
+		restful     bool            // being invoked via RESTful API
+		t2t         bool            // by another target
+		skipEC      bool            // do not erasure-encode when finalizing
+		skipVC      bool            // skip loading existing Version and skip comparing Checksums (skip VC)
+		replTs      int64           // to replicate (see tgtrepl)
+		skipBackend bool            // don't write to backend (e.g., cold-GET caching, rechunk)
+		locked      bool            // true if the LOM is already locked by the caller
+		remoteErr   bool            // to exclude `putRemote` errors when counting soft IO errors
 	}
 
 	getOI struct {
@@ -132,6 +135,7 @@ type (
 		r        io.ReadCloser // read bytes to append
 		t        *target       // this
 		lom      *core.LOM     // resulting shard
+		edits    archive.Edits // apc.ArchReplace and apc.ArchDelete (rewrite the shard)
 		filename string        // fqn inside
 		mime     string        // format
 		started  int64         // time of receiving
@@ -243,8 +247,13 @@ func (poi *putOI) chunk(chunkSize int64) (ecode int, err error) {
 		parts:       completedParts,
 		isS3:        false,
 		skipBackend: poi.skipBackend,
+		owt:         poi.owt,
+		cond:        poi.cond,
 		locked:      poi.locked,
 	})
+	if err != nil && (cmn.IsErrObjLocked(err) || ecode == http.StatusPreconditionFailed || ecode == http.StatusNotFound) {
+		poi.t.ups.abort(poi.oreq, lom, uploadID)
+	}
 	return ecode, err
 }
 
@@ -260,8 +269,8 @@ func (poi *putOI) putObject() (ecode int, err error) {
 	}
 	poi.ltime = mono.NanoTime()
 
-	// if checksums match PUT is a no-op
-	if !poi.skipVC && !poi.skipBackend {
+	// if checksums match PUT is a no-op (unless conditional)
+	if !poi.skipVC && !poi.skipBackend && poi.cond == nil {
 		if poi.lom.EqCksum(poi.cksumToUse) {
 			if cmn.Rom.V(4, cos.ModAIS) {
 				nlog.Infoln(poi.lom.String(), "has identical", poi.cksumToUse.String(), "- PUT is a no-op")
@@ -311,7 +320,9 @@ rerr:
 		vlabs := poi._vlabs(true /*detailed*/)
 		poi.t.statsT.IncWith(stats.ErrPutCount, vlabs)
 
-		if err != cmn.ErrSkip && !poi.remoteErr && err != io.ErrUnexpectedEOF && !cos.IsErrRetriableConn(err) && !cos.IsErrMv(err) {
+		// (not counting client errors: failed preconditions and object lock)
+		if err != cmn.ErrSkip && !poi.remoteErr && err != io.ErrUnexpectedEOF && !cos.IsErrRetriableConn(err) && !cos.IsErrMv(err) &&
+			!cmn.IsErrPrecondFailed(err) && !cmn.IsErrObjLocked(err) {
 			poi.t.statsT.IncWith(stats.IOErrPutCount, vlabs)
 			if cmn.Rom.V(4, cos.ModAIS) {
 				nlog.Warningln("io-error [", err, "]", poi.loghdr())
@@ -414,6 +425,7 @@ func (poi *putOI) finalize() (ecode int, err error) {
 		}
 	}
 	poi.t.putMirror(poi.lom)
+	poi.t.putRepl(poi.lom, poi.replTs)
 	return 0, nil
 }
 
@@ -475,21 +487,37 @@ func (poi *putOI) fini() (ecode int, err error) {
 		lom.SetAtimeUnix(poi.atime)
 	}
 
+	// conditional write: evaluate under wlock
+	if err := lom.CheckWriteCond(poi.cond); err != nil {
+		return ecodeWriteCond(err), err
+	}
+
+	// object lock: refuse to overwrite; apply default retention
+	if poi.owt < cmn.OwtRebalance {
+		if err := lom.PrewriteWORM(); err != nil {
+			return http.StatusForbidden, err
+		}
+	}
+
 	// ais versioning
 	if bck.IsAIS() && lom.VersionConf().Enabled {
 		switch {
 		case poi.owt >= cmn.OwtRebalance || poi.owt == cmn.OwtCopy:
 			// rebalance, copy, get*: do nothing
 		default:
-			// best effort
 			if remSrc, ok := lom.GetCustomKey(cmn.SourceObjMD); !ok || remSrc == "" {
-				if err = lom.IncVersion(); err != nil {
-					nlog.Errorln(err) // (unlikely)
+				// preserve the current version, if configured (see core/lversion)
+				if err = lom.RetainIncVersion(); err != nil {
+					return http.StatusInternalServerError, err
 				}
 			}
 		}
 	}
 
+	if poi.owt < cmn.OwtRebalance {
+		poi.replTs = poi.t.replStamp(lom, poi.oreq)
+	}
+
 	// done
 	if err := lom.RenameFinalize(poi.workFQN); err != nil {
 		return 0, err
@@ -528,6 +556,7 @@ func (poi *putOI) putRemote() (int, error) {
 			lom.SetCustomKey(cmn.SourceObjMD, bp.Provider())
 		}
 		poi.rltime = mono.SinceNano(startTime)
+		poi.t.lsoChanged(lom.Bck(), lom.ObjName)
 		return 0, nil
 	}
 	poi.remoteErr = true
@@ -1128,6 +1157,13 @@ func (goi *getOI) txfini() (fqn string, ecode int, err error) {
 		dpq  = goi.dpq
 		lom  = goi.lom
 	)
+	// S3 server-side encrypted (see t.getSSEObjS3); get-from-neighbor transfers the content as is
+	if !dpq.isGFN {
+		if err = lom.CheckEncrypted(); err != nil {
+			return lom.FQN, http.StatusBadRequest, err
+		}
+	}
+
 	// open
 	if cmn.Rom.Features().IsSet(feat.LoadBalanceGET) && !goi.cold && !dpq.isGFN && !lom.IsChunked() {
 		// [feat] best-effort GET load balancing across mirrored copies
@@ -1357,7 +1393,11 @@ func (goi *getOI) _txarch(fqn string, lmfh cos.LomReader, whdr http.Header) erro
 		)
 		debug.Assert(size >= 0, "negative archive entry size for", lom.Cname(), "/", dpq.arch.path)
 		// (compare w/ goi.setwhdr)
-		whdr.Set(cos.HdrContentType, cos.ContentBinary)
+		ctype := cos.ContentBinary
+		if tabular.HasExt(lom.ObjName) {
+			ctype = tabular.ContentArrowStream // row group (see cmn/tabular)
+		}
+		whdr.Set(cos.HdrContentType, ctype)
 		whdr.Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
 
 		buf, slab := goi.t.gmm.AllocSize(_txsize(size))
@@ -1635,6 +1675,15 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, err error) {
 		workFQN = a.hdl.workFQN
 	)
 	if workFQN == "" {
+		// object lock: fail fast (flush will check again - see poi.fini)
+		if a.lom.Bprops().ObjLock.Enabled {
+			a.lom.Lock(true)
+			err = a.lom.PrewriteWORM()
+			a.lom.Unlock(true)
+			if err != nil {
+				return "", err
+			}
+		}
 		workFQN = a.lom.GenFQN(fs.WorkCT, fs.WorkfileAppend)
 		a.lom.Lock(false)
 		if a.lom.Load(false /*cache it*/, false /*locked*/) == nil {
@@ -1750,6 +1799,13 @@ func (coi *coi) do(t *target, dm *bundle.DM, lom *core.LOM) (res xs.CoiRes) {
 		return coi._dryRun(lom, coi.ObjnameTo, coi.ETLArgs)
 	}
 
+	// no transform, remote => remote of the same provider: try server-side copy
+	if coi.GetROC == nil && coi.PutWOC == nil && lom.Bck().IsRemote() && coi.BckTo.IsRemote() {
+		if res, ok := coi._native(t, lom); ok {
+			return res
+		}
+	}
+
 	// (no-op transform) and (remote source) => same flow as actual transform but with default reader
 	if coi.GetROC == nil && lom.Bck().IsRemote() {
 		coi.GetROC = core.GetDefaultROC
@@ -1843,6 +1899,27 @@ func (coi *coi) do(t *target, dm *bundle.DM, lom *core.LOM) (res xs.CoiRes) {
 	return res
 }
 
+// backend-native copy (see core.BackendCopier)
+// NOTE: does not evict in-cluster copy of the destination object that may be cached by another target
+func (coi *coi) _native(t *target, lom *core.LOM) (xs.CoiRes, bool) {
+	provider := lom.Bck().RemoteBck().Provider
+	if coi.BckTo.RemoteBck().Provider != provider {
+		return xs.CoiRes{}, false
+	}
+	bp, ok := t.bps[provider].(core.BackendCopier)
+	if !ok {
+		return xs.CoiRes{}, false
+	}
+	size, ecode, err := bp.CopyObj(context.Background(), lom, coi.BckTo, coi.ObjnameTo)
+	if ecode == http.StatusNotImplemented {
+		return xs.CoiRes{}, false
+	}
+	if err == nil {
+		t.evictCached(coi.BckTo, coi.ObjnameTo) // (overwritten remotely)
+	}
+	return xs.CoiRes{Err: err, Ecode: ecode, Lsize: size}, true
+}
+
 func (coi *coi) isNOP(lom, dst *core.LOM, dm *bundle.DM) bool {
 	if coi.LatestVer || coi.Sync {
 		return false
@@ -2141,9 +2218,18 @@ func (a *putA2I) do() (int, error) {
 	if a.filename == "" {
 		return 0, errors.New("archive path is not defined")
 	}
+	// S3 server-side encrypted (see t.getSSEObjS3): cannot be read (and rewritten) as archive
+	if err := a.lom.CheckEncrypted(); err != nil {
+		return http.StatusBadRequest, err
+	}
+	// object lock: refuse to modify; apply default retention
+	if err := a.lom.PrewriteWORM(); err != nil {
+		return http.StatusForbidden, err
+	}
 	// standard library does not support appending to tgz, zip, and such;
 	// for TAR there is an optimizing workaround not requiring a full copy
-	if a.mime == archive.ExtTar && !a.put /*append*/ && !a.lom.IsChunked() {
+	// (in-place append cannot preserve the current version)
+	if a.mime == archive.ExtTar && !a.put /*append*/ && a.edits == nil && !a.lom.IsChunked() && !a.lom.RetainsVersions() {
 		var (
 			err       error
 			fh        *os.File
@@ -2209,9 +2295,13 @@ cpap: // copy + append
 		}
 		cksum.Init(a.lom.CksumType())
 		aw = archive.NewWriter(a.mime, wfh, &cksum, nil)
-		err = aw.Copy(lmfh, a.lom.Lsize())
-		if err == nil {
-			err = aw.Write(a.filename, oah, a.r)
+		if a.edits != nil {
+			err = a.copyEdit(aw, lmfh, oah)
+		} else {
+			err = aw.Copy(lmfh, a.lom.Lsize())
+			if err == nil {
+				err = aw.Write(a.filename, oah, a.r)
+			}
 		}
 		erc = aw.Fini() // in that order
 		cos.Close(lmfh)
@@ -2231,6 +2321,63 @@ cpap: // copy + append
 	return a.reterr(err)
 }
 
+// copy while deleting and/or replacing archived files;
+// replacement that's not found in the shard gets appended
+func (a *putA2I) copyEdit(aw archive.Writer, lmfh cos.LomReader, oah cos.SimpleOAH) error {
+	if e, ok := a.edits[a.filename]; ok && e.R != nil {
+		e.OAH = oah // replacement
+	}
+	if err := aw.CopyEdit(lmfh, a.edits, a.lom.Lsize()); err != nil {
+		return err
+	}
+	var applied int
+	for _, e := range a.edits {
+		switch {
+		case e.Applied:
+			applied++
+		case e.R != nil:
+			if err := aw.Write(a.filename, oah, e.R); err != nil {
+				return err
+			}
+			applied++
+		}
+	}
+	if applied == 0 {
+		return cos.NewErrNotFound(a.t, a.filename+" in "+a.lom.Cname())
+	}
+	return nil
+}
+
+// (TAR only) rebuild existing shard index after the shard has been rewritten
+// - called without holding the lock (see core/shard_idx for the locking protocol)
+// - on failure, the (stale) index is still detected as such upon the next read
+func (a *putA2I) reindex() {
+	lom := a.lom
+	lom.Lock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil || !lom.HasShardIdx() {
+		lom.Unlock(false)
+		return
+	}
+	var (
+		srcCksum = lom.Checksum()
+		srcSize  = lom.Lsize()
+		idx      *archive.ShardIndex
+	)
+	fh, err := lom.Open()
+	if err == nil {
+		idx, err = archive.BuildShardIndex(fh, srcSize)
+		cos.Close(fh)
+	}
+	lom.Unlock(false)
+	if err == nil {
+		idx.SrcCksum, idx.SrcSize = srcCksum, srcSize
+		err = core.SaveShardIndex(lom, idx)
+	}
+	if err != nil {
+		nlog.Warningln(a.t.String(), "failed to rebuild shard index for", lom.Cname(), "[", err, "]")
+	}
+}
+
 // TAR only - fast & direct
 func (a *putA2I) fast(rwfh *os.File, tarFormat tar.Format, offset int64) (size int64, err error) {
 	var (
@@ -2258,11 +2405,16 @@ func (a *putA2I) fast(rwfh *os.File, tarFormat tar.Format, offset int64) (size i
 }
 
 func (*putA2I) reterr(err error) (int, error) {
-	if err == nil {
+	switch {
+	case err == nil:
 		return 0, nil
+	case cmn.IsErrCapExceeded(err):
+		return http.StatusInsufficientStorage, err
+	case cos.IsNotExist(err):
+		return http.StatusNotFound, err
+	default:
+		return http.StatusInternalServerError, err
 	}
-	ecode := cos.Ternary(cmn.IsErrCapExceeded(err), http.StatusInsufficientStorage, http.StatusInternalServerError)
-	return ecode, err
 }
 
 func (a *putA2I) finalize(size int64, cksum *cos.Cksum, fqn string) error {
@@ -2271,6 +2423,13 @@ func (a *putA2I) finalize(size int64, cksum *cos.Cksum, fqn string) error {
 		debug.AssertNoErr(err)
 		debug.Assertf(finfo.Size() == size, "%d != %d", finfo.Size(), size)
 	})
+	// ais versioning: preserve the current version, if configured (see core/lversion)
+	if a.lom.Bck().IsAIS() && a.lom.VersionConf().Enabled {
+		if err := a.lom.RetainIncVersion(); err != nil {
+			return err
+		}
+	}
+	replTs := a.t.replStamp(a.lom, nil) // (before the current object is replaced)
 	// done
 	if err := a.lom.RenameFinalize(fqn); err != nil {
 		return err
@@ -2281,12 +2440,14 @@ func (a *putA2I) finalize(size int64, cksum *cos.Cksum, fqn string) error {
 	if err := a.lom.Persist(); err != nil {
 		return err
 	}
+	a.t.lsoChanged(a.lom.Bck(), a.lom.ObjName)
 	if a.lom.ECEnabled() {
 		if err := ec.ECM.EncodeObject(a.lom, nil); err != nil && err != ec.ErrorECDisabled {
 			return err
 		}
 	}
 	a.t.putMirror(a.lom)
+	a.t.putRepl(a.lom, replTs)
 	return nil
 }
 
@@ -2423,6 +2584,16 @@ func freeGOI(a *getOI) {
 	goiPool.Put(a)
 }
 
+func ecodeWriteCond(err error) int {
+	if cos.IsNotExist(err) {
+		return http.StatusNotFound
+	}
+	if cmn.IsErrPrecondFailed(err) {
+		return http.StatusPreconditionFailed
+	}
+	return http.StatusInternalServerError
+}
+
 func allocPOI() *putOI {
 	return poiPool.Get().(*putOI)
 }
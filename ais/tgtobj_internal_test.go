@@ -20,6 +20,7 @@ import (
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/core/mock"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/tools/readers"
 	"github.com/NVIDIA/aistore/tools/tassert"
 )
@@ -175,6 +176,50 @@ func TestPutObjectChunks(tst *testing.T) {
 	}
 }
 
+// counts (error) stats
+type cntStatsTracker struct {
+	mock.StatsTracker
+	counts map[string]int
+}
+
+func (st *cntStatsTracker) IncWith(name string, _ map[string]string) { st.counts[name]++ }
+
+func TestPutObjectCondErrStats(tst *testing.T) {
+	lom := core.AllocLOM("test-cond-obj")
+	defer core.FreeLOM(lom)
+	err := lom.InitBck(&meta.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal})
+	tassert.CheckFatal(tst, err)
+	defer lom.RemoveMain()
+
+	st := &cntStatsTracker{counts: make(map[string]int)}
+	orig := t.statsT
+	t.statsT = st
+	defer func() { t.statsT = orig }()
+
+	put := func(cond *core.WriteCond) error {
+		r, _ := readers.New(&readers.Arg{Type: readers.Rand, Size: cos.KiB, CksumType: cos.ChecksumNone})
+		poi := &putOI{
+			atime:   time.Now().UnixNano(),
+			t:       t,
+			lom:     lom,
+			r:       r,
+			workFQN: path.Join(testMountpath, "test-cond-obj.work"),
+			config:  cmn.GCO.Get(),
+			owt:     cmn.OwtPut,
+			restful: true,
+			cond:    cond,
+		}
+		_, err := poi.putObject()
+		return err
+	}
+	tassert.CheckFatal(tst, put(nil))
+
+	err = put(&core.WriteCond{IfNoneMatch: "*"})
+	tassert.Fatalf(tst, cmn.IsErrPrecondFailed(err), "expecting precondition failure, got %v", err)
+	tassert.Errorf(tst, st.counts[stats.ErrPutCount] == 1, "expecting one PUT error, got %d", st.counts[stats.ErrPutCount])
+	tassert.Errorf(tst, st.counts[stats.IOErrPutCount] == 0, "not expecting PUT I/O errors, got %d", st.counts[stats.IOErrPutCount])
+}
+
 func BenchmarkObjPut(b *testing.B) {
 	benches := []struct {
 		fileSize int64
@@ -0,0 +1,117 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+// Bucket replication to a remote AIS cluster (see cmn/repl.go and xact/xs/repl.go):
+// - local PUTs get stamped with the time of the write and this cluster's UUID (custom
+//   metadata that travels with the replica);
+// - successful local PUTs and DELETEs are then handed over to x-replicate;
+// - replicated PUTs and DELETEs (apc.HdrReplSrc) are never replicated back;
+// - periodically, resume replication in buckets with pending journaled work
+//   (e.g., upon restart).
+
+const replHKName = "replicate" + hk.NameSuffix
+
+func (t *target) regRepl() {
+	hk.Reg(replHKName, t.housekeepRepl, hk.ReplIval)
+}
+
+func (t *target) housekeepRepl(int64) time.Duration {
+	if !t.ClusterStarted() || t.regstate.disabled.Load() {
+		return hk.ReplIval
+	}
+	bmd := t.owner.bmd.get()
+	bmd.Range(nil /*any provider*/, nil /*any namespace*/, func(bck *meta.Bck) bool {
+		if bck.Props.Replication.Enabled && xs.ReplPending(bck) {
+			if _, err := t.renewRepl(bck); err != nil {
+				nlog.Errorln(t.String(), "failed to resume replication", bck.Cname(""), "[", err, "]")
+			}
+		}
+		return false
+	})
+	return hk.ReplIval
+}
+
+func (*target) renewRepl(bck *meta.Bck) (*xs.XactRepl, error) {
+	rns := xreg.RenewRepl(bck)
+	if rns.Err != nil {
+		return nil, rns.Err
+	}
+	return rns.Entry.Get().(*xs.XactRepl), nil
+}
+
+// under wlock: returns the time of the write to replicate (zero when not replicating)
+func (t *target) replStamp(lom *core.LOM, oreq *http.Request) int64 {
+	bprops := lom.Bprops()
+	if bprops == nil || !bprops.Replication.Enabled {
+		return 0
+	}
+	if oreq != nil && t.isReplicated(oreq) {
+		return 0 // replicated (keeping the original stamp)
+	}
+	now := time.Now().UnixNano()
+	lom.SetCustomKey(cmn.ReplMtimeObjMD, strconv.FormatInt(now, 10))
+	lom.SetCustomKey(cmn.ReplSrcObjMD, t.owner.smap.get().UUID)
+	lom.SetCustomKey(cmn.ReplVerObjMD, strconv.FormatInt(replVerCur(lom)+1, 10))
+	return now
+}
+
+// replicated version of the current (being overwritten) object, if any
+func replVerCur(lom *core.LOM) (ver int64) {
+	cur := core.AllocLOM(lom.ObjName)
+	if cur.InitBck(lom.Bck()) == nil && cur.Load(false /*cache it*/, true /*locked*/) == nil {
+		ver, _ = xs.ReplVer(cur)
+	}
+	core.FreeLOM(cur)
+	return ver
+}
+
+// apc.HdrReplSrc is validated by the proxy (see p.checkReplSrc)
+func (t *target) isReplicated(r *http.Request) bool {
+	if r.Header.Get(apc.HdrReplSrc) == "" {
+		return false
+	}
+	return isRedirect(r.URL.Query()) != "" || t.checkIntraCall(r, false /*from primary*/) == nil
+}
+
+func (t *target) putRepl(lom *core.LOM, ts int64) {
+	if ts == 0 {
+		return
+	}
+	t.repl(lom, false, ts, 0)
+}
+
+func (t *target) delRepl(lom *core.LOM) {
+	bprops := lom.Bprops()
+	if bprops == nil || !bprops.Replication.Enabled {
+		return
+	}
+	ver, _ := xs.ReplVer(lom) // (deleted object)
+	t.repl(lom, true, time.Now().UnixNano(), ver+1)
+}
+
+func (t *target) repl(lom *core.LOM, del bool, ts, ver int64) {
+	xrepl, err := t.renewRepl(lom.Bck())
+	if err != nil {
+		nlog.Errorln(t.String(), "failed to replicate", lom.Cname(), "[", err, "]")
+		return
+	}
+	xrepl.Repl(lom.ObjName, del, ts, ver)
+}
@@ -5,7 +5,6 @@
 package ais
 
 import (
-	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -36,6 +35,9 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
+	if r.Header.Get(cos.HdrOrigin) != "" && len(apiItems) > 0 {
+		corsS3(w, r, t.owner.bmd, apiItems[0])
+	}
 
 	switch r.Method {
 	case http.MethodHead:
@@ -47,9 +49,12 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 		t.putCopyMpt(w, r, config, apiItems)
 	case http.MethodDelete:
 		q := r.URL.Query()
-		if q.Has(s3.QparamMptUploadID) {
+		switch {
+		case q.Has(s3.QparamMptUploadID):
 			t.abortMptS3(w, r, apiItems, q)
-		} else {
+		case q.Has(s3.QparamTagging):
+			t.tagObjS3(w, r, apiItems)
+		default:
 			t.delObjS3(w, r, apiItems)
 		}
 	case http.MethodPost:
@@ -79,13 +84,16 @@ func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, config *cmn.
 
 	q := r.URL.Query()
 	switch {
+	case q.Has(s3.QparamTagging):
+		t.tagObjS3(w, r, items)
+	case q.Has(s3.QparamRetention) || q.Has(s3.QparamLegalHold):
+		t.objLockS3(w, r, items)
 	case q.Has(s3.QparamMptPartNo) && q.Has(s3.QparamMptUploadID):
 		if r.Header.Get(cos.S3HdrObjSrc) != "" {
-			// TODO:
-			// copy another object (or its range) => part of the specified multipart upload.
-			// (ref https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPartCopy.html)
-			err := errors.New("UploadPartCopy not implemented yet")
-			s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusNotImplemented})
+			if cmn.Rom.V(5, cos.ModS3) {
+				nlog.Infoln("putCopyPartMpt", bck.String(), items, q)
+			}
+			t.putCopyPartMptS3(w, r, items, q, bck)
 			return
 		}
 		if cmn.Rom.V(5, cos.ModS3) {
@@ -111,40 +119,8 @@ func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, config *cmn.
 // S3 copy object API use the "destination" bucket in the URL path, but AIStore target use "source" bucket
 // we need this extra `copyObjS3` handler at target to address the translation
 func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, config *cmn.Config, items []string) {
-	src := r.Header.Get(cos.S3HdrObjSrc)
-
-	// [HACK]
-	// it appears, 'x-amz-copy-source' header gets double-escaped upon http redirect
-	// (s3cmd and aws clients, both)
-	srcUnescaped, err := url.QueryUnescape(src)
-	if err != nil {
-		nlog.Errorf("Warning: failed to unescape '%s=%s' header: %v", cos.S3HdrObjSrc, src, err)
-	} else if src != srcUnescaped {
-		if cmn.Rom.V(5, cos.ModS3) {
-			nlog.Infoln("Warning: header", cos.S3HdrObjSrc, "is double-escaped - unescaping from", src, "to", srcUnescaped)
-		}
-		src = srcUnescaped
-	}
-
-	src = strings.Trim(src, "/") // in AWS examples the path starts with "/"
-	parts := strings.SplitN(src, "/", 2)
-	if len(parts) < 2 {
-		s3.WriteErr(w, r, s3.ErrInfo{Err: errS3Obj})
-		return
-	}
-	// src
-	bckSrc, ecode, err := meta.InitByNameOnly(parts[0], t.owner.bmd)
-	if err != nil {
-		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: ecode})
-		return
-	}
-	objSrc := strings.Trim(parts[1], "/")
-	if err := cos.ValidateOname(objSrc); err != nil {
-		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
-		return
-	}
-	if err := bckSrc.Init(t.owner.bmd); err != nil {
-		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+	bckSrc, objSrc, ok := t.parseCopySrcS3(w, r)
+	if !ok {
 		return
 	}
 	lom := core.AllocLOM(objSrc)
@@ -201,6 +177,47 @@ func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, config *cmn.C
 	sgl.Free()
 }
 
+// parse and validate `x-amz-copy-source` (CopyObject and UploadPartCopy)
+func (t *target) parseCopySrcS3(w http.ResponseWriter, r *http.Request) (*meta.Bck, string, bool) {
+	src := r.Header.Get(cos.S3HdrObjSrc)
+
+	// [HACK]
+	// it appears, 'x-amz-copy-source' header gets double-escaped upon http redirect
+	// (s3cmd and aws clients, both)
+	srcUnescaped, err := url.QueryUnescape(src)
+	if err != nil {
+		nlog.Errorf("Warning: failed to unescape '%s=%s' header: %v", cos.S3HdrObjSrc, src, err)
+	} else if src != srcUnescaped {
+		if cmn.Rom.V(5, cos.ModS3) {
+			nlog.Infoln("Warning: header", cos.S3HdrObjSrc, "is double-escaped - unescaping from", src, "to", srcUnescaped)
+		}
+		src = srcUnescaped
+	}
+
+	src = strings.Trim(src, "/") // in AWS examples the path starts with "/"
+	parts := strings.SplitN(src, "/", 2)
+	if len(parts) < 2 {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: errS3Obj})
+		return nil, "", false
+	}
+	// src
+	bckSrc, ecode, err := meta.InitByNameOnly(parts[0], t.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: ecode})
+		return nil, "", false
+	}
+	objSrc := strings.Trim(parts[1], "/")
+	if err := cos.ValidateOname(objSrc); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return nil, "", false
+	}
+	if err := bckSrc.Init(t.owner.bmd); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return nil, "", false
+	}
+	return bckSrc, objSrc, true
+}
+
 func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, config *cmn.Config, lom *core.LOM) {
 	if err := lom.InitBck(bck); err != nil {
 		if cmn.IsErrRemoteBckNotFound(err) {
@@ -217,6 +234,28 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck,
 
 	// TODO: dual checksumming, e.g. lom.SetCustom(apc.AWS, ...)
 
+	tagging, ok := parseTaggingS3(w, r)
+	if !ok {
+		return
+	}
+	if tagging != "" {
+		lom.SetCustomKey(s3.TaggingObjMD, tagging)
+	}
+	if !t.putSSES3(w, r, lom) {
+		return
+	}
+	lockHdrs, ok := parseObjLockS3(w, r, bck)
+	if !ok {
+		return
+	}
+	if lockHdrs != nil {
+		lockHdrs.Set(lom)
+	}
+	cond, ok := parseWriteCondS3(w, r, bck)
+	if !ok {
+		return
+	}
+
 	dpq := dpqAlloc()
 	if err := dpq.parse(r.URL.RawQuery); err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
@@ -231,18 +270,38 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck,
 		poi.config = config
 		poi.skipVC = cmn.Rom.Features().IsSet(feat.SkipVC) || dpq.skipVC // apc.QparamSkipVC
 		poi.restful = true
+		poi.cond = cond
 	}
 	ecode, err := poi.do(nil /*response hdr*/, r, dpq)
 	freePOI(poi)
-	if err != nil {
+	switch {
+	case err == nil:
+		s3.SetS3Headers(w.Header(), lom)
+	case cond != nil && cos.IsNotExist(err):
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusNotFound, Code: s3.NoSuchKey}) // If-Match
+	default:
 		t.FSHC(err, lom.Mountpath(), lom.FQN)
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: ecode})
-	} else {
-		s3.SetS3Headers(w.Header(), lom)
 	}
 	dpqFree(dpq)
 }
 
+// parse If-Match and If-None-Match request headers (PUT and CompleteMultipartUpload)
+// - the condition is evaluated under the object's write lock (see core/lcond)
+// - remote buckets are not supported: the remote write precedes the (local) locking
+func parseWriteCondS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck) (*core.WriteCond, bool) {
+	cond := &core.WriteCond{IfMatch: r.Header.Get(cos.HdrIfMatch), IfNoneMatch: r.Header.Get(cos.HdrIfNoneMatch)}
+	if cond.IsEmpty() {
+		return nil, true
+	}
+	if !bck.IsAIS() {
+		err := cmn.NewErrNotImpl("evaluate conditional write in", bck.Cname(""))
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusNotImplemented, Code: "NotImplemented"})
+		return nil, false
+	}
+	return cond, true
+}
+
 // GET s3/<bucket-name[/<object-name>]
 func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string) {
 	bucket := items[0]
@@ -262,6 +321,10 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 		t.listUploadsMptS3(w, bck, q)
 		return
 	}
+	if len(items) == 1 && q.Has(s3.QparamVersions) {
+		t.listVersionsS3(w, r, bck, q)
+		return
+	}
 	if len(items) < 2 {
 		err := fmt.Errorf(fmtErrBckObj, r.Method, items)
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
@@ -271,6 +334,17 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 	if errN != nil {
 		return
 	}
+	if q.Has(s3.QparamTagging) {
+		t.getTaggingS3(w, r, bck, objName)
+		return
+	}
+	if q.Has(s3.QparamRetention) || q.Has(s3.QparamLegalHold) {
+		t.getObjLockS3(w, r, bck, objName)
+		return
+	}
+	if ver := q.Get(s3.QparamVersionID); ver != "" && t.getVersionS3(w, r, bck, objName, ver) {
+		return
+	}
 	if q.Has(s3.QparamMptPartNo) {
 		if cmn.Rom.V(5, cos.ModS3) {
 			nlog.Infoln("getMptPart", bck.String(), objName, q)
@@ -288,6 +362,9 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 		t.listPartsMptS3(w, r, bck, objName, q)
 		return
 	}
+	if t.getSSEObjS3(w, r, bck, objName) {
+		return
+	}
 
 	dpq := dpqAlloc()
 	if err := dpq.parse(r.URL.RawQuery); err != nil {
@@ -324,6 +401,9 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 	if errN != nil {
 		return
 	}
+	if ver := r.URL.Query().Get(s3.QparamVersionID); ver != "" && t.getVersionS3(w, r, bck, objName, ver) {
+		return
+	}
 	lom := core.AllocLOM(objName)
 	defer core.FreeLOM(lom)
 	if err := lom.InitBck(bck); err != nil {
@@ -350,6 +430,9 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 		op  cmn.ObjectProps
 	)
 	if exists {
+		if !t.headSSEObjS3(w, r, lom) {
+			return
+		}
 		op.ObjAttrs = *lom.ObjAttrs()
 	} else {
 		// cold HEAD
@@ -399,14 +482,22 @@ func (t *target) delObjS3(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
 		return
 	}
-	ecode, err = t.DeleteObject(lom, false)
+	retains := lom.RetainsVersions()
+	if ver := r.URL.Query().Get(s3.QparamVersionID); ver != "" && retains {
+		t.delVersionS3(w, r, lom, ver)
+		return
+	}
+	ecode, err = t.deleteObject(lom, false /*evict*/, s3.BypassGovernance(r.Header), false /*replicated*/)
 	if err != nil {
 		name := lom.Cname()
-		if ecode == http.StatusNotFound {
+		switch {
+		case cmn.IsErrObjLocked(err):
+			s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		case ecode == http.StatusNotFound:
 			err := cos.NewErrNotFound(t, name)
 			ei := s3.ErrInfo{Err: err, Status: http.StatusNotFound, Code: s3.NoSuchKey}
 			s3.WriteErr(w, r, ei)
-		} else {
+		default:
 			err := fmt.Errorf("error deleting %s: %v", name, err)
 			s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: ecode})
 		}
@@ -414,6 +505,13 @@ func (t *target) delObjS3(w http.ResponseWriter, r *http.Request, items []string
 	}
 	// EC cleanup if EC is enabled
 	ec.ECM.CleanupObject(lom)
+
+	if retains {
+		if ver, dm, _ := lom.LatestRetained(); dm {
+			w.Header().Set(cos.S3HdrDeleteMarker, "true")
+			w.Header().Set(cos.S3VersionHeader, ver)
+		}
+	}
 }
 
 // POST /s3/<bucket-name>/<object-name>
@@ -424,6 +522,14 @@ func (t *target) postObjS3(w http.ResponseWriter, r *http.Request, items []strin
 		return
 	}
 	q := r.URL.Query()
+	if q.Has(s3.QparamSelect) {
+		objName, errN := s3.JoinValidateOname(w, r, items)
+		if errN != nil {
+			return
+		}
+		t.selectObjS3(w, r, bck, objName)
+		return
+	}
 	if q.Has(s3.QparamMptUploads) {
 		if cmn.Rom.V(5, cos.ModS3) {
 			nlog.Infoln("startMpt", bck.String(), items, q)
@@ -0,0 +1,154 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// S3 object lock: object-level retention and legal hold (see cmn/objlock and core/lworm)
+// - the bucket-level configuration is stored in bucket props (see prxs3bck.go)
+// - retention and legal hold are stored in the object's custom metadata
+
+// parse and validate x-amz-object-lock-* request headers (PUT and multipart start)
+func parseObjLockS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck) (*s3.ObjLockHdrs, bool) {
+	h, err := s3.ParseObjLockHdrs(r.Header)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: "InvalidRequest"})
+		return nil, false
+	}
+	if h != nil && !bck.Props.ObjLock.Enabled {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: errObjLockNotEnabled(bck), Code: "InvalidRequest"})
+		return nil, false
+	}
+	return h, true
+}
+
+func errObjLockNotEnabled(bck *meta.Bck) error {
+	return fmt.Errorf("bucket %s is missing object lock configuration", bck.Cname(""))
+}
+
+// GET /s3/<bucket-name>/<object-name>?retention|legal-hold
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectRetention.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectLegalHold.html
+func (t *target) getObjLockS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	if !bck.Props.ObjLock.Enabled {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: errObjLockNotEnabled(bck), Code: "InvalidRequest"})
+		return
+	}
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if !t._loadTaggingS3(w, r, bck, lom, false /*exclusive*/) {
+		return
+	}
+	var (
+		mode, until = lom.Retention()
+		hold        = lom.LegalHold()
+	)
+	lom.Unlock(false)
+
+	sgl := t.gmm.NewSGL(0)
+	defer sgl.Free()
+	if r.URL.Query().Has(s3.QparamRetention) {
+		if mode == "" {
+			err := fmt.Errorf("%s has no retention", lom.Cname())
+			s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusNotFound, Code: s3.NoSuchObjLockConfig})
+			return
+		}
+		s3.NewRetention(mode, until).MustMarshal(sgl)
+	} else {
+		s3.NewLegalHold(hold).MustMarshal(sgl)
+	}
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+}
+
+// PUT /s3/<bucket-name>/<object-name>?retention|legal-hold
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectRetention.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectLegalHold.html
+func (t *target) objLockS3(w http.ResponseWriter, r *http.Request, items []string) {
+	bck, ecode, err := meta.InitByNameOnly(items[0], t.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: ecode})
+		return
+	}
+	objName, errN := s3.JoinValidateOname(w, r, items)
+	if errN != nil {
+		return
+	}
+	if !bck.Props.ObjLock.Enabled {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: errObjLockNotEnabled(bck), Code: "InvalidRequest"})
+		return
+	}
+	if r.URL.Query().Has(s3.QparamRetention) {
+		t.putRetentionS3(w, r, bck, objName)
+	} else {
+		t.putLegalHoldS3(w, r, bck, objName)
+	}
+}
+
+func (t *target) putRetentionS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	retention := &s3.Retention{}
+	if err := xml.NewDecoder(r.Body).Decode(retention); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: "MalformedXML"})
+		return
+	}
+	mode, until, err := retention.Parse()
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: "InvalidRequest"})
+		return
+	}
+	bypass := s3.BypassGovernance(r.Header)
+	t._setObjLockS3(w, r, bck, objName, func(lom *core.LOM) error {
+		if err := lom.CheckRetention(mode, until, bypass); err != nil {
+			return err
+		}
+		lom.SetRetention(mode, until)
+		return nil
+	})
+}
+
+func (t *target) putLegalHoldS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	hold := &s3.LegalHold{}
+	if err := xml.NewDecoder(r.Body).Decode(hold); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: "MalformedXML"})
+		return
+	}
+	on, err := hold.Parse()
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: "InvalidRequest"})
+		return
+	}
+	t._setObjLockS3(w, r, bck, objName, func(lom *core.LOM) error {
+		lom.SetLegalHold(on)
+		return nil
+	})
+}
+
+// compare with `setTaggingS3`
+func (t *target) _setObjLockS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, set func(*core.LOM) error) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if !t._loadTaggingS3(w, r, bck, lom, true /*exclusive*/) {
+		return
+	}
+	if err := set(lom); err != nil {
+		lom.Unlock(true)
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return
+	}
+	err := lom.Persist()
+	lom.Unlock(true)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusInternalServerError})
+	}
+}
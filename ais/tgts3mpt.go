@@ -12,9 +12,12 @@ import (
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -46,11 +49,34 @@ func (t *target) startMptS3(w http.ResponseWriter, r *http.Request, items []stri
 		return
 	}
 
+	tagging, ok := parseTaggingS3(w, r)
+	if !ok {
+		return
+	}
+	sse, ok := t.startSSEMptS3(w, r, bck)
+	if !ok {
+		return
+	}
+	lockHdrs, ok := parseObjLockS3(w, r, bck)
+	if !ok {
+		return
+	}
+
 	uploadID, err := t.ups.start(r, lom, false /*skipBackend*/)
 	if err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
 		return
 	}
+	if tagging != "" {
+		t.ups.setTagging(uploadID, tagging)
+	}
+	if sse != nil {
+		t.ups.setSSE(uploadID, sse.ToMD())
+		sse.SetHeaders(w.Header())
+	}
+	if lockHdrs != nil {
+		t.ups.setObjLock(uploadID, lockHdrs.ToMD())
+	}
 	result := &s3.InitiateMptUploadResult{Bucket: bck.Name, Key: objName, UploadID: uploadID}
 
 	nlog.Infoln("start", uploadID)
@@ -71,24 +97,85 @@ func (t *target) startMptS3(w http.ResponseWriter, r *http.Request, items []stri
 // https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPart.html
 func (t *target) putPartMptS3(w http.ResponseWriter, r *http.Request, items []string, q url.Values, bck *meta.Bck) {
 	// 1. parse/validate
+	uploadID, partNum, ok := t.parsePartMptS3(w, r, q)
+	if !ok {
+		return
+	}
+
+	// 2. init lom, load/create chunk manifest
+	objName, errN := s3.JoinValidateOname(w, r, items)
+	if errN != nil {
+		return
+	}
+	lom := &core.LOM{ObjName: objName}
+	if err := lom.InitBck(bck); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return
+	}
+
+	reader, ok := t.partSSEMptS3(w, r, r.Body, uploadID, partNum)
+	if !ok {
+		return
+	}
+
+	args := partArgs{
+		req:      r,
+		size:     r.ContentLength,
+		reader:   reader,
+		lom:      lom,
+		uploadID: uploadID,
+		partNum:  partNum,
+		isS3:     true,
+	}
+	etag, ecode, err := t.ups.putPart(&args)
+	// convert to s3 error
+	if cos.IsNotExist(err) {
+		s3.WriteMptErr(w, r, s3.NewErrNoSuchUpload(uploadID, nil), ecode, lom, uploadID)
+		return
+	}
+	if err != nil {
+		s3.WriteMptErr(w, r, err, ecode, lom, uploadID)
+		return
+	}
+
+	// s3 compliance
+	if etag != "" {
+		w.Header().Set(cos.S3CksumHeader, etag)
+	}
+}
+
+func (t *target) parsePartMptS3(w http.ResponseWriter, r *http.Request, q url.Values) (string, int, bool) {
 	uploadID := q.Get(s3.QparamMptUploadID)
 	if uploadID == "" {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: errors.New(emptyUploadID)})
-		return
+		return "", 0, false
 	}
 	part := q.Get(s3.QparamMptPartNo)
 	if part == "" {
 		err := fmt.Errorf("upload %q: missing part number", uploadID)
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
-		return
+		return "", 0, false
 	}
 	partNum, err := t.ups.parsePartNum(part)
 	if err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
-		return
+		return "", 0, false
 	}
+	return uploadID, int(partNum), true
+}
 
-	// 2. init lom, load/create chunk manifest
+// Copy another object (or its range) => part of the specified multipart upload.
+// - the source can be any bucket, including remote;
+// - the request is executed by the target that handles the upload (see p.copyObjS3)
+// and that reads the source via intra-cluster GET from the target that owns it
+// (which, in turn, may cold-GET it from the remote backend).
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPartCopy.html
+func (t *target) putCopyPartMptS3(w http.ResponseWriter, r *http.Request, items []string, q url.Values, bck *meta.Bck) {
+	// 1. parse/validate
+	uploadID, partNum, ok := t.parsePartMptS3(w, r, q)
+	if !ok {
+		return
+	}
 	objName, errN := s3.JoinValidateOname(w, r, items)
 	if errN != nil {
 		return
@@ -98,18 +185,66 @@ func (t *target) putPartMptS3(w http.ResponseWriter, r *http.Request, items []st
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
 		return
 	}
+	if manifest, _ := t.ups.get(uploadID, lom); manifest == nil {
+		s3.WriteMptErr(w, r, s3.NewErrNoSuchUpload(uploadID, nil), http.StatusNotFound, lom, uploadID)
+		return
+	}
+	rng, err := s3.ParseCopySrcRange(r.Header.Get(cos.S3HdrObjSrcRange))
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: "InvalidArgument"})
+		return
+	}
 
+	// 2. source
+	bckSrc, objSrc, ok := t.parseCopySrcS3(w, r)
+	if !ok {
+		return
+	}
+	src := core.AllocLOM(objSrc)
+	defer core.FreeLOM(src)
+	if err := src.InitBck(bckSrc); err != nil {
+		if cmn.IsErrRemoteBckNotFound(err) {
+			t.BMDVersionFixup(r)
+			err = src.InitBck(bckSrc)
+		}
+		if err != nil {
+			s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+			return
+		}
+	}
+	resp, err := t.getCopySrcS3(src, rng)
+	if err != nil {
+		ei := s3.ErrInfo{Err: err}
+		if herr, ok := err.(*cmn.ErrHTTP); ok {
+			ei.Status = herr.Status
+		}
+		switch ei.Status {
+		case http.StatusNotFound:
+			ei.Code = s3.NoSuchKey
+		case http.StatusRequestedRangeNotSatisfiable:
+			ei.Status, ei.Code = http.StatusBadRequest, "InvalidRange"
+		}
+		s3.WriteErr(w, r, ei)
+		return
+	}
+	defer cos.Close(resp.Body)
+
+	// 3. put part
+	// (x-amz-content-sha256, if present, refers to the (empty) request body)
+	r.Header.Del(cos.S3HdrContentSHA256)
+	reader, ok := t.partSSEMptS3(w, r, resp.Body, uploadID, partNum)
+	if !ok {
+		return
+	}
 	args := partArgs{
-		req:      r,
-		size:     r.ContentLength,
-		reader:   r.Body,
+		size:     resp.ContentLength,
+		reader:   reader,
 		lom:      lom,
 		uploadID: uploadID,
-		partNum:  int(partNum),
+		partNum:  partNum,
 		isS3:     true,
 	}
 	etag, ecode, err := t.ups.putPart(&args)
-	// convert to s3 error
 	if cos.IsNotExist(err) {
 		s3.WriteMptErr(w, r, s3.NewErrNoSuchUpload(uploadID, nil), ecode, lom, uploadID)
 		return
@@ -119,10 +254,64 @@ func (t *target) putPartMptS3(w http.ResponseWriter, r *http.Request, items []st
 		return
 	}
 
-	// s3 compliance
-	if etag != "" {
-		w.Header().Set(cos.S3CksumHeader, etag)
+	result := &s3.CopyPartResult{
+		LastModified: cos.FormatNanoTime(time.Now().UnixNano(), cos.ISO8601),
+		ETag:         cmn.QuoteETag(etag),
 	}
+	sgl := t.gmm.NewSGL(0)
+	result.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// read the source object (or its range) from the target that owns it;
+// encrypted sources are not supported (see tgts3sse)
+func (t *target) getCopySrcS3(lom *core.LOM, rng string) (*http.Response, error) {
+	smap := t.owner.smap.get()
+	tsi, err := smap.HrwHash2T(lom.Digest())
+	if err != nil {
+		return nil, err
+	}
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodGet
+		reqArgs.Base = tsi.URL(cmn.NetIntraData)
+		reqArgs.Header = http.Header{
+			apc.HdrSenderID:   []string{t.SID()},
+			apc.HdrSenderName: []string{t.String()},
+		}
+		if rng != "" {
+			reqArgs.Header.Set(cos.HdrRange, rng)
+		}
+		reqArgs.Path = apc.URLPathObjects.Join(lom.Bck().Name, lom.ObjName)
+		reqArgs.Query = lom.Bck().NewQuery()
+	}
+	req, err := reqArgs.Req()
+	cmn.FreeHra(reqArgs)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := g.client.data.Do(req) //nolint:bodyclose // closed by the caller
+	cmn.HreqFree(req)
+	if err != nil {
+		return nil, err
+	}
+	if code := resp.StatusCode; code >= http.StatusBadRequest {
+		cos.DrainReader(resp.Body)
+		cos.Close(resp.Body)
+		return nil, &cmn.ErrHTTP{Message: lom.Cname() + ": " + http.StatusText(code), Status: code}
+	}
+	for _, kv := range resp.Header.Values(apc.HdrObjCustomMD) {
+		if strings.HasPrefix(kv, s3.SSEObjMD+"=") {
+			cos.Close(resp.Body)
+			return nil, &cmn.ErrHTTP{
+				Message: lom.Cname() + ": copying (ranges of) encrypted objects is not supported",
+				Status:  http.StatusNotImplemented,
+			}
+		}
+	}
+	return resp, nil
 }
 
 // Complete multipart upload.
@@ -166,6 +355,10 @@ func (t *target) completeMptS3(w http.ResponseWriter, r *http.Request, items []s
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
 		return
 	}
+	cond, ok := parseWriteCondS3(w, r, bck)
+	if !ok {
+		return
+	}
 
 	// convert s3PartList to aistore native type
 	partList := make(apc.MptCompletedParts, 0, len(s3PartList.Parts))
@@ -189,9 +382,17 @@ func (t *target) completeMptS3(w http.ResponseWriter, r *http.Request, items []s
 		body:     body,
 		parts:    partList,
 		isS3:     true,
+		cond:     cond,
 	})
 	// convert generic error to s3 error
 	if cos.IsNotExist(err) {
+		// (unless If-Match on a missing object)
+		if cond != nil {
+			if manifest, _ := t.ups.get(uploadID, lom); manifest != nil {
+				s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusNotFound, Code: s3.NoSuchKey})
+				return
+			}
+		}
 		s3.WriteMptErr(w, r, s3.NewErrNoSuchUpload(uploadID, nil), ecode, lom, uploadID)
 		return
 	}
@@ -0,0 +1,92 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// POST /s3/<bucket-name>/<object-name>?select&select-type=2
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_SelectObjectContent.html
+//
+// runs the query on this (HRW) target - the one that stores the object -
+// and streams back the result (see s3.SelectRequest)
+func (t *target) selectObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	if st := r.URL.Query().Get(s3.QparamSelectType); st != s3.SelectType2 {
+		err := fmt.Errorf("invalid %s=%q (expecting %q)", s3.QparamSelectType, st, s3.SelectType2)
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return
+	}
+	req := &s3.SelectRequest{}
+	if err := xml.NewDecoder(r.Body).Decode(req); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: "MalformedXML"})
+		return
+	}
+	if err := req.Validate(); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: "InvalidRequest"})
+		return
+	}
+
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return
+	}
+	lom.Lock(false)
+	err := lom.Load(true /*cache it*/, true /*locked*/)
+	if err != nil && cos.IsNotExist(err) && bck.IsRemote() {
+		lom.Unlock(false)
+		if _, err = t.GetCold(r.Context(), lom, "" /*xkind*/, cmn.OwtGetLock); err == nil {
+			lom.Lock(false)
+			err = lom.Load(true /*cache it*/, true /*locked*/)
+			if err != nil {
+				lom.Unlock(false)
+			}
+		}
+	} else if err != nil {
+		lom.Unlock(false)
+	}
+	if err != nil {
+		ei := s3.ErrInfo{Err: err}
+		if cos.IsNotExist(err) {
+			ei.Err = cos.NewErrNotFound(t, lom.Cname())
+			ei.Status, ei.Code = http.StatusNotFound, s3.NoSuchKey
+		}
+		s3.WriteErr(w, r, ei)
+		return
+	}
+	defer lom.Unlock(false)
+
+	// S3 server-side encrypted: ciphertext at rest (compare with t.getSSEObjS3)
+	if err := lom.CheckEncrypted(); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: "InvalidRequest"})
+		return
+	}
+
+	lmfh, err := lom.Open()
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusInternalServerError})
+		return
+	}
+	defer cos.Close(lmfh)
+
+	w.Header().Set(cos.HdrContentType, cos.ContentBinary)
+	w.WriteHeader(http.StatusOK)
+	if err := req.Run(w, lmfh); err != nil {
+		nlog.Warningln(t.String(), "select", lom.Cname(), "failed:", err)
+	} else if cmn.Rom.V(5, cos.ModS3) {
+		nlog.Infoln(t.String(), "select", lom.Cname(), "done")
+	}
+}
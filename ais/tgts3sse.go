@@ -0,0 +1,232 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// S3 server-side encryption at rest: ais:// buckets only (see s3.SSE)
+// - PUT and multipart: encrypt the payload as it arrives
+// - GET and HEAD:      SSE-C requires the same customer key
+// Encrypted objects cannot be read via native API (see goi.txfini)
+
+type sseShaReader struct {
+	io.ReadCloser
+	cksum    *cos.CksumHash
+	expected string
+}
+
+func errSSENotAIS(bck *meta.Bck) error {
+	return fmt.Errorf("server-side encryption is supported only for %s buckets (have %s)", "ais://", bck.Cname(""))
+}
+
+// PUT: returns false upon failure (having written the error)
+func (*target) putSSES3(w http.ResponseWriter, r *http.Request, lom *core.LOM) bool {
+	p, err := s3.ParseSSE(r.Header)
+	if err != nil {
+		s3.WriteSSEErr(w, r, err)
+		return false
+	}
+	if p == nil {
+		return true
+	}
+	if !lom.Bck().IsAIS() {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: errSSENotAIS(lom.Bck()), Status: http.StatusNotImplemented, Code: "NotImplemented"})
+		return false
+	}
+	sse, dek, err := s3.NewSSE(p, false /*parts*/)
+	if err != nil {
+		s3.WriteSSEErr(w, r, err)
+		return false
+	}
+	if r.Body, err = sse.NewEncReader(r.Body, dek, 0); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusInternalServerError})
+		return false
+	}
+	sse.Set(lom)
+	return true
+}
+
+// start multipart upload: returns (nil, true) when not encrypted
+func (*target) startSSEMptS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck) (*s3.SSE, bool) {
+	p, err := s3.ParseSSE(r.Header)
+	if err != nil {
+		s3.WriteSSEErr(w, r, err)
+		return nil, false
+	}
+	if p == nil {
+		return nil, true
+	}
+	if !bck.IsAIS() {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: errSSENotAIS(bck), Status: http.StatusNotImplemented, Code: "NotImplemented"})
+		return nil, false
+	}
+	sse, _, err := s3.NewSSE(p, true /*parts*/)
+	if err != nil {
+		s3.WriteSSEErr(w, r, err)
+		return nil, false
+	}
+	return sse, true
+}
+
+// put part: returns the part's (encrypting, if need be) reader
+// (body: request body or, in the UploadPartCopy case, the source)
+func (t *target) partSSEMptS3(w http.ResponseWriter, r *http.Request, body io.ReadCloser, uploadID string, partNum int) (io.ReadCloser, bool) {
+	md := t.ups.getSSE(uploadID)
+	if md == nil {
+		return body, true
+	}
+	sse, err := s3.SSEFromMD(md)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusInternalServerError})
+		return nil, false
+	}
+	dek, err := sse.DataKey(r.Header)
+	if err != nil {
+		s3.WriteSSEErr(w, r, err)
+		return nil, false
+	}
+	// the part's checksums are computed over ciphertext - validate plaintext SHA256 here
+	if sha := r.Header.Get(cos.S3HdrContentSHA256); sha != "" && sha != cos.S3UnsignedPayload {
+		body = &sseShaReader{ReadCloser: body, cksum: cos.NewCksumHash(cos.ChecksumSHA256), expected: sha}
+		r.Header.Del(cos.S3HdrContentSHA256)
+	}
+	reader, err := sse.NewEncReader(body, dek, partNum)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusInternalServerError})
+		return nil, false
+	}
+	sse.SetHeaders(w.Header())
+	return reader, true
+}
+
+// GET /s3/<bucket-name>/<object-name> of an encrypted object;
+// returns false when the object is not encrypted (and the regular datapath applies)
+func (t *target) getSSEObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) bool {
+	if !bck.IsAIS() {
+		return false
+	}
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		return false
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		return false
+	}
+	if _, ok := lom.GetCustomKey(s3.SSEObjMD); !ok {
+		return false
+	}
+
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
+		ei := s3.ErrInfo{Err: err}
+		if cos.IsNotExist(err) {
+			ei.Err = cos.NewErrNotFound(t, lom.Cname())
+			ei.Status, ei.Code = http.StatusNotFound, s3.NoSuchKey
+		}
+		s3.WriteErr(w, r, ei)
+		return true
+	}
+	lmfh, err := lom.Open()
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusInternalServerError})
+		return true
+	}
+	defer cos.Close(lmfh)
+
+	rs, err := sseReader(r, lom, lmfh)
+	if err != nil {
+		s3.WriteSSEErr(w, r, err)
+		return true
+	}
+	if rs == nil {
+		return false // (not encrypted anymore)
+	}
+	hdr := w.Header()
+	s3.SetS3Headers(hdr, lom)
+	if v, ok := lom.GetCustomKey(cos.HdrContentType); ok {
+		hdr.Set(cos.HdrContentType, v)
+	} else {
+		hdr.Set(cos.HdrContentType, cos.ContentBinary)
+	}
+	// (Last-Modified is already set - see SetS3Headers)
+	http.ServeContent(w, r, "", time.Time{}, rs) // (handles range reads)
+	t.statsT.IncWith(stats.GetCount, bvlabs(bck))
+	return true
+}
+
+// decrypting reader; nil when the object is not encrypted
+func sseReader(r *http.Request, lom *core.LOM, lmfh io.ReaderAt) (io.ReadSeeker, error) {
+	sse, err := s3.GetSSE(lom)
+	if err != nil || sse == nil {
+		return nil, err
+	}
+	dek, err := sse.DataKey(r.Header)
+	if err != nil {
+		return nil, err
+	}
+	var partSizes []int64
+	if sse.Multipart() {
+		// segment per part (see s3.partIV)
+		u, err := core.NewUfest("", lom, true /*must-exist*/)
+		if err != nil {
+			return nil, err
+		}
+		if err := u.LoadCompleted(lom); err != nil {
+			return nil, err
+		}
+		partSizes = make([]int64, 0, u.Count())
+		for num := 1; num <= u.Count(); num++ {
+			c, err := u.GetChunk(num)
+			if err != nil {
+				return nil, err
+			}
+			partSizes = append(partSizes, c.Size())
+		}
+	}
+	return sse.NewDecReader(lmfh, dek, lom.Lsize(), partSizes)
+}
+
+// HEAD: SSE-C requires the same customer key
+func (*target) headSSEObjS3(w http.ResponseWriter, r *http.Request, lom *core.LOM) bool {
+	sse, err := s3.GetSSE(lom)
+	if err == nil && sse != nil {
+		_, err = sse.DataKey(r.Header)
+	}
+	if err != nil {
+		s3.WriteSSEErr(w, r, err)
+		return false
+	}
+	return true
+}
+
+//////////////////
+// sseShaReader //
+//////////////////
+
+func (sr *sseShaReader) Read(p []byte) (n int, err error) {
+	n, err = sr.ReadCloser.Read(p)
+	sr.cksum.H.Write(p[:n])
+	if errors.Is(err, io.EOF) {
+		sr.cksum.Finalize()
+		if expected := cos.NewCksum(cos.ChecksumSHA256, sr.expected); !sr.cksum.Equal(expected) {
+			return n, cos.NewErrDataCksum(&sr.cksum.Cksum, expected, cos.S3HdrContentSHA256)
+		}
+	}
+	return n, err
+}
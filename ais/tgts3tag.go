@@ -0,0 +1,135 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"encoding/xml"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// S3 object tagging: tags are stored in the object's custom metadata
+// (see s3.TaggingObjMD) and are local to AIS (i.e., not propagated to remote backends)
+
+// PUT or DELETE /s3/<bucket-name>/<object-name>?tagging
+func (t *target) tagObjS3(w http.ResponseWriter, r *http.Request, items []string) {
+	bck, ecode, err := meta.InitByNameOnly(items[0], t.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: ecode})
+		return
+	}
+	objName, errN := s3.JoinValidateOname(w, r, items)
+	if errN != nil {
+		return
+	}
+	if r.Method == http.MethodDelete {
+		t.delTaggingS3(w, r, bck, objName)
+	} else {
+		t.putTaggingS3(w, r, bck, objName)
+	}
+}
+
+// GET /s3/<bucket-name>/<object-name>?tagging
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectTagging.html
+func (t *target) getTaggingS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if !t._loadTaggingS3(w, r, bck, lom, false /*exclusive*/) {
+		return
+	}
+	tagging, err := s3.NewTagging(lom)
+	lom.Unlock(false)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusInternalServerError})
+		return
+	}
+	sgl := t.gmm.NewSGL(0)
+	tagging.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>/<object-name>?tagging
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectTagging.html
+func (t *target) putTaggingS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	tagging := &s3.Tagging{}
+	if err := xml.NewDecoder(r.Body).Decode(tagging); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: "MalformedXML"})
+		return
+	}
+	if err := tagging.Validate(); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.InvalidTag})
+		return
+	}
+	t.setTaggingS3(w, r, bck, objName, tagging.Encode())
+}
+
+// DELETE /s3/<bucket-name>/<object-name>?tagging
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteObjectTagging.html
+func (t *target) delTaggingS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	if t.setTaggingS3(w, r, bck, objName, "" /*none*/) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// compare with `httpobjpatch`
+func (t *target) setTaggingS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName, encoded string) bool {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if !t._loadTaggingS3(w, r, bck, lom, true /*exclusive*/) {
+		return false
+	}
+	if encoded == "" {
+		lom.DelCustomKey(s3.TaggingObjMD)
+	} else {
+		lom.SetCustomKey(s3.TaggingObjMD, encoded)
+	}
+	err := lom.Persist()
+	lom.Unlock(true)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusInternalServerError})
+		return false
+	}
+	return true
+}
+
+// returns true with the LOM locked and loaded
+func (t *target) _loadTaggingS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, lom *core.LOM, exclusive bool) bool {
+	if err := lom.InitBck(bck); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return false
+	}
+	lom.Lock(exclusive)
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(exclusive)
+		ei := s3.ErrInfo{Err: err}
+		if cos.IsNotExist(err) {
+			ei.Err = cos.NewErrNotFound(t, lom.Cname())
+			ei.Status, ei.Code = http.StatusNotFound, s3.NoSuchKey
+		}
+		s3.WriteErr(w, r, ei)
+		return false
+	}
+	return true
+}
+
+// parse and validate `x-amz-tagging` request header (PUT and multipart start)
+func parseTaggingS3(w http.ResponseWriter, r *http.Request) (string, bool) {
+	v := r.Header.Get(cos.S3HdrTagging)
+	if v == "" {
+		return "", true
+	}
+	tagging, err := s3.ParseTagging(v)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.InvalidTag})
+		return "", false
+	}
+	return tagging.Encode(), true
+}
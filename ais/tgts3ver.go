@@ -0,0 +1,331 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"container/heap"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ec"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// S3 object versions in ais:// buckets that retain prior versions
+// (see core/lversion and versioning.retain_prior)
+
+// S3 uses "null" version ID for objects that have been written prior to enabling versioning
+const s3NullVersion = "null"
+
+func isCurrentVersion(lom *core.LOM, ver string) bool {
+	cur := lom.Version()
+	return cur == ver || (cur == "" && ver == s3NullVersion)
+}
+
+// GET /s3/<bucket-name>?versions
+// this target's share of the bucket's versions (the proxy merges all targets' results)
+func (t *target) listVersionsS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, q url.Values) {
+	var (
+		res   = s3.NewListVersionsResult(bck.Name, q)
+		avail = fs.GetAvail()
+		// the smallest names (>= key-marker) - enough to fill the page
+		names = &verNames{
+			m:      make(map[string]struct{}, min(res.MaxKeys, 1024)),
+			limit:  res.MaxKeys + 2, // (+ key-marker, + one to truncate)
+			marker: res.KeyMarker,
+			prefix: res.Prefix,
+		}
+	)
+	for _, mi := range avail {
+		for _, ct := range []string{fs.ObjCT, fs.VersionCT} {
+			if err := names.walk(mi.MakePathCT(bck.Bucket(), ct), ct); err != nil {
+				s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+				return
+			}
+		}
+	}
+	sorted := names.h
+	slices.Sort(sorted)
+
+	var cnt int
+	for _, name := range sorted {
+		lom := core.AllocLOM(name)
+		n, err := _lsVersions(lom, bck, res)
+		core.FreeLOM(lom)
+		if err != nil {
+			s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+			return
+		}
+		if cnt += n; cnt > res.MaxKeys {
+			break // (enough to fill the page - see Finalize)
+		}
+	}
+	res.Finalize()
+
+	sgl := t.gmm.NewSGL(0)
+	res.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+//////////////
+// verNames //
+//////////////
+
+// Collects up to `limit` smallest names of the objects (current or retained) that
+// match the prefix and are >= key-marker. Walking stops (directory by directory)
+// as soon as the remaining entries cannot contribute.
+type verNames struct {
+	m      map[string]struct{}
+	marker string
+	prefix string
+	h      strMaxHeap
+	limit  int
+}
+
+func (vn *verNames) full() bool { return len(vn.h) >= vn.limit }
+
+func (vn *verNames) add(name string) {
+	if name < vn.marker || !strings.HasPrefix(name, vn.prefix) {
+		return
+	}
+	if _, ok := vn.m[name]; ok {
+		return
+	}
+	if vn.full() {
+		if name >= vn.h[0] {
+			return
+		}
+		delete(vn.m, heap.Pop(&vn.h).(string))
+	}
+	vn.m[name] = struct{}{}
+	heap.Push(&vn.h, name)
+}
+
+func (vn *verNames) walk(root, ct string) error {
+	err := filepath.WalkDir(root, func(fqn string, de os.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		rel, err := filepath.Rel(root, fqn)
+		if err != nil || rel == "." {
+			return err
+		}
+		if de.IsDir() {
+			dir := rel + "/"
+			switch {
+			case vn.prefix != "" && !cmn.DirHasOrIsPrefix(dir, vn.prefix):
+				return filepath.SkipDir
+			case dir < vn.marker && !strings.HasPrefix(vn.marker, dir):
+				return filepath.SkipDir // all names in this subtree precede the marker
+			case vn.full() && rel >= vn.h[0]:
+				return filepath.SkipDir // all names in this subtree are too large
+			}
+			return nil
+		}
+		//
+		// directory entries are visited in lexical order; `lb` is the lower bound
+		// of the names that the rest of this directory may contain
+		//
+		var (
+			objName = rel
+			lb      = rel
+		)
+		if ct == fs.VersionCT {
+			// (any subsequent "<name>.<suffix>" may cut its name at a byte <= '.')
+			base := filepath.Base(rel)
+			if i := strings.IndexFunc(base, func(c rune) bool { return c <= '.' }); i >= 0 {
+				lb = rel[:len(rel)-len(base)+i]
+			}
+			ci := fs.CSM.ParseUbase(base, ct)
+			if !ci.Ok {
+				objName = ""
+			} else {
+				objName = filepath.Join(filepath.Dir(rel), ci.Base)
+			}
+		}
+		if vn.full() && lb >= vn.h[0] {
+			return filepath.SkipDir // skip the rest of the directory
+		}
+		if objName != "" {
+			vn.add(objName)
+		}
+		return nil
+	})
+	return err
+}
+
+type strMaxHeap []string
+
+func (h strMaxHeap) Len() int           { return len(h) }
+func (h strMaxHeap) Less(i, j int) bool { return h[i] > h[j] }
+func (h strMaxHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *strMaxHeap) Push(x any)        { *h = append(*h, x.(string)) }
+
+func (h *strMaxHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+func _lsVersions(lom *core.LOM, bck *meta.Bck, res *s3.ListVersionsResult) (int, error) {
+	if err := lom.InitBck(bck); err != nil {
+		return 0, err
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+
+	ovs, err := lom.ListVersions()
+	if err != nil {
+		return 0, err
+	}
+	n := len(ovs)
+	exists := lom.Load(false /*cache it*/, true /*locked*/) == nil
+	if exists {
+		_, _, mtime, err := lom.Fstat(false /*get-atime*/)
+		if err != nil {
+			return 0, err
+		}
+		cur := &core.ObjVersion{Mtime: mtime.UTC(), Version: lom.Version(), Size: lom.Lsize()}
+		if cur.Version == "" {
+			cur.Version = s3NullVersion
+		}
+		cur.ETag = lom.ETag(mtime, false)
+		res.Add(lom.ObjName, cur, true /*is latest*/)
+		n++
+	}
+	for i, ov := range ovs {
+		res.Add(lom.ObjName, ov, i == 0 && !exists)
+	}
+	return n, nil
+}
+
+// GET or HEAD /s3/<bucket-name>/<object-name>?versionId=...
+// returns false when the regular (current version) datapath applies
+func (*target) getVersionS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName, ver string) bool {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return true
+	}
+	if !lom.RetainsVersions() {
+		return false
+	}
+
+	lom.Lock(false)
+	defer lom.Unlock(false)
+
+	vlom := lom
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err == nil && isCurrentVersion(lom, ver) {
+		if lom.IsChunked() {
+			return false
+		}
+	} else {
+		ov, err := lom.GetVersion(ver)
+		if err != nil {
+			ei := s3.ErrInfo{Err: err}
+			if cos.IsNotExist(err) {
+				ei.Status, ei.Code = http.StatusNotFound, s3.NoSuchVersion
+			}
+			s3.WriteErr(w, r, ei)
+			return true
+		}
+		if ov.DeleteMarker {
+			w.Header().Set(cos.S3HdrDeleteMarker, "true")
+			w.Header().Set(cos.S3VersionHeader, ver)
+			err := errors.New(lom.Cname() + " version " + ver + " is a delete marker")
+			s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusMethodNotAllowed, Code: "MethodNotAllowed"})
+			return true
+		}
+		vlom = core.AllocLOM(objName)
+		defer core.FreeLOM(vlom)
+		if err := vlom.InitBck(bck); err == nil {
+			err = vlom.InitVersion(ov)
+		}
+		if err != nil {
+			s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+			return true
+		}
+	}
+
+	fh, err := os.Open(vlom.FQN)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return true
+	}
+	defer fh.Close()
+	_, _, mtime, err := vlom.Fstat(false /*get-atime*/)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return true
+	}
+	var content io.ReadSeeker = fh
+	rs, err := sseReader(r, vlom, fh)
+	if err != nil {
+		s3.WriteSSEErr(w, r, err)
+		return true
+	}
+	if rs != nil {
+		content = rs
+	}
+
+	hdr := w.Header()
+	s3.SetS3Headers(hdr, vlom)
+	hdr.Set(cos.S3VersionHeader, ver)
+	if v, ok := vlom.GetCustomKey(cos.HdrContentType); ok {
+		hdr.Set(cos.HdrContentType, v)
+	} else {
+		hdr.Set(cos.HdrContentType, cos.ContentBinary)
+	}
+	http.ServeContent(w, r, "", mtime, content) // (handles HEAD and range reads)
+	return true
+}
+
+// DELETE /s3/<bucket-name>/<object-name>?versionId=...
+func (*target) delVersionS3(w http.ResponseWriter, r *http.Request, lom *core.LOM, ver string) {
+	lom.Lock(true)
+	err := lom.CheckVersionWORM(ver, s3.BypassGovernance(r.Header))
+	if err != nil {
+		lom.Unlock(true)
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return
+	}
+	ov, err := lom.DelVersion(ver)
+	lom.Unlock(true)
+	if err != nil {
+		ei := s3.ErrInfo{Err: err}
+		if cos.IsNotExist(err) {
+			ei.Status, ei.Code = http.StatusNotFound, s3.NoSuchVersion
+		}
+		s3.WriteErr(w, r, ei)
+		return
+	}
+	if ov.FQN == lom.FQN {
+		ec.ECM.CleanupObject(lom) // (current version deleted)
+	}
+	w.Header().Set(cos.S3VersionHeader, ver)
+	if ov.DeleteMarker {
+		w.Header().Set(cos.S3HdrDeleteMarker, "true")
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
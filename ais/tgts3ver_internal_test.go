@@ -0,0 +1,59 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestVerNamesWalk(t *testing.T) {
+	var (
+		objs  = []string{"a-b", "a.b", "a/x", "a/y/z", "b!", "b/c.tar", "c d", "dir/obj", "dir/obj-1", "dir.x"}
+		vers  = []string{"a-b.1", "a.2", "a.3.dm", "a.vi", "a.b.1", "a.b.vi", ".h.1", ".h.vi", "-m.1", "-m.vi", "zz.4", "zz.vi", "dir/obj-0.1", "dir/obj-0.vi"}
+		all   = []string{"-m", ".h", "a", "a-b", "a.b", "a/x", "a/y/z", "b!", "b/c.tar", "c d", "dir.x", "dir/obj", "dir/obj-0", "dir/obj-1", "zz"}
+		oroot = t.TempDir()
+		vroot = t.TempDir()
+	)
+	for _, name := range objs {
+		_mkfile(t, filepath.Join(oroot, name))
+	}
+	for _, name := range vers {
+		_mkfile(t, filepath.Join(vroot, name))
+	}
+	slices.Sort(all)
+
+	for _, marker := range []string{"", "a", "a.b", "b", "dir/", "dir/obj-0", "zzz"} {
+		for _, prefix := range []string{"", "a", "dir/"} {
+			for limit := 1; limit <= len(all)+1; limit++ {
+				vn := &verNames{m: make(map[string]struct{}), limit: limit, marker: marker, prefix: prefix}
+				tassert.CheckFatal(t, vn.walk(oroot, fs.ObjCT))
+				tassert.CheckFatal(t, vn.walk(vroot, fs.VersionCT))
+				got := vn.h
+				slices.Sort(got)
+
+				var expected []string
+				for _, name := range all {
+					if name >= marker && strings.HasPrefix(name, prefix) && len(expected) < limit {
+						expected = append(expected, name)
+					}
+				}
+				tassert.Fatalf(t, slices.Equal(got, expected), "marker %q, prefix %q, limit %d: expected %v, got %v",
+					marker, prefix, limit, expected, got)
+			}
+		}
+	}
+}
+
+func _mkfile(t *testing.T, fqn string) {
+	tassert.CheckFatal(t, os.MkdirAll(filepath.Dir(fqn), 0o755))
+	tassert.CheckFatal(t, os.WriteFile(fqn, nil, 0o644))
+}
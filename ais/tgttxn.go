@@ -973,6 +973,13 @@ func (t *target) destroyBucket(c *txnSrv) error {
 		if !nlp.TryLock(c.timeout.netw / 2) {
 			return cmn.NewErrBusy("bucket", c.bck.Cname(""))
 		}
+		// object lock (WORM): refuse to destroy locked objects
+		if c.msg.Action == apc.ActDestroyBck && c.bck.Init(t.owner.bmd) == nil {
+			if err := core.CheckBckWORM(c.bck); err != nil {
+				nlp.Unlock()
+				return err
+			}
+		}
 		txn := newTxnBckBase(c.bck)
 		txn.fillFromCtx(c)
 		if err := t.txns.begin(txn, nlp); err != nil {
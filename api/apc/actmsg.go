@@ -29,6 +29,7 @@ const (
 
 	ActCopyBck = "copy-bck"
 	ActETLBck  = "etl-bck"
+	ActSyncBck = "sync-bck" // diff-based bucket => bucket synchronization (see apc.SyncBckMsg)
 
 	ActETLInline = "etl-inline"
 
@@ -53,6 +54,8 @@ const (
 
 	ActLRU          = "lru"
 	ActStoreCleanup = "cleanup-store"
+	ActLifecycle    = "lifecycle" // bucket lifecycle: expire objects (see cmn.LifecycleConf)
+	ActReplicate    = "replicate" // bucket replication to remote AIS cluster (see cmn.ReplConf)
 
 	ActEvictRemoteBck = "evict-remote-bck" // evict remote bucket's data
 	ActList           = "list"
@@ -47,6 +47,9 @@ const (
 	// including BucketProps.Extra.OCI
 	HdrOCIRegion = aisPrefix + "Oci-Region"
 
+	// including BucketProps.Extra.Azure
+	HdrAzureHNS = aisPrefix + "Azure-Hns"
+
 	// including BucketProps.Extra.HTTP
 	HdrOrigURLBck = aisPrefix + "Original-Url"
 
@@ -57,6 +60,8 @@ const (
 
 	HdrRemoteOffline = aisPrefix + "Remote-Offline" // When accessing cached remote bucket with no backend connectivity.
 
+	HdrReplSrc = aisPrefix + "Repl-Src" // Replicated PUT or DELETE: source cluster UUID (see cmn.ReplConf)
+
 	// Object props headers
 	HdrObjCksumType = aisPrefix + "Checksum-Type"  // Checksum type, one of SupportedChecksums().
 	HdrObjCksumVal  = aisPrefix + "Checksum-Value" // Checksum value.
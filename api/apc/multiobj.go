@@ -150,7 +150,7 @@ func (*PrefetchMsg) delim(sb *cos.SB) {
 
 // ArchiveMsg parameterizes archiving multiple objects into a single
 // archive ("shard") object - one of `.tar`, `.tgz` / `.tar.gz`, `.zip`,
-// or `.tar.lz4`. Source objects are selected via ListRange. See
+// `.tar.lz4`, or `.tar.zst`. Source objects are selected via ListRange. See
 // cmn.ArchiveMsg for the full request that wraps this with a
 // destination bucket.
 //
@@ -159,7 +159,7 @@ type ArchiveMsg struct {
 	TxnUUID     string `json:"-"` // Internal use only
 	FromBckName string `json:"-"` // Internal use only
 	// Destination archive object name, including a supported archive
-	// extension (`.tar`, `.tgz`, `.tar.gz`, `.zip`, `.tar.lz4`).
+	// extension (`.tar`, `.tgz`, `.tar.gz`, `.zip`, `.tar.lz4`, `.tar.zst`).
 	ArchName string `json:"archname"`
 	// Override the archive MIME type. When set, takes precedence over
 	// the extension inferred from `archname`.
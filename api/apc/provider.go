@@ -16,8 +16,10 @@ const (
 	GCP   = "gcp"
 	OCI   = "oci"
 	HT    = "ht"
+	File  = "file"
+	HDFS  = "hdfs"
 
-	AllProviders = "ais, aws (s3://), gcp (gs://), azure (az://), oci (oc://), ht://" // NOTE: must include all
+	AllProviders = "ais, aws (s3://), gcp (gs://), azure (az://), oci (oc://), ht://, file://, hdfs://" // NOTE: must include all
 
 	NsUUIDPrefix = '@' // BEWARE: used by on-disk layout
 	NsNamePrefix = '#' // BEWARE: used by on-disk layout
@@ -36,12 +38,13 @@ const (
 
 const RemAIS = "remais" // to differentiate ais vs "remote" ais; also, default (remote ais cluster) alias
 
-var Providers = cos.NewStrSet(AIS, GCP, AWS, Azure, OCI, HT)
+var Providers = cos.NewStrSet(AIS, GCP, AWS, Azure, OCI, HT, File, HDFS)
 
 func IsProvider(p string) bool { return Providers.Contains(p) }
 
+// NOTE: includes file:// (shared POSIX filesystem) and hdfs:// (WebHDFS) - backends with their own listing and versioning
 func IsCloudProvider(p string) bool {
-	return p == AWS || p == GCP || p == Azure || p == OCI
+	return p == AWS || p == GCP || p == Azure || p == OCI || p == File || p == HDFS
 }
 
 // NOTE: not to confuse w/ bck.IsRemote() which also includes remote AIS
@@ -98,6 +101,10 @@ func DisplayProvider(p string) string {
 		return "OCI"
 	case HT:
 		return "HTTP(S)"
+	case File:
+		return "POSIX"
+	case HDFS:
+		return "HDFS"
 	default:
 		return p
 	}
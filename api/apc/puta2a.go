@@ -8,4 +8,12 @@ package apc
 const (
 	ArchAppend = 1 << iota
 	ArchAppendIfExist
+
+	// replace archived file in place if exists, otherwise append;
+	// destination shard must exist (unless combined with ArchAppendIfExist)
+	ArchReplace
+
+	// delete archived file(s): QparamArchpath and, optionally, JSON-encoded
+	// list of archived filenames in the request body (see api.DeleteFromArch)
+	ArchDelete
 )
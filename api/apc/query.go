@@ -84,7 +84,7 @@ const (
 	QparamAllLogs = "all"
 
 	// The following 4 (four) QparamArch* parameters are all intended for usage with sharded datasets,
-	// whereby the shards are (.tar, .tgz (or .tar.gz), .zip, and/or .tar.lz4) formatted objects.
+	// whereby the shards are (.tar, .tgz (or .tar.gz), .zip, .tar.lz4, and/or .tar.zst) formatted objects.
 	//
 	// For the most recently updated list of supported serialization formats, please see cmn/archive package.
 	//
@@ -87,6 +87,30 @@ type (
 		ListRange
 		TCBMsg
 	}
+
+	// SyncBckMsg parameterizes diff-based bucket-to-bucket synchronization
+	// (sync-bck): compare source and destination objects by name, size,
+	// checksum (or ETag), and version; copy only new and changed objects.
+	// Source and destination can be any two (distinct) buckets.
+	SyncBckMsg struct {
+		// Select only source (and destination) objects whose names
+		// start with this prefix.
+		Prefix string `json:"prefix"` // +gen:optional
+		// Compare and report (see job's control message) but make no
+		// modifications.
+		DryRun bool `json:"dry_run"` // +gen:optional
+		// Delete destination objects that are not present at the source.
+		Delete bool `json:"delete"` // +gen:optional
+		// Force the operation even when other xactions with limited
+		// coexistence are already running.
+		Force bool `json:"force"` // +gen:optional
+		// Number of concurrent workers (per target):
+		//   - `0`: Auto-computed.
+		//   - `>0`: Exact worker count.
+		NumWorkers int `json:"num-workers,omitempty"` // +gen:optional
+		// Keep going when failing to copy or delete individual objects.
+		ContinueOnError bool `json:"coer,omitempty"` // +gen:optional
+	}
 )
 
 ////////////
@@ -141,3 +165,27 @@ func (msg *CopyBckMsg) Str(sb *cos.SB, fromCname, toCname, tag string) {
 		}
 	}
 }
+
+////////////////
+// SyncBckMsg //
+////////////////
+
+func (msg *SyncBckMsg) Str(sb *cos.SB, fromCname, toCname string) {
+	sb.WriteString("sync ")
+	sb.WriteString(fromCname)
+	sb.WriteString("=>")
+	sb.WriteString(toCname)
+
+	if msg.DryRun || msg.Delete {
+		sb.WriteString(", flags:")
+		if msg.DryRun {
+			sb.WriteString("dry-run")
+		}
+		if msg.Delete {
+			if msg.DryRun {
+				sb.WriteUint8(',')
+			}
+			sb.WriteString("delete")
+		}
+	}
+}
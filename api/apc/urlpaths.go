@@ -34,6 +34,8 @@ const (
 	Sort     = "sort"     // dsort
 	ETL      = "etl"
 
+	Events = "events" // change notifications (webhook); proxy => targets
+
 	// proxy only
 	Cluster = "cluster" // primary
 	Tokens  = "tokens"  // auth & access
@@ -155,6 +157,7 @@ var (
 	URLPathIC       = urlpath(Version, IC)
 	URLPathHealth   = urlpath(Version, Health)
 	URLPathMetasync = urlpath(Version, Metasync)
+	URLPathEvents   = urlpath(Version, Events)
 
 	URLPathClu        = urlpath(Version, Cluster)
 	URLPathCluProxy   = urlpath(Version, Cluster, Proxy)
@@ -220,6 +220,19 @@ func ETLBucket(bp BaseParams, bckFrom, bckTo cmn.Bck, msg *apc.TCBMsg, fltPresen
 	return tcb(bp, bckFrom, bckTo, jbody, fltPresence...)
 }
 
+// SyncBucket compares bckFrom and bckTo (any two buckets) by name, size, checksum (ETag),
+// and version, and copies only new and changed objects.
+//
+// `msg.Delete` - additionally, remove destination objects that do not exist in bckFrom;
+// `msg.DryRun` - do nothing; only report (via xaction's control message) the differences.
+//
+// The destination must exist (remote buckets get added to the cluster's BMD on the fly).
+// Returns xaction ID if successful, error otherwise.
+func SyncBucket(bp BaseParams, bckFrom, bckTo cmn.Bck, msg *apc.SyncBckMsg) (string, error) {
+	jbody := cos.MustMarshal(apc.ActMsg{Action: apc.ActSyncBck, Value: msg})
+	return tcb(bp, bckFrom, bckTo, jbody)
+}
+
 func tcb(bp BaseParams, bckFrom, bckTo cmn.Bck, jbody []byte, fltPresence ...int) (string, error) {
 	if err := bckTo.Validate(); err != nil {
 		return "", err
@@ -43,6 +43,16 @@ const (
 	// client and dev deployment; see also cluster config "net.http.skip_verify"
 	AisSkipVerifyCrt = "AIS_SKIP_VERIFY_CRT"
 
+	// S3 server-side encryption (SSE-S3): base64-encoded 256-bit cluster key
+	// that wraps per-object data keys (must be the same on all targets)
+	AisSSEClusterKey = "AIS_SSE_CLUSTER_KEY"
+
+	// per-bucket backend credentials (bucket props "extra.<provider>.secret"):
+	// either a directory with one file per named secret (e.g., mounted k8s secret)
+	// or a single JSON file: {"<secret-name>": {...}, ...}
+	// (target only; changes are picked up at runtime)
+	AisBackendSecrets = "AIS_BACKEND_SECRETS"
+
 	// via ais-k8s repo
 	// see also:
 	// * https://github.com/NVIDIA/ais-k8s/blob/main/operator/pkg/resources/cmn/env.go
@@ -113,7 +113,7 @@ type (
 		ArchPath string // filename _in_ archive
 		Mime     string // user-specified mime type, takes precedence if defined
 		PutArgs
-		Flags int64 // apc.ArchAppend and apc.ArchAppendIfExist (the former requires destination shard to exist)
+		Flags int64 // apc.ArchAppend, apc.ArchAppendIfExist, and apc.ArchReplace (see api/apc/puta2a.go)
 	}
 
 	// APPEND(object)
@@ -640,7 +640,7 @@ func PrefetchObject(bp BaseParams, bck cmn.Bck, objName string) (string, error)
 }
 
 // Archive the content of a reader (`args.Reader` - e.g., an open file). =======================================
-// Destination, depending on the options, can be an existing (.tar, .tgz or .tar.gz, .zip, .tar.lz4)
+// Destination, depending on the options, can be an existing (.tar, .tgz or .tar.gz, .zip, .tar.lz4, .tar.zst)
 // formatted object (aka "shard") or a new one (or, a new version).
 // ---
 // For the updated list of supported archival formats -- aka MIME types -- see cmn/cos/archive.go.
@@ -674,6 +674,35 @@ func PutApndArch(args *PutApndArchArgs) (err error) {
 	return
 }
 
+// Delete archived files from an existing shard ===============================================
+// The shard gets rewritten on the target (with checksum recomputed and, for TAR, shard index updated).
+// Returns 404 when the shard does not exist or contains none of the named files.
+// See also: PutApndArch with apc.ArchReplace (to replace archived file in place).
+
+func DeleteFromArch(bp BaseParams, bck cmn.Bck, objName string, archpaths []string) error {
+	debug.Assert(len(archpaths) > 0)
+	q := qalloc()
+	q = bck.AddToQuery(q)
+	q.Set(apc.QparamArchpath, archpaths[0])
+
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Query = q
+		reqParams.Header = http.Header{apc.HdrPutApndArchFlags: []string{strconv.Itoa(apc.ArchDelete)}}
+		if len(archpaths) > 1 {
+			reqParams.Body = cos.MustMarshal(archpaths)
+			reqParams.Header.Set(cos.HdrContentType, cos.ContentJSON)
+		}
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	qfree(q)
+	return err
+}
+
 // Append(object) ===============================================================================
 // Uses specified reader (`args.Reader`) to append the corresponding content to an object.
 // The API can be called multiple times - each call returns a handle
@@ -71,6 +71,7 @@ var (
 		"rebalance.enabled":                   supportedBool,
 		"resilver.enabled":                    supportedBool,
 		"versioning.enabled":                  supportedBool,
+		"versioning.retain_prior":             supportedBool,
 		"replication.on_cold_get":             supportedBool,
 		"replication.on_lru_eviction":         supportedBool,
 		"replication.on_put":                  supportedBool,
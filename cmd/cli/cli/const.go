@@ -1211,6 +1211,7 @@ var (
 	archpathGetFlag = cli.StringFlag{ // for apc.QparamArchpath; GET from shard
 		Name: archpathFlag.Name,
 		Usage: "Extract the specified file from an object (\"shard\") formatted as: " + archFormats + ";\n" +
+			indent4 + "\tParquet and Arrow objects: extract 'rowgroup/<index>' as Arrow IPC stream;\n" +
 			indent4 + "\tsee also: '--archregx'",
 	}
 	archmimeFlag = cli.StringFlag{ // for apc.QparamArchmime
@@ -51,6 +51,14 @@ const (
 	PropBackendBckProvider = PropBackendBck + ".provider"
 )
 
+// ht:// bucket listing (see ExtraPropsHTTP)
+const (
+	HTListManifest  = "manifest"  // newline-delimited list of URLs (or object names)
+	HTListAutoindex = "autoindex" // Apache/nginx-generated HTML directory index
+
+	HTManifestDflt = "manifest.txt"
+)
+
 type (
 	Bprops struct {
 		BackendBck  Bck             `json:"backend_bck,omitempty"`            // makes a remote bucket out of a given ais://
@@ -60,10 +68,17 @@ type (
 		Cksum       CksumConf       `json:"checksum"`                         // this bucket's checksum (for supported enum, see cmn/cos.cksum)
 		Extra       ExtraProps      `json:"extra,omitempty" list:"omitempty"` // e.g., AWS.Endpoint for this bucket
 		RateLimit   RateLimitConf   `json:"rate_limit"`                       // frontend and backend rate limiting - bursty and adaptive, respectively
+		Hedge       HedgeConf       `json:"hedge" list:"omitempty"`           // cold GET hedging and failover to equivalent endpoints (see cmn/hedge)
+		Replication ReplConf        `json:"replication" list:"omitempty"`     // asynchronous replication to attached remote AIS cluster (see cmn/repl)
+		LsoCache    LsoCacheConf    `json:"lso_cache" list:"omitempty"`       // remote listing cache invalidated by change notifications (see cmn/lsocache)
 		EC          ECConf          `json:"ec"`                               // erasure coding
 		Chunks      ChunksConf      `json:"chunks"`                           // chunks and chunk manifests; multipart upload
 		Mirror      MirrorConf      `json:"mirror"`                           // n-way mirroring
 		LRU         LRUConf         `json:"lru"`                              // LRU watermarks and enable/disable
+		Lifecycle   LifecycleConf   `json:"lifecycle" list:"omitempty"`       // object expiration and stale multipart uploads (see cmn/lifecycle)
+		CORS        CORSConf        `json:"cors" list:"omitempty"`            // cross-origin resource sharing (see cmn/cors)
+		Policy      PolicyConf      `json:"policy" list:"omitempty"`          // S3-compatible bucket policy (see cmn/policy)
+		ObjLock     ObjLockConf     `json:"object_lock" list:"omitempty"`     // object lock (WORM) and default retention (see cmn/objlock)
 		Access      apc.AccessAttrs `json:"access,string"`                    // access permissions
 		Features    feat.Flags      `json:"features,string"`                  // to flip assorted enumerated defaults (e.g. "S3-Use-Path-Style"; see cmn/feat)
 		BID         uint64          `json:"bid,string" list:"omit"`           // unique ID
@@ -72,10 +87,11 @@ type (
 	}
 
 	ExtraProps struct {
-		HTTP ExtraPropsHTTP `json:"http,omitempty" list:"omitempty"`
-		AWS  ExtraPropsAWS  `json:"aws,omitempty" list:"omitempty"`
-		GCP  ExtraPropsGCP  `json:"gcp,omitempty" list:"omitempty"`
-		OCI  ExtraPropsOCI  `json:"oci,omitempty" list:"omitempty"`
+		HTTP  ExtraPropsHTTP  `json:"http,omitempty" list:"omitempty"`
+		AWS   ExtraPropsAWS   `json:"aws,omitempty" list:"omitempty"`
+		GCP   ExtraPropsGCP   `json:"gcp,omitempty" list:"omitempty"`
+		Azure ExtraPropsAzure `json:"azure,omitempty" list:"omitempty"`
+		OCI   ExtraPropsOCI   `json:"oci,omitempty" list:"omitempty"`
 		// e.g. "team=alpha;project=beta;id=123"
 		Custom string `json:"custom,omitempty"`
 	}
@@ -89,6 +105,8 @@ type (
 		HTTP *ExtraPropsHTTPToSet `json:"http,omitempty"` // +gen:optional
 		// Google Cloud Storage extras.
 		GCP *ExtraPropsGCPToSet `json:"gcp,omitempty"` // +gen:optional
+		// Azure Blob Storage extras.
+		Azure *ExtraPropsAzureToSet `json:"azure,omitempty"` // +gen:optional
 		// Oracle Cloud Infrastructure object storage extras.
 		OCI *ExtraPropsOCIToSet `json:"oci,omitempty"` // +gen:optional
 		// Opaque user-defined extras (JSON-encoded). Any change to
@@ -121,6 +139,13 @@ type (
 		// - for the AIS default, see `DefaultPartSize` in ais/s3/const
 		// - NOTE: the threshold is, effectively, one of the **performance tunables**
 		MultiPartSize cos.SizeIEC `json:"multipart_size,omitempty"`
+
+		// S3-compatible store quirks
+		Dialect S3Dialect `json:"dialect,omitempty"`
+
+		// Named backend secret (see env.AisBackendSecrets) with static access keys;
+		// takes precedence over the profile; reloaded upon change.
+		Secret string `json:"secret,omitempty"`
 	}
 	// ExtraPropsAWSToSet is the partial-update counterpart of ExtraPropsAWS.
 	ExtraPropsAWSToSet struct {
@@ -141,12 +166,24 @@ type (
 		// at least 5 MiB. Falls back to the AIS-provided default when
 		// omitted. Primarily a performance tunable.
 		MultiPartSize *cos.SizeIEC `json:"multipart_size,omitempty"` // +gen:optional
+		// Quirks of S3-compatible stores (MinIO, Ceph RGW, Wasabi,
+		// etc.): addressing, checksums, listing, and multipart upload.
+		Dialect *S3DialectToSet `json:"dialect,omitempty"` // +gen:optional
+		// Named secret (see `AIS_BACKEND_SECRETS`) that holds static
+		// access keys for this bucket: `access_key_id`,
+		// `secret_access_key`, and optional `session_token`. Takes
+		// precedence over the profile; reloaded when the secret changes.
+		Secret *string `json:"secret,omitempty"` // +gen:optional
 	}
 
 	ExtraPropsGCP struct {
 		// GCP service-account credentials JSON file.
 		// Overrides the global GOOGLE_APPLICATION_CREDENTIALS environment.
 		ApplicationCreds string `json:"application_creds,omitempty"`
+
+		// Named backend secret (see env.AisBackendSecrets): service-account JSON;
+		// takes precedence over application_creds; reloaded upon change.
+		Secret string `json:"secret,omitempty"`
 	}
 	// ExtraPropsGCPToSet is the partial-update counterpart of ExtraPropsGCP.
 	ExtraPropsGCPToSet struct {
@@ -154,23 +191,64 @@ type (
 		// Overrides the `GOOGLE_APPLICATION_CREDENTIALS` environment
 		// variable.
 		ApplicationCreds *string `json:"application_creds,omitempty"` // +gen:optional
+		// Named secret (see `AIS_BACKEND_SECRETS`) that holds GCP
+		// service-account credentials JSON. Takes precedence over
+		// `application_creds`; reloaded when the secret changes.
+		Secret *string `json:"secret,omitempty"` // +gen:optional
+	}
+
+	ExtraPropsAzure struct {
+		// Named backend secret (see env.AisBackendSecrets): storage account name and key;
+		// overrides the global AZURE_STORAGE_ACCOUNT and AZURE_STORAGE_KEY; reloaded upon change.
+		Secret string `json:"secret,omitempty"`
+
+		// Storage account with hierarchical namespace (ADLS Gen2): real directories,
+		// atomic (server-side) rename, and ACL-preserving copy.
+		HNS bool `json:"hns,omitempty" list:"readonly"`
+	}
+	// ExtraPropsAzureToSet is the partial-update counterpart of ExtraPropsAzure.
+	ExtraPropsAzureToSet struct {
+		// Named secret (see `AIS_BACKEND_SECRETS`) that holds the storage
+		// account for this bucket: `account_name`, `account_key`, and
+		// optional `endpoint`. Overrides the `AZURE_STORAGE_ACCOUNT` and
+		// `AZURE_STORAGE_KEY` environment; reloaded when the secret changes.
+		Secret *string `json:"secret,omitempty"` // +gen:optional
+		// Whether the storage account has hierarchical namespace enabled
+		// (ADLS Gen2). Read-only; detected by the system when the bucket
+		// is added to the cluster.
+		HNS *bool `json:"hns,omitempty"` // +gen:optional
 	}
 
 	ExtraPropsOCI struct {
 		// OCI region for this bucket.
 		// Overrides the global OCI_REGION environment / CLI config default.
 		Region string `json:"region,omitempty"`
+
+		// Named backend secret (see env.AisBackendSecrets): API signing key of an OCI user
+		// (in the same tenancy); reloaded upon change.
+		Secret string `json:"secret,omitempty"`
 	}
 	// ExtraPropsOCIToSet is the partial-update counterpart of ExtraPropsOCI.
 	ExtraPropsOCIToSet struct {
 		// OCI region for this bucket. Overrides the `OCI_REGION`
 		// environment variable and CLI config default.
 		Region *string `json:"region,omitempty"` // +gen:optional
+		// Named secret (see `AIS_BACKEND_SECRETS`) that holds the API
+		// signing key of an OCI user in the same tenancy: `tenancy_ocid`,
+		// `user_ocid`, `fingerprint`, `private_key`, and optional
+		// `passphrase`. Reloaded when the secret changes.
+		Secret *string `json:"secret,omitempty"` // +gen:optional
 	}
 
 	ExtraPropsHTTP struct {
 		// Original URL prior to hashing.
 		OrigURLBck string `json:"original_url,omitempty" list:"readonly"`
+		// Listing source: none (default), HTListManifest, or HTListAutoindex.
+		Listing string `json:"listing,omitempty"`
+		// Manifest location relative to the original URL (default: HTManifestDflt).
+		Manifest string `json:"manifest,omitempty"`
+		// Allow PUT and DELETE (e.g., WebDAV-style servers).
+		Writable bool `json:"writable,omitempty"`
 	}
 	// ExtraPropsHTTPToSet is the partial-update counterpart of ExtraPropsHTTP.
 	ExtraPropsHTTPToSet struct {
@@ -178,6 +256,17 @@ type (
 		// name). Read-only; set by the system when the bucket is first
 		// materialized.
 		OrigURLBck *string `json:"original_url"` // +gen:optional
+		// How to list the bucket: `"manifest"` (newline-delimited list of
+		// URLs or object names) or `"autoindex"` (Apache/nginx-generated
+		// HTML directory index). Empty (default) means in-cluster listing
+		// only.
+		Listing *string `json:"listing,omitempty"` // +gen:optional
+		// Manifest location relative to the original URL. Applies to
+		// `"manifest"` listing only; defaults to `manifest.txt`.
+		Manifest *string `json:"manifest,omitempty"` // +gen:optional
+		// Write objects via HTTP PUT and remove them via HTTP DELETE
+		// (e.g., WebDAV-style servers). Default: read-only.
+		Writable *bool `json:"writable,omitempty"` // +gen:optional
 	}
 
 	// BpropsToSet is the partial-update counterpart of Bprops - the
@@ -205,11 +294,27 @@ type (
 		Chunks *ChunksConfToSet `json:"chunks,omitempty"` // +gen:optional
 		// Erasure coding (data and parity slices).
 		EC *ECConfToSet `json:"ec,omitempty"` // +gen:optional
+		// Object expiration and abort-incomplete-upload rules.
+		Lifecycle *LifecycleConfToSet `json:"lifecycle,omitempty"` // +gen:optional
+		// Cross-origin resource sharing (CORS) rules.
+		CORS *CORSConfToSet `json:"cors,omitempty"` // +gen:optional
+		// Bucket policy (subset of S3 bucket policy).
+		Policy *PolicyConfToSet `json:"policy,omitempty"` // +gen:optional
+		// Object lock (WORM) and default retention.
+		ObjLock *ObjLockConfToSet `json:"object_lock,omitempty"` // +gen:optional
 		// Bitwise access-permission mask. See `apc.AccessAttrs` for
 		// the flag definitions.
 		Access *apc.AccessAttrs `json:"access,string,omitempty"` // +gen:optional
 		// Per-bucket rate limiting for HTTP verbs (GET, PUT, etc.).
 		RateLimit *RateLimitConfToSet `json:"rate_limit,omitempty"` // +gen:optional
+		// Cold GET hedging and failover to equivalent endpoints.
+		Hedge *HedgeConfToSet `json:"hedge,omitempty"` // +gen:optional
+		// Asynchronous replication to a bucket in an attached remote
+		// AIS cluster.
+		Replication *ReplConfToSet `json:"replication,omitempty"` // +gen:optional
+		// Remote list-objects cache, invalidated by change notifications
+		// (see `/v1/events`).
+		LsoCache *LsoCacheConfToSet `json:"lso_cache,omitempty"` // +gen:optional
 		// Bitwise feature flags scoped to this bucket. See `feat.Flags`
 		// for the flag definitions.
 		Features *feat.Flags `json:"features,string,omitempty"` // +gen:optional
@@ -322,13 +427,23 @@ func (bp *Bprops) Validate(targetCnt int) error {
 
 	// run assorted props validators
 	var softErr error
-	for _, pv := range []propsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.RateLimit, &bp.Chunks, &bp.LRU, &bp.Features} {
+	for _, pv := range []propsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.RateLimit, &bp.Hedge, &bp.Replication, &bp.LsoCache, &bp.Chunks, &bp.LRU, &bp.Lifecycle, &bp.CORS, &bp.Policy, &bp.ObjLock, &bp.Features} {
 		var err error
 		switch {
 		case pv == &bp.EC:
 			err = bp.EC.ValidateAsProps(targetCnt)
 		case pv == &bp.Extra:
 			err = bp.Extra.ValidateAsProps(bp.Provider)
+		case pv == &bp.Hedge:
+			provider := bp.Provider
+			if !bp.BackendBck.IsEmpty() {
+				provider = bp.BackendBck.Provider
+			}
+			err = bp.Hedge.ValidateAsProps(provider)
+		case pv == &bp.Replication:
+			err = bp.Replication.ValidateAsProps(bp.Provider, !bp.BackendBck.IsEmpty())
+		case pv == &bp.Lifecycle:
+			err = bp.Lifecycle.ValidateAsProps(bp.Provider, !bp.BackendBck.IsEmpty())
 		default:
 			err = pv.ValidateAsProps()
 		}
@@ -394,6 +509,7 @@ const (
 	maxAWSProfileLen = 256
 	maxAWSRegionLen  = 64
 	maxOCIRegionLen  = 64
+	maxSecretNameLen = 253 // (k8s)
 
 	maxCustomLen = 128
 )
@@ -441,17 +557,40 @@ func (c *ExtraProps) ValidateAsProps(arg ...any) error {
 		if c.HTTP.OrigURLBck == "" {
 			return errors.New("original bucket URL must be set for an HTTP provider bucket")
 		}
+		return c.HTTP.validate()
 
 	case apc.AWS:
 		return c.AWS.validate()
 	case apc.GCP:
 		return c.GCP.validate()
+	case apc.Azure:
+		return validateSecret(apc.Azure, c.Azure.Secret)
 	case apc.OCI:
 		return c.OCI.validate()
 	}
 	return nil
 }
 
+// secret name: a file in the (flat) secrets directory or a key in the secrets file
+func validateSecret(provider, name string) error {
+	if name == "" {
+		return nil
+	}
+	if len(name) > maxSecretNameLen {
+		return fmt.Errorf("invalid extra.%s.secret: too long (%d > %d)", provider, len(name), maxSecretNameLen)
+	}
+	if name[0] == '.' {
+		return fmt.Errorf("invalid extra.%s.secret %q: cannot start with '.'", provider, name)
+	}
+	for _, c := range name {
+		ok := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '-' || c == '_' || c == '.'
+		if !ok {
+			return fmt.Errorf("invalid extra.%s.secret %q: expecting letters, digits, '-', '_', and '.'", provider, name)
+		}
+	}
+	return nil
+}
+
 func (conf *ExtraPropsAWS) validate() error {
 	// multipart_size
 	size := conf.MultiPartSize
@@ -467,6 +606,15 @@ func (conf *ExtraPropsAWS) validate() error {
 		return fmt.Errorf("invalid extra.aws.max_pagesize %d (expecting 0 (default) or range 1..%d)", v, apc.MaxPageSizeAIS)
 	}
 
+	// dialect
+	if err := conf.Dialect.validate(); err != nil {
+		return err
+	}
+
+	if err := validateSecret(apc.AWS, conf.Secret); err != nil {
+		return err
+	}
+
 	// profile
 	if p := conf.Profile; p != "" {
 		if strings.TrimSpace(p) != p {
@@ -507,10 +655,38 @@ func (conf *ExtraPropsAWS) validate() error {
 	return nil
 }
 
+func (conf *ExtraPropsHTTP) validate() error {
+	switch conf.Listing {
+	case "", HTListManifest, HTListAutoindex:
+	default:
+		return fmt.Errorf("invalid extra.http.listing %q (expecting %q, %q, or none)", conf.Listing, HTListManifest, HTListAutoindex)
+	}
+	if m := conf.Manifest; m != "" {
+		if err := cos.ValidatePrefix("invalid extra.http.manifest", m); err != nil { // disallow "../" and "~/"
+			return err
+		}
+		if strings.HasPrefix(m, "/") || strings.Contains(m, "://") {
+			return fmt.Errorf("invalid extra.http.manifest %q: must be relative to the original URL", m)
+		}
+	}
+	return nil
+}
+
+// manifest location relative to the bucket's original URL
+func (conf *ExtraPropsHTTP) ManifestName() string {
+	if conf.Manifest == "" {
+		return HTManifestDflt
+	}
+	return conf.Manifest
+}
+
 func (conf *ExtraPropsGCP) validate() error {
 	const (
 		etag = "invalid extra.gcp.application_creds"
 	)
+	if err := validateSecret(apc.GCP, conf.Secret); err != nil {
+		return err
+	}
 	path := conf.ApplicationCreds
 	if path == "" {
 		return nil
@@ -536,7 +712,7 @@ func (conf *ExtraPropsOCI) validate() error {
 			return fmt.Errorf("invalid extra.oci.region: too long (%d > %d)", len(r), maxOCIRegionLen)
 		}
 	}
-	return nil
+	return validateSecret(apc.OCI, conf.Secret)
 }
 
 //
@@ -609,7 +785,7 @@ func (s AllBsummResults) Finalize(dsize map[string]uint64, testingEnv bool) {
 type (
 	// ArchiveBckMsg is the payload for archiving multiple source-bucket objects
 	// into a single archive object (also called a "shard"), formatted as a
-	// .tar, .tgz/.tar.gz, .zip, or .tar.lz4 file. The destination bucket may
+	// .tar, .tgz/.tar.gz, .zip, .tar.lz4, or .tar.zst file. The destination bucket may
 	// be the same as the source.
 	//
 	// The single-object append variant is apc.PutApndArchArgs.
@@ -9,16 +9,43 @@ import (
 	"archive/tar"
 	"archive/zip"
 	"io"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// archived files to delete or replace while copying (see Writer.CopyEdit)
+//   - all occurrences of a given (archived) filename are affected;
+//   - replacement content is written in place of the first occurrence, thus
+//     preserving the order of archived files (e.g., WebDataset samples)
+type (
+	Edit struct {
+		R       io.Reader // replacement content; nil: delete
+		OAH     cos.OAH   // replacement size and mtime
+		Applied bool      // set by CopyEdit when the filename is found
+	}
+	Edits map[string]*Edit // [archived filename => edit]
 )
 
+func (edits Edits) find(name string) *Edit {
+	if e, ok := edits[name]; ok {
+		return e
+	}
+	if name != "" && name[0] == '/' { // in re `--absolute-names`, see also namesEq
+		return edits[name[1:]]
+	}
+	return nil
+}
+
 // copy `src` => `tw` destination, one file at a time
-// handles .tar, .tar.gz, and .tar.lz4
+// handles .tar, .tar.gz, .tar.lz4, and .tar.zst
 // - open specific arch reader
 // - always close it
 // - `tw` is the writer that can be further used to write (ie., append)
+// - `edits` (optional) - archived files to skip (delete) or replace
 //
 // see also: cpZip below
-func cpTar(src io.Reader, tw *tar.Writer, buf []byte) (err error) {
+func cpTar(src io.Reader, tw *tar.Writer, buf []byte, edits Edits) (err error) {
 	tr := tar.NewReader(src)
 	for err == nil {
 		var hdr *tar.Header
@@ -30,6 +57,20 @@ func cpTar(src io.Reader, tw *tar.Writer, buf []byte) (err error) {
 		if err != nil {
 			break
 		}
+		if e := edits.find(hdr.Name); e != nil {
+			if e.Applied || e.R == nil {
+				e.Applied = true
+				continue // delete (or drop duplicate of the replaced one)
+			}
+			e.Applied = true
+			nhdr := *hdr
+			nhdr.Size = e.OAH.Lsize()
+			nhdr.ModTime = time.Unix(0, e.OAH.AtimeUnix())
+			if err = tw.WriteHeader(&nhdr); err == nil {
+				_, err = io.CopyBuffer(tw, e.R, buf)
+			}
+			continue
+		}
 		// copy next one
 		csl := &io.LimitedReader{R: tr, N: hdr.Size}
 		if err = tw.WriteHeader(hdr); err == nil {
@@ -39,7 +80,7 @@ func cpTar(src io.Reader, tw *tar.Writer, buf []byte) (err error) {
 	return err
 }
 
-func cpZip(src io.ReaderAt, size int64, zw *zip.Writer, buf []byte) (err error) {
+func cpZip(src io.ReaderAt, size int64, zw *zip.Writer, buf []byte, edits Edits) (err error) {
 	var zr *zip.Reader
 	if zr, err = zip.NewReader(src, size); err != nil {
 		return
@@ -52,6 +93,24 @@ func cpZip(src io.ReaderAt, size int64, zw *zip.Writer, buf []byte) (err error)
 		if f.FileInfo().IsDir() {
 			continue
 		}
+		if e := edits.find(f.FileHeader.Name); e != nil {
+			if e.Applied || e.R == nil {
+				e.Applied = true
+				continue // (ditto)
+			}
+			e.Applied = true
+			hdr := f.FileHeader
+			hdr.UncompressedSize64 = uint64(e.OAH.Lsize())
+			hdr.CompressedSize64, hdr.CRC32 = 0, 0 // recomputed by zip.Writer
+			hdr.Modified = time.Unix(0, e.OAH.AtimeUnix())
+			if zipw, err = zw.CreateHeader(&hdr); err == nil {
+				_, err = io.CopyBuffer(zipw, e.R, buf)
+			}
+			if err != nil {
+				break
+			}
+			continue
+		}
 		zipr, err = f.Open()
 		if err != nil {
 			break
@@ -1,7 +1,7 @@
 // Package archive: write, read, copy, append, list primitives
 // across all supported formats
 /*
- * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package archive
 
@@ -53,6 +53,8 @@ func List(fqn string) ([]*Entry, error) {
 		}
 	case ExtTarLz4:
 		lst, err = lsLz4(fh)
+	case ExtTarZst:
+		lst, err = lsZst(fh)
 	default:
 		debug.Assert(false, mime)
 	}
@@ -65,7 +67,7 @@ func List(fqn string) ([]*Entry, error) {
 	return lst, nil
 }
 
-// list: tar, tgz, zip, msgpack
+// list: tar, tgz, zip, lz4, zstd
 func lsTar(reader io.Reader) (lst []*Entry, _ error) {
 	tr := tar.NewReader(reader)
 	for {
@@ -116,6 +118,16 @@ func lsLz4(reader io.Reader) ([]*Entry, error) {
 	return lsTar(lzr)
 }
 
+func lsZst(reader io.Reader) ([]*Entry, error) {
+	zsr, err := newZstdDecoder(reader)
+	if err != nil {
+		return nil, err
+	}
+	lst, err := lsTar(zsr)
+	zsr.Close()
+	return lst, err
+}
+
 // Split a path at the first archive extension boundary, e.g.:
 // "a/b/c/shard.tar/dir/file.bin" -> ("a/b/c/shard.tar", "dir/file.bin")
 // "plain/object/path" -> ("plain/object/path", "").
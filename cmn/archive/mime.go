@@ -1,7 +1,7 @@
 // Package archive: write, read, copy, append, list primitives
 // across all supported formats
 /*
- * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package archive
 
@@ -27,12 +27,14 @@ const (
 	ExtTarGz  = ".tar.gz"
 	ExtZip    = ".zip"
 	ExtTarLz4 = ".tar.lz4"
+	ExtTarZst = ".tar.zst"
 )
 
 // compression formats - not necessarily compressed TAR
 const (
 	ExtGz  = ".gz"
 	ExtLz4 = ".lz4"
+	ExtZst = ".zst"
 )
 
 const (
@@ -51,7 +53,7 @@ type detect struct {
 	offset int
 }
 
-var FileExtensions = [...]string{ExtTar, ExtTgz, ExtTarGz, ExtZip, ExtTarLz4}
+var FileExtensions = [...]string{ExtTar, ExtTgz, ExtTarGz, ExtZip, ExtTarLz4, ExtTarZst}
 
 // standard file signatures
 var (
@@ -59,8 +61,9 @@ var (
 	magicGzip = detect{sig: []byte{0x1f, 0x8b}, mime: ExtTarGz}
 	magicZip  = detect{sig: []byte{0x50, 0x4b}, mime: ExtZip}
 	magicLz4  = detect{sig: []byte{0x04, 0x22, 0x4d, 0x18}, mime: ExtTarLz4}
+	magicZstd = detect{sig: []byte{0x28, 0xb5, 0x2f, 0xfd}, mime: ExtTarZst}
 
-	allMagics = []detect{magicTar, magicGzip, magicZip, magicLz4} // NOTE: must contain all
+	allMagics = []detect{magicTar, magicGzip, magicZip, magicLz4, magicZstd} // NOTE: must contain all
 )
 
 // motivation: prevent from creating archives with non-standard extensions
@@ -95,6 +98,8 @@ func normalize(mime string) (string, error) {
 		return ExtTarGz, nil
 	case strings.Contains(mime, ExtTarLz4[1:]): // ditto
 		return ExtTarLz4, nil
+	case strings.Contains(mime, ExtTarZst[1:]): // ditto
+		return ExtTarZst, nil
 	default:
 		for _, ext := range FileExtensions {
 			if strings.Contains(mime, ext[1:]) {
@@ -175,6 +180,10 @@ func _detect(lh cos.LomReader, archname, mime string, buf []byte) (string, error
 		if l := magicLz4.offset + len(magicLz4.sig) + 4; n < l {
 			return "", newErrUnknownFileExt(archname, fmt.Sprintf(fmtErrTooShort, ExtTarLz4, l))
 		}
+	case ExtTarZst:
+		if l := magicZstd.offset + len(magicZstd.sig) + 4; n < l {
+			return "", newErrUnknownFileExt(archname, fmt.Sprintf(fmtErrTooShort, ExtTarZst, l))
+		}
 	}
 	for _, magic := range allMagics {
 		if n > magic.offset && bytes.HasPrefix(buf[magic.offset:n], magic.sig) {
@@ -185,7 +194,7 @@ func _detect(lh cos.LomReader, archname, mime string, buf []byte) (string, error
 }
 
 // inspect the first bytes of r and return a compression
-// extension (ExtGz, ExtLz4);
+// extension (ExtGz, ExtLz4, ExtZst);
 // an empty `ext` indicates plain-text (or rather: no compression)
 func DetectCompression(r io.ReaderAt) (string, error) {
 	// keep a bit of head-room
@@ -204,6 +213,10 @@ func DetectCompression(r io.ReaderAt) (string, error) {
 		bytes.HasPrefix(hdr[magicLz4.offset:], magicLz4.sig) {
 		return ExtLz4, nil
 	}
+	if n >= magicZstd.offset+len(magicZstd.sig) &&
+		bytes.HasPrefix(hdr[magicZstd.offset:], magicZstd.sig) {
+		return ExtZst, nil
+	}
 	// plain-text or unknown
 	return "", nil
 }
@@ -237,6 +250,8 @@ func ExtFromContentType(ct string) string {
 		return ExtTgz
 	case strings.HasPrefix(ct, "application/x-lz4") || strings.HasPrefix(ct, "application/lz4"):
 		return ExtTarLz4
+	case strings.HasPrefix(ct, "application/zstd") || strings.HasPrefix(ct, "application/x-zstd"):
+		return ExtTarZst
 	case strings.HasPrefix(ct, cos.ContentZip):
 		return ExtZip
 	default:
@@ -252,6 +267,8 @@ func ContentTypeFromExt(ext string) string {
 		return cos.ContentGzip // widely used for .tar.gz / .tgz
 	case ExtTarLz4:
 		return "application/x-lz4" // unofficial but conventional
+	case ExtTarZst:
+		return "application/zstd" // IANA-registered (RFC 8878)
 	case ExtZip:
 		return cos.ContentZip // IANA-registered
 	default:
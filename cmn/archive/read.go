@@ -18,6 +18,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v4"
 )
 
@@ -97,6 +98,10 @@ type (
 		tr  tarReader
 		lzr *lz4.Reader
 	}
+	zstReader struct {
+		tr  tarReader
+		zsr *zstd.Decoder
+	}
 )
 
 // interface guard
@@ -105,6 +110,7 @@ var (
 	_ Reader = (*tgzReader)(nil)
 	_ Reader = (*zipReader)(nil)
 	_ Reader = (*lz4Reader)(nil)
+	_ Reader = (*zstReader)(nil)
 )
 
 func NewReader(mime string, fh io.Reader, size ...int64) (ar Reader, err error) {
@@ -118,6 +124,8 @@ func NewReader(mime string, fh io.Reader, size ...int64) (ar Reader, err error)
 		ar = &zipReader{size: size[0]}
 	case ExtTarLz4:
 		ar = &lz4Reader{}
+	case ExtTarZst:
+		ar = &zstReader{}
 	default:
 		debug.Assert(false, mime)
 	}
@@ -327,6 +335,60 @@ func (lzr *lz4Reader) ReadOne(filename string) (cos.ReadCloseSizer, error) {
 	return lzr.tr.ReadOne(filename)
 }
 
+// zstReader
+
+func (zsr *zstReader) init(fh io.Reader) (err error) {
+	zsr.zsr, err = newZstdDecoder(fh)
+	if err != nil {
+		return
+	}
+	zsr.tr.baseR.init(zsr.zsr)
+	zsr.tr.tr = tar.NewReader(zsr.zsr)
+	return
+}
+
+func (zsr *zstReader) ReadUntil(rcb ArchRCB, regex, mmode string) error {
+	err := zsr.tr.ReadUntil(rcb, regex, mmode)
+	zsr.zsr.Close()
+	return err
+}
+
+func (zsr *zstReader) ReadOne(filename string) (cos.ReadCloseSizer, error) {
+	reader, err := zsr.tr.ReadOne(filename)
+	if err != nil || reader == nil {
+		zsr.zsr.Close()
+		return reader, err
+	}
+	// same as tgzReader (above): the caller closes the returned reader, and the decoder with it
+	return &cslClose{gzr: zsr.zsr.IOReadCloser(), R: reader, N: reader.Size()}, nil
+}
+
+// synchronous (no background goroutines) streaming decoder
+func newZstdDecoder(r io.Reader) (*zstd.Decoder, error) {
+	return zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+}
+
+// NewDecompressor returns a reader that decompresses (plain, not necessarily archived)
+// content compressed in one of the supported formats: ExtGz, ExtLz4, or ExtZst
+// (see also: DetectCompression).
+// The caller must close the returned reader (which does not close `r`).
+func NewDecompressor(ext string, r io.Reader) (io.ReadCloser, error) {
+	switch ext {
+	case ExtGz, ExtTgz, ExtTarGz:
+		return gzip.NewReader(r)
+	case ExtLz4, ExtTarLz4:
+		return io.NopCloser(lz4.NewReader(r)), nil
+	case ExtZst, ExtTarZst:
+		zsr, err := newZstdDecoder(r)
+		if err != nil {
+			return nil, err
+		}
+		return zsr.IOReadCloser(), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression %q (expecting one of: %s, %s, %s)", ext, ExtGz, ExtLz4, ExtZst)
+	}
+}
+
 //
 // more limited readers
 //
@@ -422,11 +484,18 @@ func (csr *cslRange) Close() error { return csr.r.Close() }
 
 // return a reader for [off, off+length) over an already-open archived-file reader:
 // - range over the extracted file bytes, not over the containing archive bytes
+// - seek when `r` is seekable (e.g., TAR member located via shard index), otherwise read and discard `off` bytes
 // - the returned range-reader owns and closes the original archived-file `r`
 // - on error, `r` is closed before returning
 func RangeReader(r cos.ReadCloseSizer, off, length int64) (cos.ReadCloseSizer, error) {
 	if off != 0 {
-		if _, err := io.CopyN(io.Discard, r, off); err != nil {
+		var err error
+		if sk, ok := r.(io.Seeker); ok {
+			_, err = sk.Seek(off, io.SeekStart)
+		} else {
+			_, err = io.CopyN(io.Discard, r, off)
+		}
+		if err != nil {
 			_ = r.Close()
 			return nil, err
 		}
@@ -0,0 +1,205 @@
+// Package archive: write, read, copy, append, list primitives
+// across all supported formats
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package archive_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// TestArchRoundTrip writes an archive in each supported format, and then lists it,
+// detects its format by magic, and reads back (all and one) archived files.
+func TestArchRoundTrip(t *testing.T) {
+	const numFiles = 10
+	smm := memsys.ByteMM()
+	for _, ext := range archive.FileExtensions {
+		t.Run(ext, func(t *testing.T) {
+			var (
+				dir     = t.TempDir()
+				fqn     = filepath.Join(dir, "shard"+ext)
+				content = make(map[string][]byte, numFiles)
+			)
+			wfh, err := os.Create(fqn)
+			tassert.CheckFatal(t, err)
+			aw := archive.NewWriter(ext, wfh, nil, nil)
+			for i := range numFiles {
+				name := "dir/file-" + strconv.Itoa(i) + ".bin"
+				data := bytes.Repeat([]byte(name), i*100+1)
+				content[name] = data
+				err = aw.Write(name, cos.SimpleOAH{Size: int64(len(data))}, bytes.NewReader(data))
+				tassert.CheckFatal(t, err)
+			}
+			tassert.CheckFatal(t, aw.Fini())
+			tassert.CheckFatal(t, wfh.Close())
+
+			// list
+			lst, err := archive.List(fqn)
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, len(lst) == numFiles, "expected %d entries, got %d", numFiles, len(lst))
+			for _, en := range lst {
+				tassert.Errorf(t, en.Size == int64(len(content[en.Name])), "%s: size %d vs %d", en.Name, en.Size, len(content[en.Name]))
+			}
+
+			// detect by magic (.tgz and .tar.gz share the signature)
+			renamed := filepath.Join(dir, "noext")
+			tassert.CheckFatal(t, os.Rename(fqn, renamed))
+			mime, err := archive.MimeFQN(smm, "", renamed)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, archive.EqExt(mime, ext), "detected %q, expected %q", mime, ext)
+
+			// read one
+			name := "dir/file-7.bin"
+			fh, err := os.Open(renamed)
+			tassert.CheckFatal(t, err)
+			finfo, err := fh.Stat()
+			tassert.CheckFatal(t, err)
+			ar, err := archive.NewReader(ext, fh, finfo.Size())
+			tassert.CheckFatal(t, err)
+			r, err := ar.ReadOne(name)
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, r != nil, "%s: not found", name)
+			data, err := io.ReadAll(r)
+			tassert.CheckFatal(t, err)
+			tassert.CheckFatal(t, r.Close())
+			tassert.Errorf(t, bytes.Equal(data, content[name]), "%s: content mismatch", name)
+			fh.Close()
+		})
+	}
+}
+
+func TestCompressRoundTrip(t *testing.T) {
+	data := bytes.Repeat([]byte("the quick brown fox jumps over the lazy dog\n"), 10_000)
+	for _, ext := range []string{archive.ExtGz, archive.ExtLz4, archive.ExtZst} {
+		t.Run(ext, func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := archive.NewCompressor(ext, &buf)
+			tassert.CheckFatal(t, err)
+			_, err = w.Write(data)
+			tassert.CheckFatal(t, err)
+			tassert.CheckFatal(t, w.Close())
+			tassert.Errorf(t, buf.Len() < len(data), "not compressed: %d vs %d", buf.Len(), len(data))
+
+			detected, err := archive.DetectCompression(bytes.NewReader(buf.Bytes()))
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, detected == ext, "detected %q, expected %q", detected, ext)
+
+			r, err := archive.NewDecompressor(ext, &buf)
+			tassert.CheckFatal(t, err)
+			out, err := io.ReadAll(r)
+			tassert.CheckFatal(t, err)
+			tassert.CheckFatal(t, r.Close())
+			tassert.Errorf(t, bytes.Equal(out, data), "content mismatch")
+		})
+	}
+	_, err := archive.NewCompressor(".bz2", io.Discard)
+	tassert.Errorf(t, err != nil, "expected error for unsupported compression")
+}
+
+// range over archived file: sequential (tar member) vs seekable (indexed section)
+func TestRangeReader(t *testing.T) {
+	var (
+		buf  bytes.Buffer
+		name = "a/b/c.bin"
+		data = bytes.Repeat([]byte("0123456789abcdef"), 1000)
+	)
+	aw := archive.NewWriter(archive.ExtTar, &buf, nil, nil)
+	tassert.CheckFatal(t, aw.Write(name, cos.SimpleOAH{Size: int64(len(data))}, bytes.NewReader(data)))
+	tassert.CheckFatal(t, aw.Fini())
+	raw := buf.Bytes()
+
+	idx, err := archive.BuildShardIndex(bytes.NewReader(raw), int64(len(raw)))
+	tassert.CheckFatal(t, err)
+	entry, ok := idx.Entries[name]
+	tassert.Fatalf(t, ok, "%s: not indexed", name)
+
+	for _, rng := range [][2]int64{{0, 16}, {1000, 3000}, {int64(len(data)) - 7, 7}} {
+		off, length := rng[0], rng[1]
+		expected := data[off : off+length]
+
+		ar, err := archive.NewReader(archive.ExtTar, bytes.NewReader(raw))
+		tassert.CheckFatal(t, err)
+		member, err := ar.ReadOne(name)
+		tassert.CheckFatal(t, err)
+		seq, err := archive.RangeReader(member, off, length)
+		tassert.CheckFatal(t, err)
+
+		sec := cos.NewSectionHandle(bytes.NewReader(raw), entry.DataOffset(), entry.Size, 0)
+		seek, err := archive.RangeReader(sec, off, length)
+		tassert.CheckFatal(t, err)
+
+		for _, r := range []cos.ReadCloseSizer{seq, seek} {
+			tassert.Errorf(t, r.Size() == length, "size %d vs %d", r.Size(), length)
+			got, err := io.ReadAll(r)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, bytes.Equal(got, expected), "range [%d, %d): content mismatch", off, off+length)
+			r.Close()
+		}
+	}
+}
+
+type rcbCollect struct {
+	names   []string
+	content map[string][]byte
+}
+
+func (rcb *rcbCollect) Call(name string, r cos.ReadCloseSizer, _ any) (bool, error) {
+	data, err := io.ReadAll(r)
+	r.Close()
+	rcb.names = append(rcb.names, name)
+	rcb.content[name] = data
+	return false, err
+}
+
+// delete and replace (in place) archived files while copying
+func TestCopyEdit(t *testing.T) {
+	names := []string{"s1.cls", "s1.jpg", "s2.cls", "s2.jpg", "s3.cls", "s3.jpg"}
+	for _, ext := range archive.FileExtensions {
+		t.Run(ext, func(t *testing.T) {
+			var src, dst bytes.Buffer
+			aw := archive.NewWriter(ext, &src, nil, nil)
+			for _, name := range names {
+				tassert.CheckFatal(t, aw.Write(name, cos.SimpleOAH{Size: int64(len(name))}, bytes.NewReader([]byte(name))))
+			}
+			tassert.CheckFatal(t, aw.Fini())
+
+			replacement := []byte("replaced content")
+			edits := archive.Edits{
+				"s2.cls":  {},
+				"s2.jpg":  {},
+				"s3.jpg":  {R: bytes.NewReader(replacement), OAH: cos.SimpleOAH{Size: int64(len(replacement))}},
+				"missing": {},
+			}
+			aw = archive.NewWriter(ext, &dst, nil, nil)
+			tassert.CheckFatal(t, aw.CopyEdit(bytes.NewReader(src.Bytes()), edits, int64(src.Len())))
+			tassert.CheckFatal(t, aw.Fini())
+			for name, e := range edits {
+				tassert.Errorf(t, e.Applied == (name != "missing"), "%s: applied %t", name, e.Applied)
+			}
+
+			ar, err := archive.NewReader(ext, bytes.NewReader(dst.Bytes()), int64(dst.Len()))
+			tassert.CheckFatal(t, err)
+			rcb := &rcbCollect{content: make(map[string][]byte)}
+			tassert.CheckFatal(t, ar.ReadUntil(rcb, cos.EmptyMatchAll, ""))
+
+			expected := []string{"s1.cls", "s1.jpg", "s3.cls", "s3.jpg"}
+			tassert.Fatalf(t, len(rcb.names) == len(expected), "expected %v, got %v", expected, rcb.names)
+			for i, name := range expected {
+				tassert.Errorf(t, rcb.names[i] == name, "order: expected %v, got %v", expected, rcb.names)
+			}
+			tassert.Errorf(t, bytes.Equal(rcb.content["s3.jpg"], replacement), "s3.jpg: not replaced")
+			tassert.Errorf(t, string(rcb.content["s1.jpg"]) == "s1.jpg", "s1.jpg: content mismatch")
+		})
+	}
+}
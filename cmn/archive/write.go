@@ -1,7 +1,7 @@
 // Package archive: write, read, copy, append, list primitives
 // across all supported formats
 /*
- * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package archive
 
@@ -9,6 +9,7 @@ import (
 	"archive/tar"
 	"archive/zip"
 	"compress/gzip"
+	"fmt"
 	"io"
 	"os"
 	"sync"
@@ -20,6 +21,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/memsys"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v4"
 )
 
@@ -43,6 +45,8 @@ type (
 		Fini() error
 		// Copy arch, with potential subsequent APPEND
 		Copy(src io.Reader, size ...int64) error
+		// Copy arch while deleting and/or replacing (in place) selected archived files
+		CopyEdit(src io.Reader, edits Edits, size ...int64) error
 
 		Flush() error
 
@@ -73,6 +77,10 @@ type (
 		lzw *lz4.Writer
 		tw  tarWriter
 	}
+	zstWriter struct {
+		zsw *zstd.Encoder
+		tw  tarWriter
+	}
 )
 
 // interface guard
@@ -81,6 +89,7 @@ var (
 	_ Writer = (*tgzWriter)(nil)
 	_ Writer = (*zipWriter)(nil)
 	_ Writer = (*lz4Writer)(nil)
+	_ Writer = (*zstWriter)(nil)
 )
 
 // calls init() -> open(),alloc()
@@ -94,6 +103,8 @@ func NewWriter(mime string, w io.Writer, cksum *cos.CksumHashSize, opts *Opts) (
 		aw = &zipWriter{}
 	case ExtTarLz4:
 		aw = &lz4Writer{}
+	case ExtTarZst:
+		aw = &zstWriter{}
 	default:
 		debug.Assert(false, mime)
 	}
@@ -166,7 +177,11 @@ func (tw *tarWriter) Write(fullname string, oah cos.OAH, reader io.Reader) (err
 }
 
 func (tw *tarWriter) Copy(src io.Reader, _ ...int64) error {
-	return cpTar(src, tw.tw, tw.buf)
+	return cpTar(src, tw.tw, tw.buf, nil)
+}
+
+func (tw *tarWriter) CopyEdit(src io.Reader, edits Edits, _ ...int64) error {
+	return cpTar(src, tw.tw, tw.buf, edits)
 }
 
 func (tw *tarWriter) Flush() error { return tw.tw.Flush() }
@@ -210,11 +225,15 @@ func (tzw *tgzWriter) Write(fullname string, oah cos.OAH, reader io.Reader) erro
 }
 
 func (tzw *tgzWriter) Copy(src io.Reader, _ ...int64) error {
+	return tzw.CopyEdit(src, nil)
+}
+
+func (tzw *tgzWriter) CopyEdit(src io.Reader, edits Edits, _ ...int64) error {
 	gzr, err := gzip.NewReader(src)
 	if err != nil {
 		return err
 	}
-	err = cpTar(gzr, tzw.tw.tw, tzw.tw.buf)
+	err = cpTar(gzr, tzw.tw.tw, tzw.tw.buf, edits)
 	cos.Close(gzr)
 	return err
 }
@@ -252,9 +271,13 @@ func (zw *zipWriter) Write(fullname string, oah cos.OAH, reader io.Reader) error
 }
 
 func (zw *zipWriter) Copy(src io.Reader, size ...int64) error {
+	return zw.CopyEdit(src, nil, size...)
+}
+
+func (zw *zipWriter) CopyEdit(src io.Reader, edits Edits, size ...int64) error {
 	r, ok := src.(io.ReaderAt)
 	debug.Assert(ok && len(size) == 1)
-	return cpZip(r, size[0], zw.zw, zw.buf)
+	return cpZip(r, size[0], zw.zw, zw.buf, edits)
 }
 
 func (*zipWriter) Flush() error { return nil }
@@ -296,8 +319,78 @@ func (lzw *lz4Writer) Write(fullname string, oah cos.OAH, reader io.Reader) erro
 }
 
 func (lzw *lz4Writer) Copy(src io.Reader, _ ...int64) error {
+	return lzw.CopyEdit(src, nil)
+}
+
+func (lzw *lz4Writer) CopyEdit(src io.Reader, edits Edits, _ ...int64) error {
 	lzr := lz4.NewReader(src)
-	return cpTar(lzr, lzw.tw.tw, lzw.tw.buf)
+	return cpTar(lzr, lzw.tw.tw, lzw.tw.buf, edits)
 }
 
 func (lzw *lz4Writer) Flush() error { return lzw.tw.Flush() }
+
+// zstWriter
+
+func (zsw *zstWriter) init(w io.Writer, cksum *cos.CksumHashSize, opts *Opts) {
+	var err error
+	zsw.tw.baseW.init(w, cksum, opts)
+	zsw.zsw, err = newZstdEncoder(zsw.tw.wmul)
+	debug.AssertNoErr(err)
+	zsw.tw.tw = tar.NewWriter(zsw.zsw)
+}
+
+func (zsw *zstWriter) Fini() error {
+	// close (and note: tar.close flushes)
+	if err := zsw.tw.Fini(); err != nil {
+		zsw.zsw.Close() // Try to close zstd anyway
+		return err
+	}
+	return zsw.zsw.Close()
+}
+
+func (zsw *zstWriter) Write(fullname string, oah cos.OAH, reader io.Reader) error {
+	return zsw.tw.Write(fullname, oah, reader)
+}
+
+func (zsw *zstWriter) Copy(src io.Reader, _ ...int64) error {
+	return zsw.CopyEdit(src, nil)
+}
+
+func (zsw *zstWriter) CopyEdit(src io.Reader, edits Edits, _ ...int64) error {
+	zsr, err := newZstdDecoder(src)
+	if err != nil {
+		return err
+	}
+	err = cpTar(zsr, zsw.tw.tw, zsw.tw.buf, edits)
+	zsr.Close()
+	return err
+}
+
+func (zsw *zstWriter) Flush() error {
+	if err := zsw.tw.Flush(); err != nil {
+		return err
+	}
+	return zsw.zsw.Flush()
+}
+
+// - fastest level, similar to gzip.BestSpeed (above)
+// - synchronous: no background goroutines
+func newZstdEncoder(w io.Writer) (*zstd.Encoder, error) {
+	return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithEncoderConcurrency(1))
+}
+
+// NewCompressor returns a writer that compresses (plain, not necessarily archived) content
+// in one of the supported formats: ExtGz, ExtLz4, or ExtZst.
+// The caller must close the returned writer to flush the compressed stream (closing does not close `w`).
+func NewCompressor(ext string, w io.Writer) (io.WriteCloser, error) {
+	switch ext {
+	case ExtGz, ExtTgz, ExtTarGz:
+		return gzip.NewWriterLevel(w, gzip.BestSpeed)
+	case ExtLz4, ExtTarLz4:
+		return lz4.NewWriter(w), nil
+	case ExtZst, ExtTarZst:
+		return newZstdEncoder(w)
+	default:
+		return nil, fmt.Errorf("unsupported compression %q (expecting one of: %s, %s, %s)", ext, ExtGz, ExtLz4, ExtZst)
+	}
+}
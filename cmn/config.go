@@ -181,7 +181,15 @@ type (
 		Conf      map[string]any `json:"-"` // backend implementation-dependent (custom marshaling to populate this field)
 		Providers map[string]Ns  `json:"-"` // conditional (build tag) providers set during validation (BackendConf.Validate)
 	}
-	BackendConfAIS map[string][]string // cluster alias -> [urls...]
+	BackendConfAIS  map[string][]string // cluster alias -> [urls...]
+	BackendConfFile struct {
+		Root string `json:"root"` // shared (e.g., NFS) directory: file://<bucket>/<object> => <root>/<bucket>/<object>
+	}
+	BackendConfHDFS struct {
+		URL  string `json:"url"`            // WebHDFS endpoint (NameNode), e.g. "http://namenode:9870"
+		User string `json:"user,omitempty"` // user.name (simple authentication)
+		Root string `json:"root,omitempty"` // hdfs://<bucket>/<object> => <root>/<bucket>/<object> (default "/")
+	}
 
 	MirrorConf struct {
 		Copies  int64 `json:"copies"`       // num copies
@@ -631,6 +639,10 @@ type (
 		// - deleting in-cluster object if its remote ("cached") counterpart does not exist
 		// See also: apc.QparamSync, apc.CopyBckMsg
 		Sync bool `json:"synchronize"`
+
+		// ais:// buckets only: overwrites and deletes retain prior versions
+		// (and deletes add delete markers) - see core/lversion and S3 ListObjectVersions
+		RetainPrior bool `json:"retain_prior"`
 	}
 	// VersionConfToSet is the partial-update counterpart of VersionConf.
 	VersionConfToSet struct {
@@ -643,6 +655,9 @@ type (
 		// Stronger form of `validate_warm_get`: additionally, delete
 		// in-cluster objects whose remote counterpart no longer exists.
 		Sync *bool `json:"synchronize,omitempty"` // +gen:optional
+		// Retain prior object versions on overwrite and delete
+		// (`ais://` buckets only).
+		RetainPrior *bool `json:"retain_prior,omitempty"` // +gen:optional
 	}
 
 	// NetConf: network configuration
@@ -1436,6 +1451,27 @@ func (c *BackendConf) Validate() (err error) {
 				}
 			}
 			c.Conf[provider] = aisConf
+		case apc.File:
+			var fileConf BackendConfFile
+			if err := jsoniter.Unmarshal(b, &fileConf); err != nil {
+				return fmt.Errorf("invalid file backend specification: %w", err)
+			}
+			if fileConf.Root == "" || !filepath.IsAbs(fileConf.Root) {
+				return fmt.Errorf("file backend: root directory must be an absolute path (have %q)", fileConf.Root)
+			}
+			fileConf.Root = filepath.Clean(fileConf.Root)
+			c.Conf[provider] = fileConf
+			c.setProvider(provider)
+		case apc.HDFS:
+			var hdfsConf BackendConfHDFS
+			if err := jsoniter.Unmarshal(b, &hdfsConf); err != nil {
+				return fmt.Errorf("invalid hdfs backend specification: %w", err)
+			}
+			if err := hdfsConf.validate(); err != nil {
+				return err
+			}
+			c.Conf[provider] = hdfsConf
+			c.setProvider(provider)
 		case "":
 			continue
 		default:
@@ -1448,7 +1484,7 @@ func (c *BackendConf) Validate() (err error) {
 func (c *BackendConf) setProvider(provider string) {
 	var ns Ns
 	switch provider {
-	case apc.AWS, apc.Azure, apc.GCP, apc.OCI, apc.HT:
+	case apc.AWS, apc.Azure, apc.GCP, apc.OCI, apc.HT, apc.File, apc.HDFS:
 		ns = NsGlobal
 	default:
 		debug.Assert(false, "unknown backend provider "+provider)
@@ -1501,6 +1537,22 @@ func (c *BackendConf) EqualRemAIS(o *BackendConf, sname string) bool {
 	return true
 }
 
+func (c *BackendConfHDFS) validate() error {
+	u, err := url.Parse(c.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("hdfs backend: invalid WebHDFS URL %q", c.URL)
+	}
+	c.URL = strings.TrimSuffix(c.URL, "/")
+	if c.Root == "" {
+		c.Root = "/"
+	}
+	if !path.IsAbs(c.Root) {
+		return fmt.Errorf("hdfs backend: root directory must be an absolute path (have %q)", c.Root)
+	}
+	c.Root = path.Clean(c.Root)
+	return nil
+}
+
 func (c BackendConfAIS) String() (s string) {
 	for a, urls := range c {
 		if s != "" {
@@ -1678,6 +1730,9 @@ func (c *VersionConf) Validate() error {
 	if !c.Enabled && c.ValidateWarmGet {
 		return errors.New("versioning.validate_warm_get requires versioning to be enabled")
 	}
+	if !c.Enabled && c.RetainPrior {
+		return errors.New("versioning.retain_prior requires versioning to be enabled")
+	}
 	return nil
 }
 
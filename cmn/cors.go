@@ -0,0 +1,168 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Bucket CORS (cross-origin resource sharing): an ordered list of rules,
+// each specifying allowed origins, methods, and request headers.
+// The first rule that matches a given (origin, method, headers) wins.
+//
+// The rules are stored in the bucket props (BMD); preflight (OPTIONS) requests
+// are handled by proxies; the corresponding response headers are added by both
+// proxies and targets.
+// S3 compatibility: PUT/GET/DELETE /s3/<bucket>?cors (see ais/s3/cors.go).
+
+const (
+	maxCORSRules  = 100       // as per S3
+	maxCORSMaxAge = 86400 * 7 // (seconds)
+)
+
+type (
+	CORSConf struct {
+		Rules []CORSRule `json:"rules,omitempty" list:"readonly"`
+	}
+	// CORSConfToSet is the partial-update counterpart of CORSConf.
+	CORSConfToSet struct {
+		// Complete list of CORS rules (replaces the current one).
+		// Empty list removes the bucket's CORS configuration.
+		Rules *[]CORSRule `json:"rules,omitempty"` // +gen:optional
+	}
+
+	CORSRule struct {
+		// Optional rule ID.
+		ID string `json:"id,omitempty"`
+		// Allowed origins, e.g. "https://example.com"; each may contain at most one '*' wildcard.
+		AllowedOrigins []string `json:"allowed_origins"`
+		// Allowed HTTP methods: GET, HEAD, PUT, POST, and/or DELETE.
+		AllowedMethods []string `json:"allowed_methods"`
+		// Request headers allowed in preflight requests; may contain '*' wildcards.
+		AllowedHeaders []string `json:"allowed_headers,omitempty"`
+		// Response headers that browsers are allowed to expose to client scripts.
+		ExposeHeaders []string `json:"expose_headers,omitempty"`
+		// Preflight response cache duration (seconds); zero means not specified.
+		MaxAgeSeconds int `json:"max_age_seconds,omitempty"`
+	}
+)
+
+//////////////
+// CORSConf //
+//////////////
+
+func (c *CORSConf) IsEmpty() bool { return len(c.Rules) == 0 }
+
+func (c *CORSConf) ValidateAsProps(...any) error {
+	if len(c.Rules) == 0 {
+		c.Rules = nil // (Bprops.Equal)
+		return nil
+	}
+	if len(c.Rules) > maxCORSRules {
+		return fmt.Errorf("invalid CORS: too many rules (%d > %d)", len(c.Rules), maxCORSRules)
+	}
+	for i := range c.Rules {
+		if err := c.Rules[i].validate(); err != nil {
+			return fmt.Errorf("invalid CORS rule #%d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// returns the first rule that allows a given cross-origin request, or nil
+// - reqHeaders: (preflight) Access-Control-Request-Headers, if any
+func (c *CORSConf) Match(origin, method string, reqHeaders []string) *CORSRule {
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if rule.matchOrigin(origin) && rule.matchMethod(method) && rule.matchHeaders(reqHeaders) {
+			return rule
+		}
+	}
+	return nil
+}
+
+//////////////
+// CORSRule //
+//////////////
+
+func (rule *CORSRule) validate() error {
+	if len(rule.AllowedOrigins) == 0 {
+		return errors.New("missing allowed origin(s)")
+	}
+	for _, origin := range rule.AllowedOrigins {
+		if origin == "" || strings.Count(origin, "*") > 1 {
+			return fmt.Errorf("invalid allowed origin %q (expecting non-empty with at most one '*' wildcard)", origin)
+		}
+	}
+	if len(rule.AllowedMethods) == 0 {
+		return errors.New("missing allowed method(s)")
+	}
+	for _, method := range rule.AllowedMethods {
+		switch method {
+		case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPost, http.MethodDelete:
+		default:
+			return fmt.Errorf("invalid allowed method %q (expecting one of: GET, HEAD, PUT, POST, DELETE)", method)
+		}
+	}
+	for _, hdr := range rule.AllowedHeaders {
+		if hdr == "" || strings.Count(hdr, "*") > 1 {
+			return fmt.Errorf("invalid allowed header %q (expecting non-empty with at most one '*' wildcard)", hdr)
+		}
+	}
+	if rule.MaxAgeSeconds < 0 || rule.MaxAgeSeconds > maxCORSMaxAge {
+		return fmt.Errorf("max-age %ds out of range [0, %d]", rule.MaxAgeSeconds, maxCORSMaxAge)
+	}
+	return nil
+}
+
+func (rule *CORSRule) matchOrigin(origin string) bool {
+	for _, pattern := range rule.AllowedOrigins {
+		if wildcardMatch(pattern, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *CORSRule) matchMethod(method string) bool {
+	for _, m := range rule.AllowedMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// (header names are case-insensitive)
+func (rule *CORSRule) matchHeaders(reqHeaders []string) bool {
+outer:
+	for _, hdr := range reqHeaders {
+		hdr = strings.ToLower(strings.TrimSpace(hdr))
+		if hdr == "" {
+			continue
+		}
+		for _, pattern := range rule.AllowedHeaders {
+			if wildcardMatch(strings.ToLower(pattern), hdr) {
+				continue outer
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// at most one '*' that matches any (possibly empty) substring
+func wildcardMatch(pattern, s string) bool {
+	i := strings.IndexByte(pattern, '*')
+	if i < 0 {
+		return pattern == s
+	}
+	prefix, suffix := pattern[:i], pattern[i+1:]
+	return len(s) >= len(prefix)+len(suffix) && strings.HasPrefix(s, prefix) && strings.HasSuffix(s, suffix)
+}
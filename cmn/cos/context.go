@@ -20,4 +20,7 @@ const (
 	CtxReadWrapper contextID = "readWrapper" // context key for ReadWrapperFunc
 	CtxSetSize     contextID = "setSize"     // context key for SetSizeFunc
 	CtxOriginalURL contextID = "origURL"     // context key for OriginalURL for HTTP cloud
+
+	CtxBackendEndpoint contextID = "backendEndpoint" // context key for equivalent endpoint (*cmn.BackendEndpoint)
+	CtxRemAisHeader    contextID = "remAisHeader"    // context key for additional remote-AIS request header (http.Header)
 )
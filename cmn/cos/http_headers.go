@@ -51,8 +51,24 @@ const (
 	HdrServer    = "Server"
 	HdrETag      = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
 
+	// conditional requests, Ref: https://www.rfc-editor.org/rfc/rfc9110#section-13.1
+	HdrIfMatch     = "If-Match"
+	HdrIfNoneMatch = "If-None-Match"
+
 	HdrHSTS = "Strict-Transport-Security"
 
+	// CORS, Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/CORS
+	HdrOrigin             = "Origin"
+	HdrVary               = "Vary"
+	HdrACRequestMethod    = "Access-Control-Request-Method"
+	HdrACRequestHeaders   = "Access-Control-Request-Headers"
+	HdrACAllowOrigin      = "Access-Control-Allow-Origin"
+	HdrACAllowMethods     = "Access-Control-Allow-Methods"
+	HdrACAllowHeaders     = "Access-Control-Allow-Headers"
+	HdrACExposeHeaders    = "Access-Control-Expose-Headers"
+	HdrACMaxAge           = "Access-Control-Max-Age"
+	HdrACAllowCredentials = "Access-Control-Allow-Credentials"
+
 	// RFC1123GMT or, same, http.TimeFormat ("Mon, 02 Jan 2006 15:04:05 GMT")
 	// see also, and separately, cmn.LsoLastModified (list-objects)
 	HdrLastModified = "Last-Modified"
@@ -74,8 +90,30 @@ const (
 	S3CksumHeader   = HdrETag
 	S3VersionHeader = "x-amz-version-id"
 
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/DeleteMarker.html
+	S3HdrDeleteMarker = "x-amz-delete-marker"
+
 	// s3 api request headers
-	S3HdrObjSrc = "x-amz-copy-source"
+	S3HdrObjSrc      = "x-amz-copy-source"
+	S3HdrObjSrcRange = "x-amz-copy-source-range" // UploadPartCopy
+
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-tagging.html
+	S3HdrTagging      = "x-amz-tagging"
+	S3HdrTaggingCount = "x-amz-tagging-count"
+
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/UsingServerSideEncryption.html
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/ServerSideEncryptionCustomerKeys.html
+	S3HdrSSE        = "x-amz-server-side-encryption"
+	S3HdrSSECAlgo   = "x-amz-server-side-encryption-customer-algorithm"
+	S3HdrSSECKey    = "x-amz-server-side-encryption-customer-key"
+	S3HdrSSECKeyMD5 = "x-amz-server-side-encryption-customer-key-MD5"
+
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-lock.html
+	S3HdrBckObjLock       = "x-amz-bucket-object-lock-enabled"
+	S3HdrObjLockMode      = "x-amz-object-lock-mode"
+	S3HdrObjLockUntil     = "x-amz-object-lock-retain-until-date"
+	S3HdrObjLegalHold     = "x-amz-object-lock-legal-hold"
+	S3HdrBypassGovernance = "x-amz-bypass-governance-retention"
 
 	// https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
 	S3UnsignedPayload  = "UNSIGNED-PAYLOAD"
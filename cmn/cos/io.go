@@ -6,6 +6,7 @@ package cos
 
 import (
 	"bytes"
+	"errors"
 	"io"
 	"math"
 	"os"
@@ -349,6 +350,38 @@ func (f *SectionHandle) Read(buf []byte) (n int, err error) {
 	return n, io.EOF
 }
 
+// Seek within the section followed by its (zero) padding, if any
+// usage: ranged reads of archived files located via shard index (see core/shard_idx)
+func (f *SectionHandle) Seek(offset int64, whence int) (int64, error) {
+	if f.padding == 0 {
+		return f.s.Seek(offset, whence)
+	}
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		cur, err := f.s.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, err
+		}
+		abs = cur + f.padOffset + offset
+	case io.SeekEnd:
+		abs = f.size + f.padding + offset
+	default:
+		return 0, errors.New("section-handle: invalid whence")
+	}
+	if abs < 0 {
+		return 0, errors.New("section-handle: negative position")
+	}
+	// position within the section, and within the padding
+	if _, err := f.s.Seek(min(abs, f.size), io.SeekStart); err != nil {
+		return 0, err
+	}
+	f.padOffset = min(max(abs-f.size, 0), f.padding)
+	return abs, nil
+}
+
 func (*SectionHandle) Close() error { return nil }
 
 func (f *SectionHandle) Size() int64 { return f.size }
@@ -268,4 +268,70 @@ var _ = Describe("I/O utils", func() {
 			Expect(strings.Contains(err.Error(), "no such file")).To(BeTrue())
 		})
 	})
+
+	Describe("SectionHandle", func() {
+		It("should seek within the section", func() {
+			sh := cos.NewSectionHandle(strings.NewReader(testContent), 10, 20, 0)
+
+			pos, err := sh.Seek(5, io.SeekStart)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(pos).To(Equal(int64(5)))
+			b, err := io.ReadAll(sh)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(b)).To(Equal(testContent[15:30]))
+
+			pos, err = sh.Seek(-3, io.SeekEnd)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(pos).To(Equal(int64(17)))
+			b, err = io.ReadAll(sh)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(b)).To(Equal(testContent[27:30]))
+		})
+
+		It("should seek within the section followed by padding", func() {
+			const padding = 4
+			zeros := strings.Repeat("\x00", padding)
+
+			for _, tc := range []struct {
+				offset   int64
+				whence   int
+				pos      int64
+				expected string
+			}{
+				{0, io.SeekStart, 0, testContent[10:20] + zeros},
+				{7, io.SeekStart, 7, testContent[17:20] + zeros},
+				{10, io.SeekStart, 10, zeros},
+				{12, io.SeekStart, 12, zeros[2:]},
+				{14, io.SeekStart, 14, ""},
+				{-6, io.SeekEnd, 8, testContent[18:20] + zeros},
+				{-1, io.SeekEnd, 13, zeros[3:]},
+			} {
+				sh := cos.NewSectionHandle(strings.NewReader(testContent), 10, 10, padding)
+
+				// read some first, to make sure seeking resets both section and padding offsets
+				_, err := io.ReadAll(sh)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				pos, err := sh.Seek(tc.offset, tc.whence)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pos).To(Equal(tc.pos))
+				b, err := io.ReadAll(sh)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(string(b)).To(Equal(tc.expected), "seek(%d, %d)", tc.offset, tc.whence)
+			}
+
+			sh := cos.NewSectionHandle(strings.NewReader(testContent), 10, 10, padding)
+			_, err := sh.Seek(12, io.SeekStart)
+			Expect(err).ShouldNot(HaveOccurred())
+			pos, err := sh.Seek(-4, io.SeekCurrent)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(pos).To(Equal(int64(8)))
+			b, err := io.ReadAll(sh)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(b)).To(Equal(testContent[18:20] + zeros))
+
+			_, err = sh.Seek(-1, io.SeekStart)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
 })
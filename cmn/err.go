@@ -256,6 +256,10 @@ type (
 	}
 	ErrRateLimitFrontend ErrTooManyRequests // to differentiate by tcode
 
+	ErrPrecondFailed struct {
+		cname, cond string
+	}
+
 	ErrCreateHreq struct {
 		err error // original
 	}
@@ -1049,6 +1053,21 @@ func IsErrTooManyRequests(err error) bool {
 	return errors.As(err, &wrapped)
 }
 
+// ErrPrecondFailed (412)
+
+func NewErrPrecondFailed(cname, cond string) *ErrPrecondFailed {
+	return &ErrPrecondFailed{cname: cname, cond: cond}
+}
+
+func (e *ErrPrecondFailed) Error() string {
+	return fmt.Sprintf("%s: precondition failed (%s)", e.cname, e.cond)
+}
+
+func IsErrPrecondFailed(err error) bool {
+	var e *ErrPrecondFailed
+	return errors.As(err, &e)
+}
+
 func NewErrRateLimitFrontend() *ErrRateLimitFrontend {
 	return &ErrRateLimitFrontend{
 		err:    errors.New(http.StatusText(http.StatusTooManyRequests)),
@@ -1381,6 +1400,10 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, opts ...int /*[
 			status = http.StatusConflict
 		case IsErrTooManyRequests(err):
 			status = http.StatusTooManyRequests
+		case IsErrObjLocked(err):
+			status = http.StatusForbidden
+		case IsErrPrecondFailed(err):
+			status = http.StatusPreconditionFailed
 		}
 	}
 
@@ -61,3 +61,6 @@ const (
 	NodeRestartedMarker = "node_restarted"
 	NodeRestartedPrev   = "node_restarted.prev"
 )
+
+// bucket replication journals (per mountpath; see xact/xs/repl)
+const ReplDir = ".ais.repl"
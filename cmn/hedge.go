@@ -0,0 +1,141 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+)
+
+// Cold GET hedging and failover (remote buckets).
+//
+// A bucket may list equivalent endpoints - other regions, mirrors, replicas - in
+// the order of preference. When enabled, a cold GET that takes longer than the
+// configured percentile of the bucket's recent cold GET latencies triggers a second
+// (hedged) request to the next endpoint (or, if none are listed, to the same one),
+// and whichever responds first wins. Independently, a cold GET that fails with 5xx
+// (or 429, or a network error) fails over to the next endpoint.
+// Alternative endpoints are supported by the aws:// backend (including S3-compatible
+// stores), while hedging with the same endpoint works with all cloud backends.
+// See also: ais/rlhedge.
+
+const (
+	hedgeMaxEndpoints = 8
+	hedgeMinDelay     = time.Millisecond
+)
+
+type (
+	HedgeConf struct {
+		// Equivalent endpoints in the order of preference (the bucket's own endpoint always comes first).
+		Endpoints []BackendEndpoint `json:"endpoints,omitempty"`
+		// Percentile of recent cold GET latencies that triggers hedged request (0 - no hedging, failover only).
+		Percentile int `json:"percentile,omitempty"`
+		// Hedging delay lower bound; also used until enough latencies have been sampled.
+		MinDelay cos.Duration `json:"min_delay,omitempty"`
+		Enabled  bool         `json:"enabled"`
+	}
+	// HedgeConfToSet is the partial-update counterpart of HedgeConf.
+	HedgeConfToSet struct {
+		// Complete list of equivalent endpoints (replaces the current one).
+		Endpoints *[]BackendEndpoint `json:"endpoints,omitempty"` // +gen:optional
+		// Percentile (1 to 99) of recent cold GET latencies after
+		// which to issue a hedged request; `0` disables hedging
+		// (failover only).
+		Percentile *int `json:"percentile,omitempty"` // +gen:optional
+		// Hedging delay lower bound (e.g. `"50ms"`).
+		MinDelay *cos.Duration `json:"min_delay,omitempty"` // +gen:optional
+		// Enable hedging and failover for this bucket.
+		Enabled *bool `json:"enabled,omitempty"` // +gen:optional
+	}
+
+	// equivalent (alternative) location of a given remote bucket
+	BackendEndpoint struct {
+		// Bucket name at this endpoint; empty means same name.
+		Bucket string `json:"bucket,omitempty"`
+		// Endpoint URL, e.g. "https://s3.us-west-2.amazonaws.com"; empty means default.
+		Endpoint string `json:"endpoint,omitempty"`
+		// Region; empty means the bucket's own.
+		Region string `json:"region,omitempty"`
+	}
+)
+
+///////////////
+// HedgeConf //
+///////////////
+
+// (provider: the bucket's backend provider)
+func (c *HedgeConf) ValidateAsProps(arg ...any) error {
+	if !c.Enabled {
+		return nil
+	}
+	provider, ok := arg[0].(string)
+	debug.Assert(ok)
+	if c.Percentile < 0 || c.Percentile > 99 {
+		return fmt.Errorf("invalid hedge.percentile %d (expecting 0 (failover only) or range 1..99)", c.Percentile)
+	}
+	if c.MinDelay < 0 {
+		return fmt.Errorf("invalid hedge.min_delay %v", c.MinDelay)
+	}
+	if c.Percentile == 0 && len(c.Endpoints) == 0 {
+		return errors.New("invalid hedge: requires percentile and/or (failover) endpoints")
+	}
+	if len(c.Endpoints) > hedgeMaxEndpoints {
+		return fmt.Errorf("invalid hedge.endpoints: too many (%d > %d)", len(c.Endpoints), hedgeMaxEndpoints)
+	}
+	if len(c.Endpoints) > 0 && provider != apc.AWS {
+		return fmt.Errorf("invalid hedge.endpoints: alternative endpoints are not supported by %q backend", provider)
+	}
+	for i := range c.Endpoints {
+		if err := c.Endpoints[i].validate(); err != nil {
+			return fmt.Errorf("invalid hedge.endpoints[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *HedgeConf) Delay() time.Duration { return max(c.MinDelay.D(), hedgeMinDelay) }
+
+/////////////////////
+// BackendEndpoint //
+/////////////////////
+
+func (ep *BackendEndpoint) validate() error {
+	if ep.Bucket == "" && ep.Endpoint == "" && ep.Region == "" {
+		return errors.New("empty")
+	}
+	if ep.Bucket != "" {
+		if err := cos.CheckAlphaPlus(ep.Bucket, "bucket name"); err != nil {
+			return err
+		}
+	}
+	if ep.Endpoint != "" {
+		u, err := url.Parse(ep.Endpoint)
+		if err != nil {
+			return err
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("endpoint %q: expecting http(s)://host[:port]", ep.Endpoint)
+		}
+	}
+	return nil
+}
+
+func (ep *BackendEndpoint) String() string {
+	s := ep.Endpoint
+	if ep.Region != "" {
+		s += "[" + ep.Region + "]"
+	}
+	if ep.Bucket != "" {
+		s += "/" + ep.Bucket
+	}
+	return s
+}
@@ -0,0 +1,168 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+)
+
+// Bucket lifecycle: a (small) ordered list of rules, each scoped to an object
+// name prefix and specifying:
+// - expiration: remove objects older than `ExpirationDays` (based on last-modified)
+// - abort-incomplete: abort multipart uploads initiated more than `AbortMptDays` ago
+//
+// The rules are stored in the bucket props (BMD) and executed by targets,
+// periodically (see ais/tgtlifecycle.go and xact/xs/lifecycle.go).
+// S3 compatibility: PUT/GET/DELETE /s3/<bucket>?lifecycle (see ais/s3/lifecycle.go).
+
+const (
+	maxLifecycleRules  = 1000 // as per S3
+	maxLifecycleIDLen  = 255  // ditto
+	maxLifecycleNumDay = 100 * 365
+
+	lifecycleDay = 24 * time.Hour
+)
+
+type (
+	LifecycleConf struct {
+		Rules []LifecycleRule `json:"rules,omitempty" list:"readonly"`
+	}
+	// LifecycleConfToSet is the partial-update counterpart of LifecycleConf.
+	LifecycleConfToSet struct {
+		// Complete list of lifecycle rules (replaces the current one).
+		// Empty list removes the bucket's lifecycle configuration.
+		Rules *[]LifecycleRule `json:"rules,omitempty"` // +gen:optional
+	}
+
+	LifecycleRule struct {
+		// Optional rule ID (unique within a bucket).
+		ID string `json:"id,omitempty"`
+		// Object name prefix the rule applies to; empty means the entire bucket.
+		Prefix string `json:"prefix,omitempty"`
+		// Remove objects that were last modified more than the specified number of days ago.
+		// Zero means no expiration.
+		ExpirationDays int `json:"expiration_days,omitempty"`
+		// Abort incomplete multipart uploads initiated more than the specified number of days ago.
+		// Zero means no action.
+		AbortMptDays int `json:"abort_mpt_days,omitempty"`
+		// Disabled rules are stored but not executed.
+		Enabled bool `json:"enabled"`
+	}
+)
+
+///////////////////
+// LifecycleConf //
+///////////////////
+
+func (c *LifecycleConf) IsEmpty() bool { return len(c.Rules) == 0 }
+
+// object expiration visits locally stored objects and, therefore, is limited to ais:// buckets
+// with no remote backend; aborting stale multipart uploads is supported for all buckets
+func (c *LifecycleConf) ValidateAsProps(arg ...any) error {
+	if len(c.Rules) == 0 {
+		c.Rules = nil // (Bprops.Equal)
+		return nil
+	}
+	if len(c.Rules) > maxLifecycleRules {
+		return fmt.Errorf("invalid lifecycle: too many rules (%d > %d)", len(c.Rules), maxLifecycleRules)
+	}
+	ids := make(cos.StrSet, len(c.Rules))
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf("invalid lifecycle rule #%d: %v", i, err)
+		}
+		if rule.ID == "" {
+			continue
+		}
+		if ids.Contains(rule.ID) {
+			return fmt.Errorf("invalid lifecycle: duplicate rule ID %q", rule.ID)
+		}
+		ids.Add(rule.ID)
+	}
+	if !c.Expires() {
+		return nil
+	}
+	provider, ok := arg[0].(string)
+	debug.Assert(ok)
+	hasBackend, ok := arg[1].(bool)
+	debug.Assert(ok)
+	if provider != apc.AIS || hasBackend {
+		return errors.New("invalid lifecycle: object expiration requires ais:// bucket (with no backend)")
+	}
+	return nil
+}
+
+// returns enabled rules that match a given object name
+func (c *LifecycleConf) Match(objName string) (rules []*LifecycleRule) {
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if rule.Enabled && strings.HasPrefix(objName, rule.Prefix) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// true if there's at least one enabled rule that expires objects
+func (c *LifecycleConf) Expires() bool {
+	for i := range c.Rules {
+		if rule := &c.Rules[i]; rule.Enabled && rule.ExpirationDays > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// true if there's at least one enabled rule that aborts incomplete multipart uploads
+func (c *LifecycleConf) AbortsMpt() bool {
+	for i := range c.Rules {
+		if rule := &c.Rules[i]; rule.Enabled && rule.AbortMptDays > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+///////////////////
+// LifecycleRule //
+///////////////////
+
+func (rule *LifecycleRule) validate() error {
+	if len(rule.ID) > maxLifecycleIDLen {
+		return fmt.Errorf("ID too long (%d > %d)", len(rule.ID), maxLifecycleIDLen)
+	}
+	if rule.Prefix != "" {
+		if err := cos.ValidatePrefix("lifecycle prefix", rule.Prefix); err != nil {
+			return err
+		}
+	}
+	if rule.ExpirationDays < 0 || rule.ExpirationDays > maxLifecycleNumDay {
+		return fmt.Errorf("expiration days %d out of range [0, %d]", rule.ExpirationDays, maxLifecycleNumDay)
+	}
+	if rule.AbortMptDays < 0 || rule.AbortMptDays > maxLifecycleNumDay {
+		return fmt.Errorf("abort-incomplete-upload days %d out of range [0, %d]", rule.AbortMptDays, maxLifecycleNumDay)
+	}
+	if rule.ExpirationDays == 0 && rule.AbortMptDays == 0 {
+		return fmt.Errorf("rule %q specifies no action (expecting expiration and/or abort-incomplete-upload days)", rule.ID)
+	}
+	return nil
+}
+
+func (rule *LifecycleRule) Expired(mtime, now time.Time) bool {
+	return rule.ExpirationDays > 0 && now.Sub(mtime) > time.Duration(rule.ExpirationDays)*lifecycleDay
+}
+
+func (rule *LifecycleRule) StaleMpt(created, now time.Time) bool {
+	return rule.AbortMptDays > 0 && now.Sub(created) > time.Duration(rule.AbortMptDays)*lifecycleDay
+}
@@ -0,0 +1,59 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Listing cache (remote buckets).
+//
+// When enabled, the target that pages through a remote bucket's list-objects result
+// keeps the (raw) backend pages in memory and serves repeated listings from there.
+// Cached pages are invalidated upon change notifications - S3 event notifications and
+// GCS Pub/Sub messages - posted to the cluster's (proxy's) apc.URLPathEvents endpoint,
+// as well as upon the cluster's own writes, deletes, and renames of remote objects;
+// and in any case expire after the configured TTL.
+// See also: xact/xs/lsocache, ais/prxevents.
+
+const (
+	LsoCacheDfltTTL = 10 * time.Minute
+	lsoCacheMaxTTL  = 7 * 24 * time.Hour
+)
+
+type (
+	LsoCacheConf struct {
+		// Max age of a cached page (0 - default); change notifications invalidate cached pages sooner.
+		TTL     cos.Duration `json:"ttl,omitempty"`
+		Enabled bool         `json:"enabled"`
+	}
+	// LsoCacheConfToSet is the partial-update counterpart of LsoCacheConf.
+	LsoCacheConfToSet struct {
+		// Max age of a cached list-objects page (e.g. `"1h"`); `0`
+		// selects the default: `10m`. Change notifications invalidate
+		// cached pages regardless.
+		TTL *cos.Duration `json:"ttl,omitempty"` // +gen:optional
+		// Enable listing cache for this (remote) bucket.
+		Enabled *bool `json:"enabled,omitempty"` // +gen:optional
+	}
+)
+
+func (c *LsoCacheConf) ValidateAsProps(...any) error {
+	if ttl := c.TTL.D(); ttl < 0 || ttl > lsoCacheMaxTTL {
+		return fmt.Errorf("invalid lso_cache.ttl %v (expecting 0 (default) or up to %v)", ttl, lsoCacheMaxTTL)
+	}
+	return nil
+}
+
+func (c *LsoCacheConf) MaxAge() time.Duration {
+	if c.TTL > 0 {
+		return c.TTL.D()
+	}
+	return LsoCacheDfltTTL
+}
@@ -40,8 +40,20 @@ const (
 	// see also, and separately, cos.HdrLastModified: RFC1123GMT / (HTTP header semantics)
 	LsoLastModified = "LastModified"
 
+	// LsoNumRows: number of rows in a given Parquet row group or Arrow record batch
+	// (list-objects with apc.LsArchDir; see cmn/tabular)
+	LsoNumRows = "NumRows"
+
 	// as the name implies
 	OrigFntl = "orig_fntl"
+
+	// S3 server-side encryption (mode); the content is stored as ciphertext (see ais/s3/sse.go)
+	SSEObjMD = "s3-sse"
+
+	// object lock (see cmn/objlock)
+	ObjLockModeMD  = "lock_mode"  // GOVERNANCE | COMPLIANCE
+	ObjLockUntilMD = "lock_until" // retain-until date (RFC3339)
+	ObjLegalHoldMD = "legal_hold" // ON (otherwise, not present)
 )
 
 const (
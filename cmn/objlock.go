@@ -0,0 +1,145 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Object Lock (WORM - write once, read many): ais:// buckets only.
+//
+// Once enabled for a given bucket, object lock cannot be disabled. Individual objects
+// can then be protected by:
+// - retention: mode (GOVERNANCE | COMPLIANCE) and retain-until date;
+// - legal hold: ON | OFF, independently of retention.
+// Locked objects cannot be overwritten, deleted, evicted, or renamed - with a single
+// exception: GOVERNANCE retention can be bypassed by a user with the corresponding
+// permission (x-amz-bypass-governance-retention: true).
+//
+// The bucket-level configuration may also specify default retention that applies
+// to newly written objects.
+// Object-level retention and legal hold are stored in the object's custom metadata
+// (see ObjLockModeMD et al., and core/lworm.go).
+// S3 compatibility: PUT/GET /s3/<bucket>?object-lock and
+// PUT/GET /s3/<bucket>/<object>?retention|legal-hold (see ais/s3/objlock.go).
+
+const (
+	ObjLockGovernance = "GOVERNANCE"
+	ObjLockCompliance = "COMPLIANCE"
+
+	LegalHoldOn  = "ON"
+	LegalHoldOff = "OFF"
+
+	maxObjLockDays  = 100 * 365
+	maxObjLockYears = 100
+)
+
+type (
+	ObjLockConf struct {
+		// Default retention mode: GOVERNANCE or COMPLIANCE; empty means no default retention.
+		Mode string `json:"mode,omitempty"`
+		// Default retention period: days or years (mutually exclusive).
+		Days  int `json:"days,omitempty"`
+		Years int `json:"years,omitempty"`
+		// Enabled object lock cannot be disabled.
+		Enabled bool `json:"enabled"`
+	}
+	// ObjLockConfToSet is the partial-update counterpart of ObjLockConf.
+	ObjLockConfToSet struct {
+		Mode    *string `json:"mode,omitempty"`    // +gen:optional
+		Days    *int    `json:"days,omitempty"`    // +gen:optional
+		Years   *int    `json:"years,omitempty"`   // +gen:optional
+		Enabled *bool   `json:"enabled,omitempty"` // +gen:optional
+	}
+
+	ErrObjLocked struct {
+		until  time.Time
+		cname  string
+		reason string
+	}
+)
+
+/////////////////
+// ObjLockConf //
+/////////////////
+
+func (c *ObjLockConf) ValidateAsProps(...any) error {
+	if !c.Enabled {
+		if c.Mode != "" || c.Days != 0 || c.Years != 0 {
+			return errors.New("invalid object lock: default retention requires object lock to be enabled")
+		}
+		return nil
+	}
+	if c.Mode == "" {
+		if c.Days != 0 || c.Years != 0 {
+			return errors.New("invalid object lock: default retention period requires retention mode")
+		}
+		return nil
+	}
+	if err := ValidateRetentionMode(c.Mode); err != nil {
+		return fmt.Errorf("invalid object lock: %v", err)
+	}
+	switch {
+	case c.Days != 0 && c.Years != 0:
+		return errors.New("invalid object lock: default retention days and years are mutually exclusive")
+	case c.Days == 0 && c.Years == 0:
+		return errors.New("invalid object lock: default retention requires either days or years")
+	case c.Days < 0 || c.Days > maxObjLockDays:
+		return fmt.Errorf("invalid object lock: retention days %d out of range [1, %d]", c.Days, maxObjLockDays)
+	case c.Years < 0 || c.Years > maxObjLockYears:
+		return fmt.Errorf("invalid object lock: retention years %d out of range [1, %d]", c.Years, maxObjLockYears)
+	}
+	return nil
+}
+
+// returns default retention (mode, retain-until) for an object written at a given time;
+// empty mode means no default retention
+func (c *ObjLockConf) DefaultRetention(now time.Time) (string, time.Time) {
+	if !c.Enabled || c.Mode == "" {
+		return "", time.Time{}
+	}
+	if c.Years > 0 {
+		return c.Mode, now.AddDate(c.Years, 0, 0)
+	}
+	return c.Mode, now.AddDate(0, 0, c.Days)
+}
+
+func ValidateRetentionMode(mode string) error {
+	if mode != ObjLockGovernance && mode != ObjLockCompliance {
+		return fmt.Errorf("invalid retention mode %q (expecting %s or %s)", mode, ObjLockGovernance, ObjLockCompliance)
+	}
+	return nil
+}
+
+func ValidateLegalHold(status string) error {
+	if status != LegalHoldOn && status != LegalHoldOff {
+		return fmt.Errorf("invalid legal hold status %q (expecting %s or %s)", status, LegalHoldOn, LegalHoldOff)
+	}
+	return nil
+}
+
+//////////////////
+// ErrObjLocked //
+//////////////////
+
+func NewErrObjLocked(cname, reason string, until time.Time) *ErrObjLocked {
+	return &ErrObjLocked{cname: cname, reason: reason, until: until}
+}
+
+func (e *ErrObjLocked) Error() string {
+	if e.until.IsZero() {
+		return fmt.Sprintf("%s is write-protected by object lock (%s)", e.cname, e.reason)
+	}
+	return fmt.Sprintf("%s is write-protected by object lock (%s retention until %s)",
+		e.cname, e.reason, e.until.UTC().Format(time.RFC3339))
+}
+
+func IsErrObjLocked(err error) bool {
+	var e *ErrObjLocked
+	return errors.As(err, &e)
+}
@@ -0,0 +1,202 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+// Bucket policy: a practical subset of S3 (IAM) bucket policy, evaluated by proxies
+// on top of the AIS access model (see ais/prxauth.go):
+// - an explicit Deny that matches a request always wins;
+// - otherwise, Allow statements that (together) cover all requested permissions grant access,
+//   including anonymous access when the statement's principal is "*";
+// - otherwise, the regular AIS checks (AuthN token and bucket access attributes) apply.
+//
+// Principals are AIS (AuthN) user names, or "*" for anyone, including unauthenticated clients.
+// Each statement applies to the bucket itself and/or to objects matching name patterns
+// ("*", "prefix/*", or exact object name).
+// S3 compatibility: PUT/GET/DELETE /s3/<bucket>?policy (see ais/s3/policy.go).
+
+const (
+	PolicyAllow = "Allow"
+	PolicyDeny  = "Deny"
+
+	PolicyAnyone = "*"
+
+	maxPolicyStatements = 100
+)
+
+// object-level permissions (all the rest are bucket-level)
+const aceObjLevel = apc.AceGET | apc.AceObjHEAD | apc.AcePUT | apc.AceAPPEND | apc.AceObjDELETE |
+	apc.AceObjMOVE | apc.AcePromote | apc.AceObjUpdate
+
+type (
+	PolicyConf struct {
+		Statements []PolicyStatement `json:"statements,omitempty" list:"readonly"`
+	}
+	// PolicyConfToSet is the partial-update counterpart of PolicyConf.
+	PolicyConfToSet struct {
+		// Complete list of policy statements (replaces the current one).
+		// Empty list removes the bucket's policy.
+		Statements *[]PolicyStatement `json:"statements,omitempty"` // +gen:optional
+	}
+
+	PolicyStatement struct {
+		// Optional statement ID.
+		Sid string `json:"sid,omitempty"`
+		// Allow or Deny.
+		Effect string `json:"effect"`
+		// AIS user names and/or "*" (anyone).
+		Principals []string `json:"principals"`
+		// S3 actions as specified by the user (e.g. "s3:GetObject"); kept for round-trip.
+		Actions []string `json:"actions"`
+		// Permissions that correspond to the actions.
+		Access apc.AccessAttrs `json:"access,string"`
+		// True if the statement applies to the bucket itself (e.g., list objects).
+		Bucket bool `json:"bucket,omitempty"`
+		// Object name patterns: "*", "prefix/*", or exact name.
+		Objects []string `json:"objects,omitempty"`
+	}
+)
+
+////////////////
+// PolicyConf //
+////////////////
+
+func (c *PolicyConf) IsEmpty() bool { return len(c.Statements) == 0 }
+
+func (c *PolicyConf) ValidateAsProps(...any) error {
+	if len(c.Statements) == 0 {
+		c.Statements = nil // (Bprops.Equal)
+		return nil
+	}
+	if len(c.Statements) > maxPolicyStatements {
+		return fmt.Errorf("invalid policy: too many statements (%d > %d)", len(c.Statements), maxPolicyStatements)
+	}
+	for i := range c.Statements {
+		if err := c.Statements[i].validate(); err != nil {
+			return fmt.Errorf("invalid policy statement #%d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// Evaluate a request by a given principal (empty if anonymous) to access a given object
+// (empty when not known or not applicable).
+// Returns (allowed, denied) - both false if the policy has no say.
+// Note: when the object name is not known, object-pattern Deny statements apply conservatively.
+func (c *PolicyConf) Evaluate(principal, objName string, ace apc.AccessAttrs) (allowed, denied bool) {
+	return c.evaluate(principal, objName, false /*is prefix*/, ace)
+}
+
+// Same as above for (list and multi-object) operations on all objects with a given prefix:
+// Deny applies if any of those objects may match, Allow - only if all of them do.
+func (c *PolicyConf) EvaluatePrefix(principal, prefix string, ace apc.AccessAttrs) (allowed, denied bool) {
+	return c.evaluate(principal, prefix, true, ace)
+}
+
+func (c *PolicyConf) evaluate(principal, name string, isPrefix bool, ace apc.AccessAttrs) (allowed, denied bool) {
+	var granted apc.AccessAttrs
+	for i := range c.Statements {
+		stmt := &c.Statements[i]
+		if stmt.Access&ace == 0 || !stmt.matchPrincipal(principal) {
+			continue
+		}
+		if stmt.Effect == PolicyDeny {
+			if stmt.applies(name, isPrefix, ace, true /*conservative*/) {
+				return false, true
+			}
+			continue
+		}
+		if stmt.applies(name, isPrefix, ace, false) {
+			granted |= stmt.Access & ace
+		}
+	}
+	return ace != 0 && granted == ace, false
+}
+
+/////////////////////
+// PolicyStatement //
+/////////////////////
+
+func (stmt *PolicyStatement) validate() error {
+	if stmt.Effect != PolicyAllow && stmt.Effect != PolicyDeny {
+		return fmt.Errorf("invalid effect %q (expecting %q or %q)", stmt.Effect, PolicyAllow, PolicyDeny)
+	}
+	if len(stmt.Principals) == 0 {
+		return errors.New("missing principal(s)")
+	}
+	for _, p := range stmt.Principals {
+		if p == "" {
+			return errors.New("empty principal")
+		}
+	}
+	if stmt.Access == apc.AccessNone {
+		return errors.New("missing action(s)")
+	}
+	if !stmt.Bucket && len(stmt.Objects) == 0 {
+		return errors.New("missing resource(s)")
+	}
+	for _, pattern := range stmt.Objects {
+		if pattern == "" {
+			return errors.New("empty object resource")
+		}
+		if i := strings.IndexByte(pattern, '*'); i >= 0 && i != len(pattern)-1 {
+			return fmt.Errorf("invalid object resource %q (wildcard is only supported at the end)", pattern)
+		}
+	}
+	return nil
+}
+
+func (stmt *PolicyStatement) matchPrincipal(principal string) bool {
+	for _, p := range stmt.Principals {
+		if p == PolicyAnyone || (principal != "" && p == principal) {
+			return true
+		}
+	}
+	return false
+}
+
+// whether the statement's resources cover the requested access;
+// conservative: (Deny) applies when the object(s) may match
+func (stmt *PolicyStatement) applies(name string, isPrefix bool, ace apc.AccessAttrs, conservative bool) bool {
+	if ace&^aceObjLevel != 0 && stmt.Bucket {
+		return true
+	}
+	if ace&aceObjLevel == 0 {
+		return false
+	}
+	for _, pattern := range stmt.Objects {
+		switch {
+		case pattern == "*":
+			return true
+		case isPrefix:
+			base, wildcard := strings.CutSuffix(pattern, "*")
+			if wildcard && strings.HasPrefix(name, base) {
+				return true // all objects with the prefix
+			}
+			if conservative && strings.HasPrefix(base, name) {
+				return true // some of them
+			}
+		case name == "":
+			if conservative {
+				return true
+			}
+		case strings.HasSuffix(pattern, "*"):
+			if strings.HasPrefix(name, pattern[:len(pattern)-1]) {
+				return true
+			}
+		case pattern == name:
+			return true
+		}
+	}
+	return false
+}
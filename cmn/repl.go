@@ -0,0 +1,103 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/debug"
+)
+
+// Bucket replication: asynchronously push PUTs and DELETEs on a given ais:// bucket
+// to a bucket in an attached remote AIS cluster (see `ais cluster remote-attach`).
+// Configured on both sides, replication is bidirectional.
+// Conflicts (the same object updated on both sides) are resolved by
+// - "mtime":   last writer wins, based on the time of the original write or delete (default)
+// - "version": object with more writes (and deletes) in its replicated history wins
+//              (see ReplVerObjMD); otherwise (same or unknown), as per "mtime"
+// See also: xact/xs/repl.
+
+const (
+	ReplConflictMtime   = "mtime" // (default)
+	ReplConflictVersion = "version"
+)
+
+// object custom metadata: the original (local) write - time, cluster, and replicated version
+// (that, unlike the object's own version, is the same on both sides);
+// is carried over with the replicated object
+const (
+	ReplMtimeObjMD = "repl.mtime" // unix nanoseconds
+	ReplSrcObjMD   = "repl.src"   // cluster UUID
+	ReplVerObjMD   = "repl.ver"   // number of writes and deletes
+)
+
+const (
+	ReplDfltWorkers = 16
+	replMaxWorkers  = 256
+)
+
+type (
+	ReplConf struct {
+		// Destination: bucket in an attached remote AIS cluster, e.g. ais://@remais/bucket.
+		Dst Bck `json:"dst"`
+		// Conflict resolution (see ReplConflict* enum); empty means "mtime".
+		Conflict string `json:"conflict,omitempty"`
+		// Number of concurrent PUTs and DELETEs to the destination (0 - default).
+		Workers int  `json:"workers,omitempty"`
+		Enabled bool `json:"enabled"`
+	}
+	// ReplConfToSet is the partial-update counterpart of ReplConf.
+	ReplConfToSet struct {
+		// Destination bucket in an attached remote AIS cluster
+		// (e.g. `ais://@remais/bucket`).
+		Dst *Bck `json:"dst,omitempty"` // +gen:optional
+		// Conflict resolution: `"mtime"` (default) or `"version"`.
+		Conflict *string `json:"conflict,omitempty"` // +gen:optional
+		// Number of concurrent PUTs and DELETEs to the destination
+		// (`0` selects the default: `16`).
+		Workers *int `json:"workers,omitempty"` // +gen:optional
+		// Enable replication for this bucket.
+		Enabled *bool `json:"enabled,omitempty"` // +gen:optional
+	}
+)
+
+// (provider: the bucket's own provider; hasBackend: whether the bucket has backend)
+func (c *ReplConf) ValidateAsProps(arg ...any) error {
+	if !c.Enabled {
+		return nil
+	}
+	provider, ok := arg[0].(string)
+	debug.Assert(ok)
+	hasBackend, ok := arg[1].(bool)
+	debug.Assert(ok)
+	if provider != apc.AIS || hasBackend {
+		return errors.New("invalid replication: source must be an ais:// bucket (with no backend)")
+	}
+	if !c.Dst.IsRemoteAIS() {
+		return fmt.Errorf("invalid replication.dst %q: expecting bucket in a remote AIS cluster, e.g. ais://@remais/bucket", c.Dst.String())
+	}
+	if err := c.Dst.ValidateName(); err != nil {
+		return fmt.Errorf("invalid replication.dst: %w", err)
+	}
+	switch c.Conflict {
+	case "", ReplConflictMtime, ReplConflictVersion:
+	default:
+		return fmt.Errorf("invalid replication.conflict %q (expecting %q or %q)", c.Conflict, ReplConflictMtime, ReplConflictVersion)
+	}
+	if c.Workers < 0 || c.Workers > replMaxWorkers {
+		return fmt.Errorf("invalid replication.workers %d (expecting 0 (default) or range 1..%d)", c.Workers, replMaxWorkers)
+	}
+	return nil
+}
+
+func (c *ReplConf) NumWorkers() int {
+	if c.Workers > 0 {
+		return c.Workers
+	}
+	return ReplDfltWorkers
+}
@@ -0,0 +1,142 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+)
+
+// S3-compatible stores (MinIO, Ceph RGW, Wasabi, etc.) deviate from Amazon S3
+// in a variety of ways. S3Dialect is a per-bucket (`extra.aws.dialect`) set of
+// quirks, with the `store` preset providing defaults for all the rest.
+
+// dialect store presets
+const (
+	S3StoreAWS     = "aws"
+	S3StoreMinIO   = "minio"
+	S3StoreCeph    = "ceph"
+	S3StoreWasabi  = "wasabi"
+	S3StoreGeneric = "generic" // the lowest common denominator
+)
+
+// bucket addressing
+const (
+	S3AddrPath    = "path"    // https://endpoint/bucket/key
+	S3AddrVirtual = "virtual" // https://bucket.endpoint/key
+)
+
+// request checksum calculation and response checksum validation
+const (
+	S3ChecksumWhenSupported = "when_supported" // (AWS SDK default) e.g., CRC32 with every PUT
+	S3ChecksumWhenRequired  = "when_required"  // only when the S3 operation requires it
+)
+
+// handling of missing ETag in UploadPart and CompleteMultipartUpload responses
+const (
+	S3MissingETagError   = "error"   // fail the operation
+	S3MissingETagCompute = "compute" // MD5 of the part; MD5 of MD5s followed by "-<number-of-parts>"
+)
+
+const S3MaxParts = 10000 // Amazon S3 limit
+
+type (
+	S3Dialect struct {
+		// Preset (see S3Store* enum); empty means Amazon S3.
+		Store string `json:"store,omitempty"`
+		// Bucket addressing: path-style or virtual-hosted style;
+		// empty means per the preset or, otherwise, feature flag "S3-Use-Path-Style".
+		Addressing string `json:"addressing,omitempty"`
+		// When to calculate request checksums and validate response checksums.
+		Checksum string `json:"checksum,omitempty"`
+		// Use ListObjects (V1) rather than ListObjectsV2.
+		ListV1 bool `json:"list_v1,omitempty"`
+		// Maximum number of parts in a multipart upload (0 - default).
+		MaxParts int32 `json:"max_parts,omitempty"`
+		// Missing ETag handling (see S3MissingETag* enum); empty means pass through as is.
+		MissingETag string `json:"missing_etag,omitempty"`
+	}
+	// S3DialectToSet is the partial-update counterpart of S3Dialect.
+	S3DialectToSet struct {
+		// Preset that determines the defaults for all other dialect
+		// settings: `"aws"`, `"minio"`, `"ceph"`, `"wasabi"`, or
+		// `"generic"`. Empty means Amazon S3.
+		Store *string `json:"store,omitempty"` // +gen:optional
+		// Bucket addressing: `"path"` or `"virtual"`.
+		Addressing *string `json:"addressing,omitempty"` // +gen:optional
+		// Request checksum calculation and response checksum
+		// validation: `"when_supported"` or `"when_required"`.
+		Checksum *string `json:"checksum,omitempty"` // +gen:optional
+		// Use ListObjects (V1) rather than ListObjectsV2.
+		ListV1 *bool `json:"list_v1,omitempty"` // +gen:optional
+		// Maximum number of parts in a multipart upload
+		// (`0` selects the default: `10000`).
+		MaxParts *int32 `json:"max_parts,omitempty"` // +gen:optional
+		// Missing ETag in multipart upload responses: `"error"` or
+		// `"compute"`. Empty means pass through as is.
+		MissingETag *string `json:"missing_etag,omitempty"` // +gen:optional
+	}
+)
+
+var s3Presets = map[string]S3Dialect{
+	S3StoreAWS:    {},
+	S3StoreMinIO:  {Addressing: S3AddrPath, Checksum: S3ChecksumWhenRequired},
+	S3StoreCeph:   {Addressing: S3AddrPath, Checksum: S3ChecksumWhenRequired, MissingETag: S3MissingETagCompute},
+	S3StoreWasabi: {Addressing: S3AddrVirtual, Checksum: S3ChecksumWhenRequired},
+	S3StoreGeneric: {
+		Addressing:  S3AddrPath,
+		Checksum:    S3ChecksumWhenRequired,
+		ListV1:      true,
+		MissingETag: S3MissingETagCompute,
+	},
+}
+
+func (d *S3Dialect) validate() error {
+	if _, ok := s3Presets[d.Store]; !ok && d.Store != "" {
+		return fmt.Errorf("invalid extra.aws.dialect.store %q (expecting one of: %q, %q, %q, %q, %q)",
+			d.Store, S3StoreAWS, S3StoreMinIO, S3StoreCeph, S3StoreWasabi, S3StoreGeneric)
+	}
+	switch d.Addressing {
+	case "", S3AddrPath, S3AddrVirtual:
+	default:
+		return fmt.Errorf("invalid extra.aws.dialect.addressing %q (expecting %q or %q)", d.Addressing, S3AddrPath, S3AddrVirtual)
+	}
+	switch d.Checksum {
+	case "", S3ChecksumWhenSupported, S3ChecksumWhenRequired:
+	default:
+		return fmt.Errorf("invalid extra.aws.dialect.checksum %q (expecting %q or %q)", d.Checksum, S3ChecksumWhenSupported, S3ChecksumWhenRequired)
+	}
+	if d.MaxParts < 0 || d.MaxParts > S3MaxParts {
+		return fmt.Errorf("invalid extra.aws.dialect.max_parts %d (expecting 0 (default) or range 1..%d)", d.MaxParts, S3MaxParts)
+	}
+	switch d.MissingETag {
+	case "", S3MissingETagError, S3MissingETagCompute:
+	default:
+		return fmt.Errorf("invalid extra.aws.dialect.missing_etag %q (expecting %q or %q)", d.MissingETag, S3MissingETagError, S3MissingETagCompute)
+	}
+	return nil
+}
+
+// Resolve returns the dialect with its preset's defaults filled-in
+// (explicitly specified settings take precedence; ListV1 is enabled if either is)
+func (d *S3Dialect) Resolve() S3Dialect {
+	r := s3Presets[d.Store]
+	r.Store = d.Store
+	if d.Addressing != "" {
+		r.Addressing = d.Addressing
+	}
+	if d.Checksum != "" {
+		r.Checksum = d.Checksum
+	}
+	r.ListV1 = r.ListV1 || d.ListV1
+	r.MaxParts = S3MaxParts
+	if d.MaxParts > 0 {
+		r.MaxParts = d.MaxParts
+	}
+	if d.MissingETag != "" {
+		r.MissingETag = d.MissingETag
+	}
+	return r
+}
@@ -0,0 +1,527 @@
+// Package tabular: Parquet and Arrow IPC row-group (record-batch) access
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tabular
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// Arrow IPC: encapsulated messages (schema, dictionary and record batches)
+// see https://arrow.apache.org/docs/format/Columnar.html#serialization-and-interprocess-communication-ipc
+//
+// - stream: schema | dictionary batch* | record batch* | EOS
+// - file:   "ARROW1" | padding | stream | footer | 4-byte footer length | "ARROW1"
+// - message: 0xFFFFFFFF | 4-byte metadata length | flatbuffer Message | padding | body
+
+const (
+	arrowMagic   = "ARROW1"
+	continuation = 0xFFFFFFFF
+	metaV5       = 4 // MetadataVersion.V5
+	arrowAlign   = 8
+)
+
+// MessageHeader union
+const (
+	mhSchema          = 1
+	mhDictionaryBatch = 2
+	mhRecordBatch     = 3
+)
+
+// Type union
+const (
+	atInt             = 2
+	atFloat           = 3
+	atBinary          = 4
+	atUtf8            = 5
+	atBool            = 6
+	atDate            = 8
+	atTimestamp       = 10
+	atList            = 12
+	atStruct          = 13
+	atFixedSizeBinary = 15
+	atMap             = 17
+)
+
+const (
+	precisionSingle = 1
+	precisionDouble = 2
+
+	dateUnitDay = 0
+
+	timeUnitMilli = 1
+	timeUnitMicro = 2
+	timeUnitNano  = 3
+)
+
+var (
+	errMalformedArrow = errors.New("tabular: malformed arrow ipc")
+	errUnsupported    = errors.New("tabular: not supported")
+	errTooLarge       = errors.New("tabular: row group too large")
+)
+
+type (
+	// Arrow field (column) converted from Parquet
+	field struct {
+		name      string
+		tz        string   // timestamp
+		children  []*field // list and map: element (entries); struct: members
+		ptype     int32    // parquet physical type
+		width     int      // fixed width in bytes (zero for booleans and variable-length)
+		leaf      int      // parquet column: the first one in the subtree (see pqFile.fields)
+		bitWidth  int32    // int
+		unit      int16    // date, timestamp
+		precision int16    // floating point
+		// parquet levels: a (new) value gets appended when repetition level <= slotRep
+		// and definition level >= slotDef; the value is null when the latter < presentDef
+		slotRep, slotDef, presentDef int16
+		atype                        byte
+		signed                       bool // int
+		nullable                     bool
+	}
+	// Arrow array being built
+	column struct {
+		field    *field
+		err      error
+		children []*column
+		validity []byte // bitmap (nil while no nulls)
+		offsets  []byte // int32 offsets (binary and utf8: end offsets; list and map: start offsets - see finish)
+		data     []byte // values: fixed-width, bitmap (bool), or variable-length
+		n        int
+		nulls    int
+	}
+	// IPC message in a given Arrow stream or file
+	ipcMsg struct {
+		off     int64 // message start
+		metaLen int64 // prefix, flatbuffer, and padding
+		bodyLen int64
+		nrows   int64 // record batch length
+		typ     byte  // MessageHeader
+	}
+)
+
+var eos = []byte{0xff, 0xff, 0xff, 0xff, 0, 0, 0, 0}
+
+//
+// column
+//
+
+func newColumn(f *field) *column {
+	col := &column{field: f}
+	switch f.atype {
+	case atBinary, atUtf8:
+		col.offsets = make([]byte, 4, 1024)
+	case atList, atMap:
+		col.offsets = make([]byte, 0, 1024)
+	}
+	for _, cf := range f.children {
+		col.children = append(col.children, newColumn(cf))
+	}
+	return col
+}
+
+func (col *column) nested() bool { return col.children != nil }
+
+func (col *column) appendNull() {
+	if col.validity == nil {
+		col.validity = make([]byte, (col.n+8)/8)
+		for i := range col.n {
+			col.validity[i>>3] |= 1 << (i & 7)
+		}
+	}
+	col.validity = setBit(col.validity, col.n, false)
+	switch {
+	case col.field.atype == atStruct:
+	case col.nested(): // list, map
+		col.offsets = binary.LittleEndian.AppendUint32(col.offsets, uint32(col.children[0].n))
+	case col.field.atype == atBool:
+		col.data = setBit(col.data, col.n, false)
+	case col.field.width > 0:
+		col.data = append(col.data, make([]byte, col.field.width)...)
+	default:
+		col.offsets = binary.LittleEndian.AppendUint32(col.offsets, uint32(len(col.data)))
+	}
+	col.n++
+	col.nulls++
+}
+
+func (col *column) valid() {
+	if col.validity != nil {
+		col.validity = setBit(col.validity, col.n, true)
+	}
+	col.n++
+}
+
+func (col *column) appendBool(v bool) {
+	col.data = setBit(col.data, col.n, v)
+	col.valid()
+}
+
+func (col *column) appendFixed(v []byte) {
+	col.data = append(col.data, v...)
+	col.valid()
+}
+
+func (col *column) appendBinary(v []byte) {
+	col.data = append(col.data, v...)
+	if len(col.data) > math.MaxInt32 {
+		col.err = fmt.Errorf("%w: variable-length column %q exceeds 2GiB", errUnsupported, col.field.name)
+	}
+	col.offsets = binary.LittleEndian.AppendUint32(col.offsets, uint32(len(col.data)))
+	col.valid()
+}
+
+// list (map) entry starts at the current length of its element (entries) column
+func (col *column) appendList() {
+	col.offsets = binary.LittleEndian.AppendUint32(col.offsets, uint32(col.children[0].n))
+	col.valid()
+}
+
+// list, map: end offset of the last entry
+func (col *column) finish() {
+	for _, child := range col.children {
+		child.finish()
+	}
+	if col.nested() && col.field.atype != atStruct {
+		col.offsets = binary.LittleEndian.AppendUint32(col.offsets, uint32(col.children[0].n))
+	}
+}
+
+func (col *column) size() (size int64) {
+	size = int64(len(col.validity) + len(col.offsets) + len(col.data))
+	for _, child := range col.children {
+		size += child.size()
+	}
+	return size
+}
+
+// buffers in Arrow (columnar) order
+func (col *column) buffers() [][]byte {
+	var validity []byte
+	if col.nulls > 0 {
+		validity = col.validity
+	}
+	switch {
+	case col.field.atype == atStruct:
+		return [][]byte{validity}
+	case col.nested():
+		return [][]byte{validity, col.offsets}
+	case col.offsets != nil:
+		return [][]byte{validity, col.offsets, col.data}
+	default:
+		return [][]byte{validity, col.data}
+	}
+}
+
+func setBit(bm []byte, i int, v bool) []byte {
+	if i>>3 >= len(bm) {
+		bm = append(bm, 0)
+	}
+	if v {
+		bm[i>>3] |= 1 << (i & 7)
+	}
+	return bm
+}
+
+//
+// write: schema and record batch => standalone IPC stream
+//
+
+func writeStream(fields []*field, cols []*column, nrows int64) ([]byte, error) {
+	var (
+		body    []byte
+		buffers [][2]int64 // (offset, length)
+		nodes   = make([][2]int64, 0, len(cols))
+	)
+	// field nodes and buffers: depth-first, pre-order
+	var flatten func(col *column) error
+	flatten = func(col *column) error {
+		if col.err != nil {
+			return col.err
+		}
+		nodes = append(nodes, [2]int64{int64(col.n), int64(col.nulls)})
+		for _, b := range col.buffers() {
+			buffers = append(buffers, [2]int64{int64(len(body)), int64(len(b))})
+			body = append(body, b...)
+			body = append(body, make([]byte, padding(len(b)))...)
+		}
+		for _, child := range col.children {
+			if err := flatten(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, col := range cols {
+		if err := flatten(col); err != nil {
+			return nil, err
+		}
+	}
+	var (
+		out    bytes.Buffer
+		schema = schemaMsg(fields)
+		batch  = batchMsg(nrows, nodes, buffers, int64(len(body)))
+	)
+	out.Grow(len(schema) + len(batch) + len(body) + len(eos))
+	out.Write(schema)
+	out.Write(batch)
+	out.Write(body)
+	out.Write(eos)
+	return out.Bytes(), nil
+}
+
+func padding(n int) int { return (arrowAlign - n%arrowAlign) % arrowAlign }
+
+// prefix flatbuffer Message with continuation marker and (padded) length
+func encapsulate(fb []byte) []byte {
+	l := len(fb) + padding(len(fb))
+	out := make([]byte, 8, 8+l)
+	binary.LittleEndian.PutUint32(out, continuation)
+	binary.LittleEndian.PutUint32(out[4:], uint32(l))
+	out = append(out, fb...)
+	return append(out, make([]byte, l-len(fb))...)
+}
+
+func schemaMsg(fields []*field) []byte {
+	b := flatbuffers.NewBuilder(1024)
+	offs := make([]flatbuffers.UOffsetT, len(fields))
+	for i, f := range fields {
+		offs[i] = f.build(b)
+	}
+	b.StartVector(4, len(offs), 4)
+	for i := len(offs) - 1; i >= 0; i-- {
+		b.PrependUOffsetT(offs[i])
+	}
+	vec := b.EndVector(len(offs))
+
+	b.StartObject(4) // Schema
+	b.PrependUOffsetTSlot(1, vec, 0)
+	schema := b.EndObject()
+
+	return message(b, mhSchema, schema, 0)
+}
+
+func (f *field) build(b *flatbuffers.Builder) flatbuffers.UOffsetT {
+	// (flatbuffers: nested objects first)
+	offs := make([]flatbuffers.UOffsetT, len(f.children))
+	for i, cf := range f.children {
+		offs[i] = cf.build(b)
+	}
+	var tz flatbuffers.UOffsetT
+	if f.tz != "" {
+		tz = b.CreateString(f.tz)
+	}
+	name := b.CreateString(f.name)
+
+	var typ flatbuffers.UOffsetT
+	switch f.atype {
+	case atInt:
+		b.StartObject(2)
+		b.PrependInt32Slot(0, f.bitWidth, 0)
+		b.PrependBoolSlot(1, f.signed, false)
+	case atFloat:
+		b.StartObject(1)
+		b.PrependInt16Slot(0, f.precision, 0)
+	case atDate:
+		b.StartObject(1)
+		b.PrependInt16Slot(0, f.unit, 1 /*MILLISECOND*/)
+	case atTimestamp:
+		b.StartObject(2)
+		b.PrependInt16Slot(0, f.unit, 0)
+		b.PrependUOffsetTSlot(1, tz, 0)
+	case atFixedSizeBinary:
+		b.StartObject(1)
+		b.PrependInt32Slot(0, int32(f.width), 0)
+	case atMap:
+		b.StartObject(1)
+		b.PrependBoolSlot(0, false /*keysSorted*/, false)
+	default: // Binary, Utf8, Bool, List, Struct_
+		b.StartObject(0)
+	}
+	typ = b.EndObject()
+
+	// children (empty vector, if none, is required by some readers)
+	b.StartVector(4, len(offs), 4)
+	for i := len(offs) - 1; i >= 0; i-- {
+		b.PrependUOffsetT(offs[i])
+	}
+	children := b.EndVector(len(offs))
+
+	b.StartObject(7) // Field
+	b.PrependUOffsetTSlot(0, name, 0)
+	b.PrependBoolSlot(1, f.nullable, false)
+	b.PrependByteSlot(2, f.atype, 0)
+	b.PrependUOffsetTSlot(3, typ, 0)
+	b.PrependUOffsetTSlot(5, children, 0)
+	return b.EndObject()
+}
+
+func batchMsg(nrows int64, nodes, buffers [][2]int64, bodyLen int64) []byte {
+	b := flatbuffers.NewBuilder(256 + 16*(len(nodes)+len(buffers)))
+
+	// vectors of structs: FieldNode{length, null_count} and Buffer{offset, length}
+	b.StartVector(16, len(buffers), 8)
+	for i := len(buffers) - 1; i >= 0; i-- {
+		b.Prep(8, 16)
+		b.PrependInt64(buffers[i][1])
+		b.PrependInt64(buffers[i][0])
+	}
+	bufs := b.EndVector(len(buffers))
+	b.StartVector(16, len(nodes), 8)
+	for i := len(nodes) - 1; i >= 0; i-- {
+		b.Prep(8, 16)
+		b.PrependInt64(nodes[i][1])
+		b.PrependInt64(nodes[i][0])
+	}
+	nds := b.EndVector(len(nodes))
+
+	b.StartObject(5) // RecordBatch
+	b.PrependInt64Slot(0, nrows, 0)
+	b.PrependUOffsetTSlot(1, nds, 0)
+	b.PrependUOffsetTSlot(2, bufs, 0)
+	rb := b.EndObject()
+
+	return message(b, mhRecordBatch, rb, bodyLen)
+}
+
+func message(b *flatbuffers.Builder, typ byte, header flatbuffers.UOffsetT, bodyLen int64) []byte {
+	b.StartObject(5) // Message
+	b.PrependInt64Slot(3, bodyLen, 0)
+	b.PrependUOffsetTSlot(2, header, 0)
+	b.PrependInt16Slot(0, metaV5, 0)
+	b.PrependByteSlot(1, typ, 0)
+	b.Finish(b.EndObject())
+	return encapsulate(b.FinishedBytes())
+}
+
+//
+// read: scan Arrow IPC file or stream
+//
+
+func scanIPC(r io.ReaderAt, size int64) (msgs []*ipcMsg, _ error) {
+	var (
+		off int64
+		hdr [8]byte
+	)
+	if size >= int64(len(hdr)) {
+		if _, err := r.ReadAt(hdr[:], 0); err != nil {
+			return nil, err
+		}
+		if string(hdr[:len(arrowMagic)]) == arrowMagic {
+			off = int64(len(hdr)) // file format
+		}
+	}
+	for off+4 <= size {
+		n := min(int64(len(hdr)), size-off)
+		if _, err := r.ReadAt(hdr[:n], off); err != nil && err != io.EOF {
+			return nil, err
+		}
+		var (
+			prefix = int64(4)
+			mlen   = int64(binary.LittleEndian.Uint32(hdr[:4]))
+		)
+		if mlen == continuation {
+			if n < 8 {
+				return nil, fmt.Errorf("%w: truncated message at %d", errMalformedArrow, off)
+			}
+			prefix, mlen = 8, int64(binary.LittleEndian.Uint32(hdr[4:]))
+		}
+		if mlen == 0 { // EOS
+			break
+		}
+		if mlen > size-off-prefix {
+			return nil, fmt.Errorf("%w: invalid message length %d at %d", errMalformedArrow, mlen, off)
+		}
+		fb := make([]byte, mlen)
+		if _, err := r.ReadAt(fb, off+prefix); err != nil {
+			return nil, err
+		}
+		msg, err := parseMessage(fb)
+		if err != nil {
+			return nil, err
+		}
+		msg.off, msg.metaLen = off, prefix+mlen
+		if msg.bodyLen < 0 || msg.bodyLen > size-off-msg.metaLen {
+			return nil, fmt.Errorf("%w: invalid body length %d at %d", errMalformedArrow, msg.bodyLen, off)
+		}
+		if len(msgs) == 0 && msg.typ != mhSchema {
+			return nil, fmt.Errorf("%w: expecting schema, got message type %d", errMalformedArrow, msg.typ)
+		}
+		msgs = append(msgs, msg)
+		off += msg.metaLen + msg.bodyLen
+	}
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("%w: missing schema", errMalformedArrow)
+	}
+	return msgs, nil
+}
+
+// (flatbuffers runtime does not validate offsets)
+func parseMessage(fb []byte) (msg *ipcMsg, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			msg, err = nil, fmt.Errorf("%w: %v", errMalformedArrow, r)
+		}
+	}()
+	if len(fb) < flatbuffers.SizeUOffsetT {
+		return nil, errMalformedArrow
+	}
+	t := &flatbuffers.Table{Bytes: fb, Pos: flatbuffers.GetUOffsetT(fb)}
+	msg = &ipcMsg{
+		typ:     t.GetByteSlot(6, 0),
+		bodyLen: t.GetInt64Slot(10, 0),
+	}
+	if msg.typ == mhRecordBatch {
+		if o := t.Offset(8); o != 0 {
+			rb := &flatbuffers.Table{}
+			t.Union(rb, flatbuffers.UOffsetT(o))
+			msg.nrows = rb.GetInt64Slot(4, 0)
+		}
+	}
+	return msg, nil
+}
+
+// batch number `idx` => standalone stream: schema, preceding dictionaries, the batch, and EOS
+func ipcSections(r io.ReaderAt, msgs []*ipcMsg, idx int) (sections []io.Reader, size int64) {
+	var k int
+	for _, msg := range msgs {
+		switch msg.typ {
+		case mhSchema, mhDictionaryBatch:
+			if msg.typ == mhSchema && len(sections) > 0 {
+				continue
+			}
+		case mhRecordBatch:
+			if k != idx {
+				k++
+				continue
+			}
+		default:
+			continue
+		}
+		l := msg.metaLen + msg.bodyLen
+		sections = append(sections, io.NewSectionReader(r, msg.off, l))
+		size += l
+		if msg.typ == mhRecordBatch {
+			break
+		}
+	}
+	sections = append(sections, bytes.NewReader(eos))
+	return sections, size + int64(len(eos))
+}
+
+func ipcBatches(msgs []*ipcMsg) (batches []*ipcMsg) {
+	for _, msg := range msgs {
+		if msg.typ == mhRecordBatch {
+			batches = append(batches, msg)
+		}
+	}
+	return batches
+}
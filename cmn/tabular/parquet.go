@@ -0,0 +1,1223 @@
+// Package tabular: Parquet and Arrow IPC row-group (record-batch) access
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tabular
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// Parquet file layout: "PAR1" | column chunks | FileMetaData (thrift compact) | 4-byte footer length | "PAR1"
+// see https://github.com/apache/parquet-format
+//
+// Row groups are converted to Arrow IPC streams; supported:
+// - REQUIRED, OPTIONAL, and REPEATED fields, including nested groups (Arrow struct),
+//   LIST and MAP (Arrow list and map), and legacy (2-level) lists
+// - physical types: all except INT96
+// - encodings: PLAIN, PLAIN_DICTIONARY, RLE_DICTIONARY, RLE (booleans), DELTA_BINARY_PACKED,
+//   DELTA_LENGTH_BYTE_ARRAY, DELTA_BYTE_ARRAY, and BYTE_STREAM_SPLIT
+// - codecs: UNCOMPRESSED, SNAPPY, GZIP, ZSTD, LZ4_RAW
+// - data pages v1 and v2
+
+const pqMagic = "PAR1"
+
+// physical types
+const (
+	ptBoolean = iota
+	ptInt32
+	ptInt64
+	ptInt96
+	ptFloat
+	ptDouble
+	ptByteArray
+	ptFixedLenByteArray
+)
+
+// field repetition
+const (
+	repRequired = iota
+	repOptional
+	repRepeated
+)
+
+// converted types (legacy logical types)
+const (
+	cvUTF8            = 0
+	cvMap             = 1
+	cvMapKeyValue     = 2
+	cvList            = 3
+	cvEnum            = 4
+	cvDate            = 6
+	cvTimestampMillis = 9
+	cvTimestampMicros = 10
+	cvUint32          = 13
+	cvUint64          = 14
+	cvJSON            = 19
+)
+
+// logical types (union field IDs)
+const (
+	ltString    = 1
+	ltMap       = 2
+	ltList      = 3
+	ltEnum      = 4
+	ltDate      = 6
+	ltTimestamp = 8
+	ltJSON      = 12
+)
+
+// encodings
+const (
+	encPlain                = 0
+	encPlainDict            = 2
+	encRLE                  = 3
+	encDeltaBinaryPacked    = 5
+	encDeltaLengthByteArray = 6
+	encDeltaByteArray       = 7
+	encRLEDictionary        = 8
+	encByteStreamSplit      = 9
+)
+
+// compression codecs
+const (
+	codecNone   = 0
+	codecSnappy = 1
+	codecGzip   = 2
+	codecZstd   = 6
+	codecLz4Raw = 7
+)
+
+// page types
+const (
+	pageData       = 0
+	pageIndex      = 1
+	pageDictionary = 2
+	pageDataV2     = 3
+)
+
+const (
+	maxPageSize = 1 << 30 // sanity
+	maxNesting  = 64
+)
+
+// GET converts the entire row group in memory (Arrow IPC record batch requires buffer
+// offsets upfront) - hence, the limit on its (compressed and converted) size
+var maxRowGroupSize int64 = 1 << 30
+
+var errMalformedParquet = errors.New("tabular: malformed parquet")
+
+type (
+	pqSchemaElem struct {
+		name        string
+		ptype       int32
+		typeLen     int32
+		repetition  int32
+		numChildren int32
+		converted   int32
+		logical     int16 // logical type (union field ID)
+		tsUnit      int16 // logical TIMESTAMP unit (union field ID: MILLIS=1, MICROS=2, NANOS=3)
+		utc         bool  // logical TIMESTAMP isAdjustedToUTC
+	}
+	pqColumnMeta struct {
+		ptype           int32
+		codec           int32
+		numValues       int64
+		totalCompressed int64
+		dataPageOff     int64
+		dictPageOff     int64
+	}
+	pqRowGroup struct {
+		columns         []*pqColumnMeta
+		numRows         int64
+		totalByteSize   int64
+		totalCompressed int64
+	}
+	pqFooter struct {
+		schema    []*pqSchemaElem
+		rowGroups []*pqRowGroup
+		numRows   int64
+	}
+	pqPageHeader struct {
+		typ              int32
+		uncompressedSize int32
+		compressedSize   int32
+		numValues        int32
+		encoding         int32
+		defEncoding      int32 // v1
+		repEncoding      int32 // v1
+		defLen, repLen   int32 // v2
+		compressed       bool  // v2
+	}
+	// parquet schema => Arrow fields (see pqFile.fields)
+	pqSchema struct {
+		elems []*pqSchemaElem
+		pos   int
+		leaf  int
+	}
+
+	// parquet file opened for reading
+	pqFile struct {
+		r      io.ReaderAt
+		footer *pqFooter
+		size   int64
+	}
+	// values of a single page (non-null only)
+	pqValues struct {
+		fixed []byte   // fixed-width values, contiguous
+		bin   [][]byte // byte arrays
+		bools []bool
+	}
+	// dictionary (page) of a given column chunk
+	pqDict struct {
+		fixed []byte
+		bin   [][]byte
+		n     int
+	}
+)
+
+func openParquet(r io.ReaderAt, size int64) (*pqFile, error) {
+	var tail [8]byte
+	if size < int64(len(pqMagic)+len(tail)) {
+		return nil, fmt.Errorf("%w: file too short (%d)", errMalformedParquet, size)
+	}
+	if _, err := r.ReadAt(tail[:], size-int64(len(tail))); err != nil {
+		return nil, err
+	}
+	if string(tail[4:]) != pqMagic {
+		return nil, fmt.Errorf("%w: invalid magic %q", errMalformedParquet, tail[4:])
+	}
+	flen := int64(binary.LittleEndian.Uint32(tail[:4]))
+	if flen == 0 || flen > size-int64(len(tail))-int64(len(pqMagic)) {
+		return nil, fmt.Errorf("%w: invalid footer length %d", errMalformedParquet, flen)
+	}
+	buf := make([]byte, flen)
+	if _, err := r.ReadAt(buf, size-int64(len(tail))-flen); err != nil {
+		return nil, err
+	}
+	footer, err := parseFooter(buf)
+	if err != nil {
+		return nil, err
+	}
+	return &pqFile{r: r, size: size, footer: footer}, nil
+}
+
+func (pq *pqFile) list() []*Entry {
+	lst := make([]*Entry, 0, len(pq.footer.rowGroups))
+	for i, rg := range pq.footer.rowGroups {
+		size := rg.totalCompressed
+		if size <= 0 {
+			for _, cm := range rg.columns {
+				size += cm.totalCompressed
+			}
+		}
+		lst = append(lst, &Entry{Name: RowGroupName(i), Size: size, NumRows: rg.numRows})
+	}
+	return lst
+}
+
+// convert a given row group to a standalone Arrow IPC stream
+func (pq *pqFile) rowGroup(idx int) ([]byte, error) {
+	fields, err := pq.fields()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		rg   = pq.footer.rowGroups[idx]
+		cols = make([]*column, len(fields))
+	)
+	for i, f := range fields {
+		cols[i] = newColumn(f)
+	}
+	paths := leafPaths(cols, nil, nil)
+	if len(rg.columns) != len(paths) {
+		return nil, fmt.Errorf("%w: row group %d has %d columns, expecting %d",
+			errMalformedParquet, idx, len(rg.columns), len(paths))
+	}
+	var size int64
+	for _, cm := range rg.columns {
+		size += cm.totalCompressed
+	}
+	if size = max(size, rg.totalByteSize); size > maxRowGroupSize {
+		return nil, fmt.Errorf("%w: row group %d size %d exceeds %d", errTooLarge, idx, size, maxRowGroupSize)
+	}
+	var conv int64
+	for i, path := range paths {
+		leaf := path[len(path)-1]
+		if err := pq.readChunk(path, i, rg.columns[i]); err != nil {
+			return nil, fmt.Errorf("row group %d, column %q: %w", idx, leaf.field.name, err)
+		}
+		// (in case total_byte_size understates it)
+		if conv += leaf.size(); conv > maxRowGroupSize {
+			return nil, fmt.Errorf("%w: row group %d converted size exceeds %d", errTooLarge, idx, maxRowGroupSize)
+		}
+	}
+	for _, col := range cols {
+		col.finish()
+		if int64(col.n) != rg.numRows {
+			return nil, fmt.Errorf("%w: row group %d, column %q: got %d values, expecting %d",
+				errMalformedParquet, idx, col.field.name, col.n, rg.numRows)
+		}
+	}
+	return writeStream(fields, cols, rg.numRows)
+}
+
+// root-to-leaf paths, one per parquet column (in schema order)
+func leafPaths(cols []*column, parent []*column, paths [][]*column) [][]*column {
+	for _, col := range cols {
+		path := append(parent[:len(parent):len(parent)], col)
+		if col.nested() {
+			paths = leafPaths(col.children, path, paths)
+		} else {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// parquet schema (depth-first, with num_children) => Arrow fields
+func (pq *pqFile) fields() ([]*field, error) {
+	elems := pq.footer.schema
+	if len(elems) < 1 {
+		return nil, fmt.Errorf("%w: empty schema", errMalformedParquet)
+	}
+	var (
+		sch    = &pqSchema{elems: elems, pos: 1}
+		fields = make([]*field, 0, elems[0].numChildren)
+	)
+	for range elems[0].numChildren {
+		f, err := sch.field(0, 0, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, f)
+	}
+	if sch.pos != len(elems) {
+		return nil, fmt.Errorf("%w: schema has %d elements, expecting %d", errMalformedParquet, len(elems), sch.pos)
+	}
+	return fields, nil
+}
+
+// next schema element at a given nesting depth, whereby
+// (rep, slotDef) define its slot in the parent and baseDef - the parent's presence
+// (see also: field.slotRep, et al.)
+func (sch *pqSchema) field(depth int, rep, slotDef, baseDef int16) (*field, error) {
+	if depth > maxNesting {
+		return nil, fmt.Errorf("%w: nesting depth exceeds %d", errUnsupported, maxNesting)
+	}
+	if sch.pos >= len(sch.elems) {
+		return nil, fmt.Errorf("%w: truncated schema", errMalformedParquet)
+	}
+	se := sch.elems[sch.pos]
+	if se.repetition == repRepeated {
+		// legacy (2-level) list: non-null list of non-null elements
+		f := &field{name: se.name, atype: atList, leaf: sch.leaf, slotRep: rep, slotDef: slotDef, presentDef: baseDef}
+		elem, err := sch._field(se, depth+1, rep+1, baseDef+1, baseDef+1, false)
+		if err != nil {
+			return nil, err
+		}
+		f.children = []*field{elem}
+		return f, nil
+	}
+	return sch._field(se, depth, rep, slotDef, baseDef, se.repetition == repOptional)
+}
+
+func (sch *pqSchema) _field(se *pqSchemaElem, depth int, rep, slotDef, baseDef int16, optional bool) (*field, error) {
+	sch.pos++
+	f := &field{name: se.name, nullable: optional, leaf: sch.leaf, slotRep: rep, slotDef: slotDef, presentDef: baseDef}
+	if optional {
+		f.presentDef++
+	}
+	switch {
+	case se.numChildren <= 0: // primitive
+		f.ptype = se.ptype
+		sch.leaf++
+		return f, f.fromParquet(se)
+	case se.converted == cvList || se.logical == ltList,
+		se.converted == cvMap || se.converted == cvMapKeyValue || se.logical == ltMap:
+		f.atype = atList
+		if se.converted != cvList && se.logical != ltList {
+			f.atype = atMap
+		}
+		if se.numChildren != 1 || sch.pos >= len(sch.elems) || sch.elems[sch.pos].repetition != repRepeated {
+			return nil, fmt.Errorf("%w: %q: expecting a single repeated field", errMalformedParquet, se.name)
+		}
+		var (
+			r    = sch.elems[sch.pos]
+			elem *field
+			err  error
+		)
+		// backward-compatibility rules: when the repeated field is the element itself
+		// (see https://github.com/apache/parquet-format/blob/master/LogicalTypes.md#lists)
+		if r.numChildren <= 0 || r.numChildren > 1 || r.name == "array" || r.name == se.name+"_tuple" {
+			elem, err = sch._field(r, depth+1, rep+1, f.presentDef+1, f.presentDef+1, false)
+		} else {
+			sch.pos++ // 3-level: skip the repeated group
+			elem, err = sch.field(depth+2, rep+1, f.presentDef+1, f.presentDef+1)
+		}
+		if err != nil {
+			return nil, err
+		}
+		f.children = []*field{elem}
+	default: // struct
+		f.atype = atStruct
+		f.children = make([]*field, 0, se.numChildren)
+		for range se.numChildren {
+			cf, err := sch.field(depth+1, rep, slotDef, f.presentDef)
+			if err != nil {
+				return nil, err
+			}
+			f.children = append(f.children, cf)
+		}
+	}
+	return f, nil
+}
+
+func (f *field) fromParquet(se *pqSchemaElem) error {
+	switch se.ptype {
+	case ptBoolean:
+		f.atype = atBool
+	case ptInt32:
+		f.width = 4
+		switch {
+		case se.converted == cvDate || se.logical == ltDate:
+			f.atype, f.unit = atDate, dateUnitDay
+		default:
+			f.atype, f.bitWidth, f.signed = atInt, 32, se.converted != cvUint32
+		}
+	case ptInt64:
+		f.width = 8
+		switch {
+		case se.logical == ltTimestamp:
+			f.atype, f.unit = atTimestamp, [...]int16{timeUnitMilli, timeUnitMilli, timeUnitMicro, timeUnitNano}[se.tsUnit&3]
+			if se.utc {
+				f.tz = "UTC"
+			}
+		case se.converted == cvTimestampMillis:
+			f.atype, f.unit, f.tz = atTimestamp, timeUnitMilli, "UTC"
+		case se.converted == cvTimestampMicros:
+			f.atype, f.unit, f.tz = atTimestamp, timeUnitMicro, "UTC"
+		default:
+			f.atype, f.bitWidth, f.signed = atInt, 64, se.converted != cvUint64
+		}
+	case ptFloat:
+		f.atype, f.precision, f.width = atFloat, precisionSingle, 4
+	case ptDouble:
+		f.atype, f.precision, f.width = atFloat, precisionDouble, 8
+	case ptByteArray:
+		switch {
+		case se.converted == cvUTF8 || se.converted == cvEnum || se.converted == cvJSON,
+			se.logical == ltString || se.logical == ltEnum || se.logical == ltJSON:
+			f.atype = atUtf8
+		default:
+			f.atype = atBinary
+		}
+	case ptFixedLenByteArray:
+		if se.typeLen <= 0 {
+			return fmt.Errorf("%w: column %q: invalid fixed length %d", errMalformedParquet, se.name, se.typeLen)
+		}
+		f.atype, f.width = atFixedSizeBinary, int(se.typeLen)
+	default:
+		return fmt.Errorf("%w: column %q: physical type %d", errUnsupported, se.name, se.ptype)
+	}
+	return nil
+}
+
+// read and decode all pages of a given column chunk (parquet column `leaf`)
+// into the columns on its (root-to-leaf) path
+func (pq *pqFile) readChunk(path []*column, leaf int, cm *pqColumnMeta) error {
+	var (
+		col    = path[len(path)-1]
+		maxRep = col.field.slotRep
+		maxDef = col.field.presentDef
+	)
+	if cm.ptype != col.field.ptype {
+		return fmt.Errorf("%w: physical type %d vs schema %d", errMalformedParquet, cm.ptype, col.field.ptype)
+	}
+	start := cm.dataPageOff
+	if cm.dictPageOff > 0 && cm.dictPageOff < start {
+		start = cm.dictPageOff
+	}
+	if start < int64(len(pqMagic)) || cm.totalCompressed <= 0 || cm.totalCompressed > pq.size-start {
+		return fmt.Errorf("%w: invalid column chunk [%d, %d]", errMalformedParquet, start, cm.totalCompressed)
+	}
+	chunk := make([]byte, cm.totalCompressed)
+	if _, err := pq.r.ReadAt(chunk, start); err != nil {
+		return err
+	}
+	var (
+		dict *pqDict
+		off  int
+		nv   int64
+	)
+	for nv < cm.numValues {
+		if off >= len(chunk) {
+			return fmt.Errorf("%w: column chunk ended after %d values (expecting %d)", errMalformedParquet, nv, cm.numValues)
+		}
+		d := &tcompact{b: chunk, off: off}
+		ph := parsePageHeader(d)
+		if d.err != nil {
+			return d.err
+		}
+		if ph.compressedSize < 0 || int(ph.compressedSize) > len(chunk)-d.off ||
+			ph.uncompressedSize < 0 || ph.uncompressedSize > maxPageSize {
+			return fmt.Errorf("%w: invalid page size", errMalformedParquet)
+		}
+		page := chunk[d.off : d.off+int(ph.compressedSize)]
+		off = d.off + int(ph.compressedSize)
+
+		switch ph.typ {
+		case pageDictionary:
+			data, err := decompress(cm.codec, page, int(ph.uncompressedSize))
+			if err != nil {
+				return err
+			}
+			if dict, err = col.decodeDict(data, ph); err != nil {
+				return err
+			}
+		case pageData:
+			data, err := decompress(cm.codec, page, int(ph.uncompressedSize))
+			if err != nil {
+				return err
+			}
+			// repetition levels, if any, followed by definition levels
+			var reps, defs []int16
+			if maxRep > 0 {
+				if ph.repEncoding != encRLE {
+					return fmt.Errorf("%w: repetition level encoding %d", errUnsupported, ph.repEncoding)
+				}
+				if reps, data, err = levelsPrefixed(data, maxRep, int(ph.numValues)); err != nil {
+					return err
+				}
+			}
+			if maxDef > 0 {
+				if ph.defEncoding != encRLE {
+					return fmt.Errorf("%w: definition level encoding %d", errUnsupported, ph.defEncoding)
+				}
+				if defs, data, err = levelsPrefixed(data, maxDef, int(ph.numValues)); err != nil {
+					return err
+				}
+			}
+			if err := appendPage(path, leaf, data, reps, defs, ph, dict); err != nil {
+				return err
+			}
+			nv += int64(ph.numValues)
+		case pageDataV2:
+			if ph.repLen < 0 || ph.defLen < 0 || int(ph.repLen)+int(ph.defLen) > len(page) {
+				return fmt.Errorf("%w: invalid (v2) levels", errMalformedParquet)
+			}
+			var (
+				reps, defs []int16
+				err        error
+				rl, dl     = int(ph.repLen), int(ph.defLen)
+				data       = page[rl+dl:]
+			)
+			if maxRep > 0 {
+				if reps, err = levels(page[:rl], maxRep, int(ph.numValues)); err != nil {
+					return err
+				}
+			}
+			if maxDef > 0 {
+				if defs, err = levels(page[rl:rl+dl], maxDef, int(ph.numValues)); err != nil {
+					return err
+				}
+			}
+			if ph.compressed {
+				if data, err = decompress(cm.codec, data, int(ph.uncompressedSize)-rl-dl); err != nil {
+					return err
+				}
+			}
+			if err := appendPage(path, leaf, data, reps, defs, ph, dict); err != nil {
+				return err
+			}
+			nv += int64(ph.numValues)
+		case pageIndex:
+			// skip
+		default:
+			return fmt.Errorf("%w: page type %d", errUnsupported, ph.typ)
+		}
+	}
+	return nil
+}
+
+// (v1) levels: 4-byte length followed by RLE/bit-packed hybrid; returns the levels and the remaining data
+func levelsPrefixed(data []byte, maxLevel int16, n int) ([]int16, []byte, error) {
+	if len(data) < 4 {
+		return nil, nil, errMalformedParquet
+	}
+	l := int(binary.LittleEndian.Uint32(data))
+	if l > len(data)-4 {
+		return nil, nil, errMalformedParquet
+	}
+	lvls, err := levels(data[4:4+l], maxLevel, n)
+	return lvls, data[4+l:], err
+}
+
+func decompress(codec int32, src []byte, size int) ([]byte, error) {
+	switch codec {
+	case codecNone:
+		return src, nil
+	case codecSnappy:
+		if n, err := snappy.DecodedLen(src); err != nil || n != size {
+			return nil, fmt.Errorf("%w: snappy page size", errMalformedParquet)
+		}
+		return snappy.Decode(make([]byte, size), src)
+	case codecGzip:
+		gzr, err := gzip.NewReader(bytes.NewReader(src))
+		if err != nil {
+			return nil, err
+		}
+		dst := make([]byte, size)
+		_, err = io.ReadFull(gzr, dst)
+		return dst, err
+	case codecZstd:
+		zr, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(maxPageSize))
+		if err != nil {
+			return nil, err
+		}
+		dst, err := zr.DecodeAll(src, make([]byte, 0, size))
+		zr.Close()
+		if err == nil && len(dst) != size {
+			err = fmt.Errorf("%w: zstd page size", errMalformedParquet)
+		}
+		return dst, err
+	case codecLz4Raw:
+		dst := make([]byte, size)
+		n, err := lz4.UncompressBlock(src, dst)
+		if err == nil && n != size {
+			err = fmt.Errorf("%w: lz4 page size", errMalformedParquet)
+		}
+		return dst, err
+	default:
+		return nil, fmt.Errorf("%w: compression codec %d", errUnsupported, codec)
+	}
+}
+
+//
+// column: decode values
+//
+
+func (col *column) decodeDict(data []byte, ph *pqPageHeader) (*pqDict, error) {
+	if ph.encoding != encPlain && ph.encoding != encPlainDict {
+		return nil, fmt.Errorf("%w: dictionary encoding %d", errUnsupported, ph.encoding)
+	}
+	if col.field.atype == atBool {
+		return nil, fmt.Errorf("%w: boolean dictionary", errUnsupported)
+	}
+	vals, err := col.plain(data, int(ph.numValues))
+	if err != nil {
+		return nil, err
+	}
+	return &pqDict{fixed: vals.fixed, bin: vals.bin, n: int(ph.numValues)}, nil
+}
+
+// decode values of a given page and assemble them, along with repetition and definition levels
+// (nil when the respective max level is zero), into the columns on the path
+func appendPage(path []*column, leaf int, data []byte, reps, defs []int16, ph *pqPageHeader, dict *pqDict) error {
+	var (
+		col = path[len(path)-1]
+		n   = int(ph.numValues)
+		nn  = n // non-null
+	)
+	if defs != nil {
+		nn = 0
+		for _, l := range defs {
+			if l == col.field.presentDef {
+				nn++
+			}
+		}
+	}
+	vals, err := col.decode(data, nn, ph.encoding, dict)
+	if err != nil {
+		return err
+	}
+	var k int
+	for i := range n {
+		var r, d int16
+		if reps != nil {
+			r = reps[i]
+		}
+		if defs != nil {
+			d = defs[i]
+		}
+		for _, c := range path {
+			f := c.field
+			// a given list or struct gets assembled once, along with the first column in its subtree
+			if f.leaf != leaf || r > f.slotRep || d < f.slotDef {
+				continue
+			}
+			switch {
+			case d < f.presentDef:
+				c.appendNull()
+			case f.atype == atStruct:
+				c.valid()
+			case c.nested():
+				c.appendList()
+			case vals.bools != nil:
+				c.appendBool(vals.bools[k])
+				k++
+			case f.width > 0:
+				c.appendFixed(vals.fixed[k*f.width : (k+1)*f.width])
+				k++
+			default:
+				c.appendBinary(vals.bin[k])
+				k++
+			}
+		}
+	}
+	return col.err
+}
+
+func (col *column) decode(data []byte, n int, encoding int32, dict *pqDict) (vals *pqValues, err error) {
+	ptype := col.field.ptype
+	switch encoding {
+	case encPlain:
+		vals, err = col.plain(data, n)
+	case encPlainDict, encRLEDictionary:
+		vals, err = col.lookup(data, n, dict)
+	case encRLE:
+		if col.field.atype != atBool {
+			return nil, fmt.Errorf("%w: RLE encoding of non-boolean values", errUnsupported)
+		}
+		if len(data) < 4 {
+			return nil, errMalformedParquet
+		}
+		var v []uint32
+		if v, err = rleHybrid32(data[4:], 1, n); err == nil {
+			vals = &pqValues{bools: make([]bool, n)}
+			for i, l := range v {
+				vals.bools[i] = l != 0
+			}
+		}
+	case encDeltaBinaryPacked:
+		if ptype != ptInt32 && ptype != ptInt64 {
+			return nil, fmt.Errorf("%w: DELTA_BINARY_PACKED encoding of physical type %d", errMalformedParquet, ptype)
+		}
+		var v []int64
+		if v, _, err = deltaBinaryPacked(data, n); err == nil {
+			vals = &pqValues{fixed: make([]byte, 0, n*col.field.width)}
+			for _, x := range v {
+				if ptype == ptInt32 {
+					vals.fixed = binary.LittleEndian.AppendUint32(vals.fixed, uint32(x))
+				} else {
+					vals.fixed = binary.LittleEndian.AppendUint64(vals.fixed, uint64(x))
+				}
+			}
+		}
+	case encDeltaLengthByteArray:
+		if ptype != ptByteArray {
+			return nil, fmt.Errorf("%w: DELTA_LENGTH_BYTE_ARRAY encoding of physical type %d", errMalformedParquet, ptype)
+		}
+		vals = &pqValues{}
+		vals.bin, _, err = deltaLengthByteArray(data, n)
+	case encDeltaByteArray:
+		if ptype != ptByteArray && ptype != ptFixedLenByteArray {
+			return nil, fmt.Errorf("%w: DELTA_BYTE_ARRAY encoding of physical type %d", errMalformedParquet, ptype)
+		}
+		vals, err = col.deltaByteArray(data, n)
+	case encByteStreamSplit:
+		w := col.field.width
+		if w == 0 || len(data) < n*w {
+			return nil, fmt.Errorf("%w: BYTE_STREAM_SPLIT encoding of physical type %d (%d)", errMalformedParquet, ptype, len(data))
+		}
+		vals = &pqValues{fixed: make([]byte, n*w)}
+		for i := range n {
+			for b := range w {
+				vals.fixed[i*w+b] = data[b*n+i]
+			}
+		}
+	default:
+		err = fmt.Errorf("%w: encoding %d", errUnsupported, encoding)
+	}
+	return vals, err
+}
+
+func (col *column) plain(data []byte, n int) (*pqValues, error) {
+	vals := &pqValues{}
+	switch w := col.field.width; {
+	case col.field.atype == atBool:
+		if len(data)*8 < n {
+			return nil, errMalformedParquet
+		}
+		vals.bools = make([]bool, n)
+		for i := range n {
+			vals.bools[i] = data[i>>3]&(1<<(i&7)) != 0
+		}
+	case w > 0:
+		if len(data) < n*w {
+			return nil, errMalformedParquet
+		}
+		vals.fixed = data[:n*w]
+	default:
+		vals.bin = make([][]byte, n)
+		for i := range n {
+			if len(data) < 4 {
+				return nil, errMalformedParquet
+			}
+			l := int(binary.LittleEndian.Uint32(data))
+			if l > len(data)-4 {
+				return nil, errMalformedParquet
+			}
+			vals.bin[i] = data[4 : 4+l]
+			data = data[4+l:]
+		}
+	}
+	return vals, nil
+}
+
+func (col *column) lookup(data []byte, n int, dict *pqDict) (*pqValues, error) {
+	if dict == nil {
+		return nil, fmt.Errorf("%w: missing dictionary page", errMalformedParquet)
+	}
+	if len(data) < 1 {
+		return nil, errMalformedParquet
+	}
+	idx, err := rleHybrid32(data[1:], int(data[0]), n)
+	if err != nil {
+		return nil, err
+	}
+	vals := &pqValues{}
+	if w := col.field.width; w > 0 {
+		vals.fixed = make([]byte, 0, n*w)
+		for _, i := range idx {
+			if int(i) >= dict.n {
+				return nil, fmt.Errorf("%w: dictionary index out of range", errMalformedParquet)
+			}
+			vals.fixed = append(vals.fixed, dict.fixed[int(i)*w:int(i+1)*w]...)
+		}
+	} else {
+		vals.bin = make([][]byte, n)
+		for j, i := range idx {
+			if int(i) >= dict.n {
+				return nil, fmt.Errorf("%w: dictionary index out of range", errMalformedParquet)
+			}
+			vals.bin[j] = dict.bin[i]
+		}
+	}
+	return vals, nil
+}
+
+// repetition or definition levels: RLE/bit-packing hybrid of bit-width that fits maxLevel
+func levels(data []byte, maxLevel int16, n int) ([]int16, error) {
+	v, err := rleHybrid32(data, bits.Len16(uint16(maxLevel)), n)
+	if err != nil {
+		return nil, err
+	}
+	lvls := make([]int16, n)
+	for i, l := range v {
+		if l > uint32(maxLevel) {
+			return nil, fmt.Errorf("%w: invalid level %d (max %d)", errMalformedParquet, l, maxLevel)
+		}
+		lvls[i] = int16(l)
+	}
+	return lvls, nil
+}
+
+// see https://parquet.apache.org/docs/file-format/data-pages/encodings/#run-length-encoding--bit-packing-hybrid-rle--3
+func rleHybrid32(data []byte, bw, n int) ([]uint32, error) {
+	if bw > 32 {
+		return nil, fmt.Errorf("%w: bit width %d", errMalformedParquet, bw)
+	}
+	var (
+		out   = make([]uint32, 0, n)
+		bytew = (bw + 7) / 8
+	)
+	for len(out) < n {
+		hdr, k := binary.Uvarint(data)
+		if k <= 0 {
+			return nil, fmt.Errorf("%w: rle/bit-packed run header", errMalformedParquet)
+		}
+		data = data[k:]
+		if hdr&1 == 0 { // rle run
+			cnt := int(min(hdr>>1, uint64(n-len(out))))
+			if len(data) < bytew {
+				return nil, errMalformedParquet
+			}
+			var v uint32
+			for i := range bytew {
+				v |= uint32(data[i]) << (8 * i)
+			}
+			data = data[bytew:]
+			for range cnt {
+				out = append(out, v)
+			}
+			continue
+		}
+		// bit-packed run: groups of 8 values, LSB first
+		groups := hdr >> 1
+		if groups == 0 || groups > uint64(len(data)) {
+			return nil, errMalformedParquet
+		}
+		nbytes := int(groups) * bw
+		if nbytes > len(data) {
+			return nil, errMalformedParquet
+		}
+		var (
+			packed = data[:nbytes]
+			cnt    = min(int(groups)*8, n-len(out))
+		)
+		for i := range cnt {
+			var v uint32
+			for j := range bw {
+				if bit := i*bw + j; packed[bit>>3]&(1<<(bit&7)) != 0 {
+					v |= 1 << j
+				}
+			}
+			out = append(out, v)
+		}
+		data = data[nbytes:]
+	}
+	return out, nil
+}
+
+// see https://parquet.apache.org/docs/file-format/data-pages/encodings/#delta-encoding-delta_binary_packed--5
+// returns decoded values and the number of bytes consumed
+func deltaBinaryPacked(data []byte, n int) ([]int64, int, error) {
+	var (
+		off    int
+		header [3]uint64 // block size, miniblocks per block, total count
+	)
+	for i := range header {
+		v, k := binary.Uvarint(data[off:])
+		if k <= 0 {
+			return nil, 0, fmt.Errorf("%w: delta header", errMalformedParquet)
+		}
+		header[i], off = v, off+k
+	}
+	first, k := binary.Varint(data[off:])
+	if k <= 0 {
+		return nil, 0, fmt.Errorf("%w: delta header", errMalformedParquet)
+	}
+	off += k
+	var (
+		blockSize, miniblocks, total = header[0], header[1], header[2]
+		perMini                      uint64
+	)
+	if miniblocks > 0 {
+		perMini = blockSize / miniblocks
+	}
+	if blockSize == 0 || blockSize%128 != 0 || miniblocks == 0 || perMini%32 != 0 || total < uint64(n) || total > maxPageSize {
+		return nil, 0, fmt.Errorf("%w: delta header (%d, %d, %d)", errMalformedParquet, blockSize, miniblocks, total)
+	}
+	// (decoding all `total` values to consume the entire encoded run)
+	var (
+		cnt = int(total)
+		out = make([]int64, 0, n)
+	)
+	if cnt > 0 {
+		out = append(out, first)
+	}
+	for prev := uint64(first); len(out) < cnt; {
+		minDelta, k := binary.Varint(data[off:])
+		if k <= 0 || off+k+int(miniblocks) > len(data) {
+			return nil, 0, fmt.Errorf("%w: delta block", errMalformedParquet)
+		}
+		off += k
+		widths := data[off : off+int(miniblocks)]
+		off += int(miniblocks)
+		for _, bw := range widths {
+			if len(out) >= cnt {
+				break // (remaining miniblocks not stored)
+			}
+			nbytes := int(perMini) * int(bw) / 8
+			if bw > 64 || nbytes > len(data)-off {
+				return nil, 0, fmt.Errorf("%w: delta miniblock", errMalformedParquet)
+			}
+			packed := data[off : off+nbytes]
+			for i := 0; i < int(perMini) && len(out) < cnt; i++ {
+				prev += uint64(minDelta) + unpack(packed, i*int(bw), int(bw))
+				out = append(out, int64(prev))
+			}
+			off += nbytes
+		}
+	}
+	return out[:n], off, nil
+}
+
+// `width` bits at a given bit offset, LSB first
+func unpack(b []byte, bit, width int) (v uint64) {
+	for j := range width {
+		if pos := bit + j; b[pos>>3]&(1<<(pos&7)) != 0 {
+			v |= 1 << j
+		}
+	}
+	return v
+}
+
+// lengths (DELTA_BINARY_PACKED) followed by concatenated values
+func deltaLengthByteArray(data []byte, n int) ([][]byte, int, error) {
+	lens, off, err := deltaBinaryPacked(data, n)
+	if err != nil {
+		return nil, 0, err
+	}
+	out := make([][]byte, n)
+	for i, l := range lens {
+		if l < 0 || l > int64(len(data)-off) {
+			return nil, 0, fmt.Errorf("%w: delta length %d", errMalformedParquet, l)
+		}
+		out[i] = data[off : off+int(l)]
+		off += int(l)
+	}
+	return out, off, nil
+}
+
+// prefix lengths (DELTA_BINARY_PACKED) followed by suffixes (DELTA_LENGTH_BYTE_ARRAY);
+// each value is prefix of the previous one plus its suffix
+func (col *column) deltaByteArray(data []byte, n int) (*pqValues, error) {
+	prefixes, off, err := deltaBinaryPacked(data, n)
+	if err != nil {
+		return nil, err
+	}
+	suffixes, _, err := deltaLengthByteArray(data[off:], n)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		vals = &pqValues{bin: make([][]byte, n)}
+		prev []byte
+	)
+	for i, p := range prefixes {
+		if p < 0 || p > int64(len(prev)) {
+			return nil, fmt.Errorf("%w: delta prefix %d", errMalformedParquet, p)
+		}
+		v := make([]byte, 0, int(p)+len(suffixes[i]))
+		v = append(v, prev[:p]...)
+		v = append(v, suffixes[i]...)
+		vals.bin[i], prev = v, v
+	}
+	if w := col.field.width; w > 0 {
+		vals.fixed = make([]byte, 0, n*w)
+		for _, v := range vals.bin {
+			if len(v) != w {
+				return nil, fmt.Errorf("%w: fixed-length value %d (expecting %d)", errMalformedParquet, len(v), w)
+			}
+			vals.fixed = append(vals.fixed, v...)
+		}
+		vals.bin = nil
+	}
+	return vals, nil
+}
+
+//
+// thrift: footer and page headers
+//
+
+func parseFooter(b []byte) (*pqFooter, error) {
+	var (
+		d = &tcompact{b: b}
+		f = &pqFooter{}
+	)
+	d.fields(func(id int16, typ byte) {
+		switch {
+		case id == 2 && typ == ctList:
+			n := d.structs()
+			f.schema = make([]*pqSchemaElem, 0, n)
+			for i := 0; i < n && d.err == nil; i++ {
+				f.schema = append(f.schema, parseSchemaElem(d))
+			}
+		case id == 3 && typ == ctI64:
+			f.numRows = d.i64()
+		case id == 4 && typ == ctList:
+			n := d.structs()
+			f.rowGroups = make([]*pqRowGroup, 0, n)
+			for i := 0; i < n && d.err == nil; i++ {
+				f.rowGroups = append(f.rowGroups, parseRowGroup(d))
+			}
+		default:
+			d.skip(typ)
+		}
+	})
+	if d.err != nil {
+		return nil, d.err
+	}
+	return f, nil
+}
+
+// list of structs
+func (d *tcompact) structs() int {
+	etyp, n := d.list()
+	if etyp != ctStruct && n > 0 {
+		d.fail()
+		return 0
+	}
+	return n
+}
+
+func parseSchemaElem(d *tcompact) *pqSchemaElem {
+	se := &pqSchemaElem{ptype: -1, converted: -1}
+	d.fields(func(id int16, typ byte) {
+		switch {
+		case id == 1 && typ == ctI32:
+			se.ptype = d.i32()
+		case id == 2 && typ == ctI32:
+			se.typeLen = d.i32()
+		case id == 3 && typ == ctI32:
+			se.repetition = d.i32()
+		case id == 4 && typ == ctBinary:
+			se.name = d.str()
+		case id == 5 && typ == ctI32:
+			se.numChildren = d.i32()
+		case id == 6 && typ == ctI32:
+			se.converted = d.i32()
+		case id == 10 && typ == ctStruct:
+			parseLogicalType(d, se)
+		default:
+			d.skip(typ)
+		}
+	})
+	return se
+}
+
+// LogicalType is a union (struct with exactly one field set)
+func parseLogicalType(d *tcompact, se *pqSchemaElem) {
+	d.fields(func(id int16, typ byte) {
+		se.logical = id
+		if id != ltTimestamp || typ != ctStruct {
+			d.skip(typ)
+			return
+		}
+		d.fields(func(id int16, typ byte) {
+			switch {
+			case id == 1 && (typ == ctTrue || typ == ctFalse):
+				se.utc = d.bool(typ)
+			case id == 2 && typ == ctStruct:
+				d.fields(func(id int16, typ byte) { // TimeUnit union
+					se.tsUnit = id
+					d.skip(typ)
+				})
+			default:
+				d.skip(typ)
+			}
+		})
+	})
+}
+
+func parseRowGroup(d *tcompact) *pqRowGroup {
+	rg := &pqRowGroup{}
+	d.fields(func(id int16, typ byte) {
+		switch {
+		case id == 1 && typ == ctList:
+			n := d.structs()
+			rg.columns = make([]*pqColumnMeta, 0, n)
+			for i := 0; i < n && d.err == nil; i++ {
+				rg.columns = append(rg.columns, parseColumnChunk(d))
+			}
+		case id == 2 && typ == ctI64:
+			rg.totalByteSize = d.i64()
+		case id == 3 && typ == ctI64:
+			rg.numRows = d.i64()
+		case id == 6 && typ == ctI64:
+			rg.totalCompressed = d.i64()
+		default:
+			d.skip(typ)
+		}
+	})
+	return rg
+}
+
+func parseColumnChunk(d *tcompact) *pqColumnMeta {
+	cm := &pqColumnMeta{ptype: -1}
+	d.fields(func(id int16, typ byte) {
+		switch {
+		case id == 1 && typ == ctBinary:
+			if d.binary(); d.err == nil {
+				d.err = fmt.Errorf("%w: column chunks in external files", errUnsupported)
+			}
+		case id == 3 && typ == ctStruct:
+			d.fields(func(id int16, typ byte) {
+				switch {
+				case id == 1 && typ == ctI32:
+					cm.ptype = d.i32()
+				case id == 4 && typ == ctI32:
+					cm.codec = d.i32()
+				case id == 5 && typ == ctI64:
+					cm.numValues = d.i64()
+				case id == 7 && typ == ctI64:
+					cm.totalCompressed = d.i64()
+				case id == 9 && typ == ctI64:
+					cm.dataPageOff = d.i64()
+				case id == 11 && typ == ctI64:
+					cm.dictPageOff = d.i64()
+				default:
+					d.skip(typ)
+				}
+			})
+		default:
+			d.skip(typ)
+		}
+	})
+	return cm
+}
+
+func parsePageHeader(d *tcompact) *pqPageHeader {
+	ph := &pqPageHeader{typ: -1, compressed: true}
+	d.fields(func(id int16, typ byte) {
+		switch {
+		case id == 1 && typ == ctI32:
+			ph.typ = d.i32()
+		case id == 2 && typ == ctI32:
+			ph.uncompressedSize = d.i32()
+		case id == 3 && typ == ctI32:
+			ph.compressedSize = d.i32()
+		case id == 5 && typ == ctStruct: // DataPageHeader
+			d.fields(func(id int16, typ byte) {
+				switch {
+				case id == 1 && typ == ctI32:
+					ph.numValues = d.i32()
+				case id == 2 && typ == ctI32:
+					ph.encoding = d.i32()
+				case id == 3 && typ == ctI32:
+					ph.defEncoding = d.i32()
+				case id == 4 && typ == ctI32:
+					ph.repEncoding = d.i32()
+				default:
+					d.skip(typ)
+				}
+			})
+		case id == 7 && typ == ctStruct: // DictionaryPageHeader
+			d.fields(func(id int16, typ byte) {
+				switch {
+				case id == 1 && typ == ctI32:
+					ph.numValues = d.i32()
+				case id == 2 && typ == ctI32:
+					ph.encoding = d.i32()
+				default:
+					d.skip(typ)
+				}
+			})
+		case id == 8 && typ == ctStruct: // DataPageHeaderV2
+			d.fields(func(id int16, typ byte) {
+				switch {
+				case id == 1 && typ == ctI32:
+					ph.numValues = d.i32()
+				case id == 4 && typ == ctI32:
+					ph.encoding = d.i32()
+				case id == 5 && typ == ctI32:
+					ph.defLen = d.i32()
+				case id == 6 && typ == ctI32:
+					ph.repLen = d.i32()
+				case id == 7 && (typ == ctTrue || typ == ctFalse):
+					ph.compressed = d.bool(typ)
+				default:
+					d.skip(typ)
+				}
+			})
+		default:
+			d.skip(typ)
+		}
+	})
+	if d.err == nil && ph.numValues < 0 {
+		d.err = errMalformedParquet
+	}
+	return ph
+}
@@ -0,0 +1,132 @@
+// Package tabular: Parquet and Arrow IPC row-group (record-batch) access
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tabular
+
+import (
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Tabular objects are treated as row-group containers (compare with cmn/archive):
+// - list-objects with archive expansion (apc.LsArchDir) lists row groups (Parquet)
+//   or record batches (Arrow IPC) as "rowgroup/<index>", with row counts;
+// - GET with archpath "rowgroup/<index>" returns the row group as a standalone
+//   Arrow IPC stream (schema, the record batch, and end-of-stream marker);
+//   Parquet row groups get converted in memory and are therefore limited in size
+//   (see maxRowGroupSize), while Arrow record batches are streamed as is.
+
+const (
+	ExtParquet = ".parquet"
+	ExtArrow   = ".arrow"
+
+	RowGroupPrefix = "rowgroup/" // archpath prefix
+
+	ContentArrowStream = "application/vnd.apache.arrow.stream"
+)
+
+// row group entry
+type Entry struct {
+	Name    string
+	Size    int64 // compressed (Parquet) or serialized (Arrow) size
+	NumRows int64
+}
+
+// by filename extension
+func HasExt(filename string) bool {
+	return strings.HasSuffix(filename, ExtParquet) || strings.HasSuffix(filename, ExtArrow)
+}
+
+func RowGroupName(idx int) string { return RowGroupPrefix + strconv.Itoa(idx) }
+
+func List(fqn string) ([]*Entry, error) {
+	fh, err := os.Open(fqn)
+	if err != nil {
+		return nil, err
+	}
+	finfo, err := fh.Stat()
+	if err != nil {
+		cos.Close(fh)
+		return nil, err
+	}
+	var lst []*Entry
+	if strings.HasSuffix(fqn, ExtParquet) {
+		var pq *pqFile
+		if pq, err = openParquet(fh, finfo.Size()); err == nil {
+			lst = pq.list()
+		}
+	} else {
+		var msgs []*ipcMsg
+		if msgs, err = scanIPC(fh, finfo.Size()); err == nil {
+			for i, msg := range ipcBatches(msgs) {
+				lst = append(lst, &Entry{Name: RowGroupName(i), Size: msg.metaLen + msg.bodyLen, NumRows: msg.nrows})
+			}
+		}
+	}
+	cos.Close(fh)
+	if err != nil {
+		return nil, err
+	}
+	// paging requires them sorted
+	sort.Slice(lst, func(i, j int) bool { return lst[i].Name < lst[j].Name })
+	return lst, nil
+}
+
+// returns (nil, nil) when the named row group does not exist (compare with archive.Reader.ReadOne)
+func NewRowGroupReader(r io.ReaderAt, size int64, filename, archpath string) (cos.ReadCloseSizer, error) {
+	idx, ok := parseArchpath(archpath)
+	if !ok {
+		return nil, nil
+	}
+	if strings.HasSuffix(filename, ExtParquet) {
+		pq, err := openParquet(r, size)
+		if err != nil {
+			return nil, err
+		}
+		if idx >= len(pq.footer.rowGroups) {
+			return nil, nil
+		}
+		b, err := pq.rowGroup(idx)
+		if err != nil {
+			return nil, err
+		}
+		return cos.NewByteReader(b), nil
+	}
+
+	msgs, err := scanIPC(r, size)
+	if err != nil {
+		return nil, err
+	}
+	if idx >= len(ipcBatches(msgs)) {
+		return nil, nil
+	}
+	sections, l := ipcSections(r, msgs, idx)
+	return &stream{io.MultiReader(sections...), l}, nil
+}
+
+// "rowgroup/3" => 3
+func parseArchpath(archpath string) (int, bool) {
+	s, ok := strings.CutPrefix(strings.TrimPrefix(archpath, "/"), RowGroupPrefix)
+	if !ok || s == "" || s[0] < '0' || s[0] > '9' || (len(s) > 1 && s[0] == '0') {
+		return 0, false
+	}
+	idx, err := strconv.Atoi(s)
+	if err != nil || idx < 0 {
+		return 0, false
+	}
+	return idx, true
+}
+
+type stream struct {
+	io.Reader
+	size int64
+}
+
+func (*stream) Close() error  { return nil }
+func (s *stream) Size() int64 { return s.size }
@@ -0,0 +1,1180 @@
+// Package tabular: Parquet and Arrow IPC row-group (record-batch) access
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tabular
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// test table (all row groups):
+//   id    INT64 required              PLAIN, data page v1
+//   name  BYTE_ARRAY (UTF8) optional  dictionary, data page v1
+//   score DOUBLE optional             PLAIN, data page v2
+//   flag  BOOLEAN required            PLAIN, data page v1
+//   ts    INT64 TIMESTAMP(us, UTC)    PLAIN, data page v2
+//   day   INT32 DATE                  PLAIN, two data pages v1
+
+type (
+	trow struct {
+		name  string // empty: null
+		score float64
+		id    int64
+		ts    int64
+		day   int32
+		flag  bool
+		nulls bool // score is null
+	}
+	tenc struct {
+		b    []byte
+		last []int16
+	}
+	tchunk struct {
+		ptype        int32
+		numValues    int64
+		off, dictOff int64
+		compressed   int64
+	}
+)
+
+var testCodecs = []int32{codecNone, codecSnappy, codecGzip, codecZstd, codecLz4Raw}
+
+func TestParquetRowGroups(t *testing.T) {
+	var (
+		dir    = t.TempDir()
+		groups = [][]trow{genRows(0, 10), genRows(10, 7), genRows(17, 12)}
+	)
+	for _, codec := range testCodecs {
+		t.Run(fmt.Sprintf("codec-%d", codec), func(t *testing.T) {
+			fqn := filepath.Join(dir, fmt.Sprintf("table-%d%s", codec, ExtParquet))
+			b := writeParquet(t, groups, codec)
+			tassert.CheckFatal(t, os.WriteFile(fqn, b, 0o644))
+
+			lst, err := List(fqn)
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, len(lst) == len(groups), "expected %d row groups, got %d", len(groups), len(lst))
+			for i, e := range lst {
+				tassert.Errorf(t, e.Name == RowGroupName(i), "expected %q, got %q", RowGroupName(i), e.Name)
+				tassert.Errorf(t, e.NumRows == int64(len(groups[i])), "%s: expected %d rows, got %d", e.Name, len(groups[i]), e.NumRows)
+				tassert.Errorf(t, e.Size > 0, "%s: invalid size %d", e.Name, e.Size)
+			}
+
+			for i, rows := range groups {
+				rc, err := NewRowGroupReader(bytes.NewReader(b), int64(len(b)), fqn, RowGroupName(i))
+				tassert.CheckFatal(t, err)
+				tassert.Fatalf(t, rc != nil, "%s not found", RowGroupName(i))
+				out, err := io.ReadAll(rc)
+				tassert.CheckFatal(t, err)
+				tassert.Errorf(t, int64(len(out)) == rc.Size(), "size %d vs %d", len(out), rc.Size())
+				tassert.CheckFatal(t, rc.Close())
+				checkStream(t, out, rows)
+			}
+
+			rc, err := NewRowGroupReader(bytes.NewReader(b), int64(len(b)), fqn, RowGroupName(len(groups)))
+			tassert.Errorf(t, rc == nil && err == nil, "expected not found, got %v", err)
+		})
+	}
+}
+
+func TestArrowRecordBatches(t *testing.T) {
+	var (
+		groups = [][]trow{genRows(0, 5), genRows(5, 9), genRows(14, 3)}
+		pqb    = writeParquet(t, groups, codecNone)
+		pq     *pqFile
+		err    error
+	)
+	pq, err = openParquet(bytes.NewReader(pqb), int64(len(pqb)))
+	tassert.CheckFatal(t, err)
+	fields, err := pq.fields()
+	tassert.CheckFatal(t, err)
+	schemaLen := len(schemaMsg(fields))
+
+	// Arrow IPC file: magic, schema, record batches, EOS, footer
+	streams := make([][]byte, len(groups))
+	file := []byte(arrowMagic + "\x00\x00")
+	for i := range groups {
+		streams[i], err = pq.rowGroup(i)
+		tassert.CheckFatal(t, err)
+		s := streams[i][:len(streams[i])-len(eos)]
+		if i > 0 {
+			s = s[schemaLen:]
+		}
+		file = append(file, s...)
+	}
+	file = append(file, eos...)
+	file = append(file, "(footer)\x08\x00\x00\x00"+arrowMagic...)
+
+	for _, tc := range []struct {
+		name string
+		b    []byte
+	}{
+		{"file", file},
+		{"stream", file[8 : len(file)-len("(footer)\x08\x00\x00\x00"+arrowMagic)]},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fqn := filepath.Join(t.TempDir(), "table"+ExtArrow)
+			tassert.CheckFatal(t, os.WriteFile(fqn, tc.b, 0o644))
+			lst, err := List(fqn)
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, len(lst) == len(groups), "expected %d record batches, got %d", len(groups), len(lst))
+			for i, e := range lst {
+				tassert.Errorf(t, e.NumRows == int64(len(groups[i])), "%s: expected %d rows, got %d", e.Name, len(groups[i]), e.NumRows)
+			}
+			for i, rows := range groups {
+				rc, err := NewRowGroupReader(bytes.NewReader(tc.b), int64(len(tc.b)), fqn, "/"+RowGroupName(i))
+				tassert.CheckFatal(t, err)
+				tassert.Fatalf(t, rc != nil, "%s not found", RowGroupName(i))
+				out, err := io.ReadAll(rc)
+				tassert.CheckFatal(t, err)
+				tassert.Errorf(t, int64(len(out)) == rc.Size(), "size %d vs %d", len(out), rc.Size())
+				tassert.Errorf(t, bytes.Equal(out, streams[i]), "%s: stream mismatch", RowGroupName(i))
+				checkStream(t, out, rows)
+			}
+		})
+	}
+}
+
+func TestParseArchpath(t *testing.T) {
+	for _, tc := range []struct {
+		archpath string
+		idx      int
+		ok       bool
+	}{
+		{"rowgroup/0", 0, true},
+		{"rowgroup/17", 17, true},
+		{"/rowgroup/3", 3, true},
+		{"rowgroup/", 0, false},
+		{"rowgroup/03", 0, false},
+		{"rowgroup/-1", 0, false},
+		{"rowgroup/1/x", 0, false},
+		{"rowgroups/1", 0, false},
+		{"1", 0, false},
+	} {
+		idx, ok := parseArchpath(tc.archpath)
+		tassert.Errorf(t, ok == tc.ok && idx == tc.idx, "%q: expected (%d, %t), got (%d, %t)",
+			tc.archpath, tc.idx, tc.ok, idx, ok)
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	b := writeParquet(t, [][]trow{genRows(0, 4)}, codecSnappy)
+	for _, l := range []int{0, 4, 12, len(b) / 2, len(b) - 1} {
+		_, err := NewRowGroupReader(bytes.NewReader(b[:l]), int64(l), "x"+ExtParquet, RowGroupName(0))
+		tassert.Errorf(t, err != nil, "expected error for truncated (%d) parquet", l)
+	}
+	// corrupt the footer
+	c := bytes.Clone(b)
+	flen := int(binary.LittleEndian.Uint32(c[len(c)-8:]))
+	for i := len(c) - 8 - flen; i < len(c)-8; i++ {
+		c[i] = 0xff
+	}
+	_, err := NewRowGroupReader(bytes.NewReader(c), int64(len(c)), "x"+ExtParquet, RowGroupName(0))
+	tassert.Errorf(t, err != nil, "expected error for corrupted footer")
+
+	_, err = NewRowGroupReader(bytes.NewReader(b), int64(len(b)), "x"+ExtArrow, RowGroupName(0))
+	tassert.Errorf(t, err != nil, "expected error for parquet read as arrow")
+}
+
+func TestRowGroupTooLarge(t *testing.T) {
+	b := writeParquet(t, [][]trow{genRows(0, 100), genRows(100, 4)}, codecNone)
+
+	orig := maxRowGroupSize
+	defer func() { maxRowGroupSize = orig }()
+	maxRowGroupSize = 512
+
+	_, err := NewRowGroupReader(bytes.NewReader(b), int64(len(b)), "x"+ExtParquet, RowGroupName(0))
+	tassert.Fatalf(t, errors.Is(err, errTooLarge), "expected %v, got %v", errTooLarge, err)
+
+	rc, err := NewRowGroupReader(bytes.NewReader(b), int64(len(b)), "x"+ExtParquet, RowGroupName(1))
+	tassert.CheckFatal(t, err)
+	out, err := io.ReadAll(rc)
+	tassert.CheckFatal(t, err)
+	checkStream(t, out, genRows(100, 4))
+}
+
+// nested table (single row group, 4 rows):
+//
+//	id      INT64 required                           DELTA_BINARY_PACKED
+//	tags    optional LIST of optional UTF8 (3-level) DELTA_LENGTH_BYTE_ARRAY, data page v2
+//	pt      optional group {required INT32 x;        DELTA_BINARY_PACKED
+//	                        optional UTF8 label}     DELTA_BYTE_ARRAY
+//	legacy  repeated FLOAT (2-level list)            BYTE_STREAM_SPLIT
+//	m       optional MAP {required UTF8 key; optional INT32 value}
+func TestParquetNested(t *testing.T) {
+	for _, codec := range testCodecs {
+		t.Run(fmt.Sprintf("codec-%d", codec), func(t *testing.T) {
+			b := writeNested(t, codec)
+			rc, err := NewRowGroupReader(bytes.NewReader(b), int64(len(b)), "x"+ExtParquet, RowGroupName(0))
+			tassert.CheckFatal(t, err)
+			out, err := io.ReadAll(rc)
+			tassert.CheckFatal(t, err)
+			checkNested(t, out)
+		})
+	}
+}
+
+func TestDeltaBinaryPacked(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 31, 32, 33, 128, 129, 300, 1000} {
+		vals := make([]int64, n)
+		for i := range vals {
+			switch i % 4 {
+			case 0:
+				vals[i] = int64(i * i)
+			case 1:
+				vals[i] = -int64(i) << 20
+			case 2:
+				vals[i] = math.MaxInt64 - int64(i)
+			default:
+				vals[i] = math.MinInt64 + int64(i)
+			}
+		}
+		enc := deltaEnc(vals)
+		enc = append(enc, "trailer"...)
+		got, off, err := deltaBinaryPacked(enc, n)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, off == len(enc)-len("trailer"), "n=%d: consumed %d, expecting %d", n, off, len(enc)-len("trailer"))
+		tassert.Fatalf(t, len(got) == n, "n=%d: got %d values", n, len(got))
+		for i := range vals {
+			tassert.Fatalf(t, got[i] == vals[i], "n=%d: value %d: expected %d, got %d", n, i, vals[i], got[i])
+		}
+	}
+}
+
+func writeNested(t *testing.T, codec int32) []byte {
+	const nrows = 4
+	var (
+		file   = []byte(pqMagic)
+		chunks []*tchunk
+	)
+	add := func(ptype int32, n int, pages ...[]byte) {
+		chunks = append(chunks, &tchunk{ptype: ptype, numValues: int64(n), off: int64(len(file))})
+		for _, p := range pages {
+			file = append(file, p...)
+		}
+	}
+	levels := func(lvls []uint32, maxLevel uint32) []byte { return hybrid(lvls, bitLen(maxLevel)) }
+	prefixed := func(lvls []uint32, maxLevel uint32) []byte {
+		h := levels(lvls, maxLevel)
+		return append(binary.LittleEndian.AppendUint32(nil, uint32(len(h))), h...)
+	}
+
+	// id
+	add(ptInt64, nrows, pageV1(t, codec, deltaEnc([]int64{1, 3, -7, 100}), nrows, encDeltaBinaryPacked))
+
+	// tags: [a bb], null, [], [null c]
+	var (
+		reps = []uint32{0, 1, 0, 0, 0, 1}
+		defs = []uint32{3, 3, 0, 1, 2, 3}
+	)
+	add(ptByteArray, len(reps), pageV2(t, codec, levels(reps, 1), levels(defs, 3), deltaLengthEnc("a", "bb", "c"),
+		len(reps), nrows, encDeltaLengthByteArray))
+
+	// pt.x, pt.label: {10 alpha}, null, {-3 null}, {0 alpine}
+	data := prefixed([]uint32{1, 0, 1, 1}, 1)
+	add(ptInt32, nrows, pageV1(t, codec, append(data, deltaEnc([]int64{10, -3, 0})...), nrows, encDeltaBinaryPacked))
+	data = prefixed([]uint32{2, 0, 1, 2}, 2)
+	data = append(data, deltaEnc([]int64{0, 3})...)
+	data = append(data, deltaLengthEnc("alpha", "ine")...)
+	add(ptByteArray, nrows, pageV1(t, codec, data, nrows, encDeltaByteArray))
+
+	// legacy: [1.5], [], [2.5 -1], [0]
+	floats := []float32{1.5, 2.5, -1, 0}
+	data = prefixed([]uint32{0, 0, 0, 1, 0}, 1)
+	data = append(data, prefixed([]uint32{1, 0, 1, 1, 1}, 1)...)
+	for b := range 4 {
+		for _, f := range floats {
+			data = append(data, byte(math.Float32bits(f)>>(8*b)))
+		}
+	}
+	add(ptFloat, 5, pageV1(t, codec, data, 5, encByteStreamSplit))
+
+	// m.key, m.value: {k:1}, null, {}, {a:null b:2}
+	reps = []uint32{0, 0, 0, 0, 1}
+	data = prefixed(reps, 1)
+	data = append(data, prefixed([]uint32{2, 0, 1, 2, 2}, 2)...)
+	for _, k := range []string{"k", "a", "b"} {
+		data = binary.LittleEndian.AppendUint32(data, uint32(len(k)))
+		data = append(data, k...)
+	}
+	add(ptByteArray, 5, pageV1(t, codec, data, 5, encPlain))
+	data = prefixed(reps, 1)
+	data = append(data, prefixed([]uint32{3, 0, 1, 2, 3}, 3)...)
+	data = binary.LittleEndian.AppendUint32(data, 1)
+	data = binary.LittleEndian.AppendUint32(data, 2)
+	add(ptInt32, 5, pageV1(t, codec, data, 5, encPlain))
+
+	for i, c := range chunks {
+		end := int64(len(file))
+		if i < len(chunks)-1 {
+			end = chunks[i+1].start()
+		}
+		c.compressed = end - c.start()
+	}
+
+	// footer
+	e := &tenc{last: []int16{0}}
+	e.i32(1, 2)
+	e.list(2, ctStruct, 13)
+	e.elem("schema", -1, repRequired, -1, 5)
+	e.elem("id", ptInt64, repRequired, -1, 0)
+	e.elem("tags", -1, repOptional, cvList, 1)
+	e.elem("list", -1, repRepeated, -1, 1)
+	e.elem("element", ptByteArray, repOptional, cvUTF8, 0)
+	e.elem("pt", -1, repOptional, -1, 2)
+	e.elem("x", ptInt32, repRequired, -1, 0)
+	e.elem("label", ptByteArray, repOptional, cvUTF8, 0)
+	e.elem("legacy", ptFloat, repRepeated, -1, 0)
+	e.elem("m", -1, repOptional, cvMap, 1)
+	e.elem("key_value", -1, repRepeated, -1, 2)
+	e.elem("key", ptByteArray, repRequired, cvUTF8, 0)
+	e.elem("value", ptInt32, repOptional, -1, 0)
+	e.i64(3, nrows)
+	e.rowGroups([][]*tchunk{chunks}, []int64{nrows}, codec)
+	e.b = append(e.b, 0)
+	file = append(file, e.b...)
+	file = binary.LittleEndian.AppendUint32(file, uint32(len(e.b)))
+	return append(file, pqMagic...)
+}
+
+// fixtures produced by pyarrow (see testdata/gen.py)
+func TestGolden(t *testing.T) {
+	exps, err := filepath.Glob(filepath.Join("testdata", "*.json"))
+	tassert.CheckFatal(t, err)
+	if len(exps) == 0 {
+		t.Skip("no fixtures - to generate, run testdata/gen.py")
+	}
+	for _, fexp := range exps {
+		fqn := strings.TrimSuffix(fexp, ".json")
+		t.Run(filepath.Base(fqn), func(t *testing.T) {
+			var exp struct {
+				Fields    []string `json:"fields"`
+				RowGroups []struct {
+					NumRows    int64   `json:"num_rows"`
+					NullCounts []int64 `json:"null_counts"`
+				} `json:"row_groups"`
+			}
+			b, err := os.ReadFile(fexp)
+			tassert.CheckFatal(t, err)
+			tassert.CheckFatal(t, json.Unmarshal(b, &exp))
+
+			lst, err := List(fqn)
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, len(lst) == len(exp.RowGroups), "expected %d row groups, got %d", len(exp.RowGroups), len(lst))
+			b, err = os.ReadFile(fqn)
+			tassert.CheckFatal(t, err)
+			for i, rg := range exp.RowGroups {
+				tassert.Errorf(t, lst[i].NumRows == rg.NumRows, "%s: expected %d rows, got %d", lst[i].Name, rg.NumRows, lst[i].NumRows)
+
+				rc, err := NewRowGroupReader(bytes.NewReader(b), int64(len(b)), fqn, RowGroupName(i))
+				tassert.CheckFatal(t, err)
+				out, err := io.ReadAll(rc)
+				tassert.CheckFatal(t, err)
+				names, nodes := topLevel(t, out)
+				tassert.Fatalf(t, slices.Equal(names, exp.Fields), "expected fields %v, got %v", exp.Fields, names)
+				for j, nd := range nodes {
+					tassert.Errorf(t, nd[0] == rg.NumRows && nd[1] == rg.NullCounts[j], "%s, %q: expected (%d, %d), got (%d, %d)",
+						lst[i].Name, names[j], rg.NumRows, rg.NullCounts[j], nd[0], nd[1])
+				}
+			}
+		})
+	}
+}
+
+// top-level field names and their (length, null count) in a given Arrow IPC stream
+func topLevel(t *testing.T, b []byte) (names []string, nodes [][2]int64) {
+	msgs, err := scanIPC(bytes.NewReader(b), int64(len(b)))
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(msgs) == 2 && msgs[0].typ == mhSchema && msgs[1].typ == mhRecordBatch, "invalid stream")
+	var (
+		st    = header(b, msgs[0])
+		rb    = header(b, msgs[1])
+		o     = flatbuffers.UOffsetT(st.Offset(6))
+		vec   = st.Vector(o)
+		nvec  = rb.Vector(flatbuffers.UOffsetT(rb.Offset(6)))
+		ni    int
+		count func(f *flatbuffers.Table) int
+	)
+	count = func(f *flatbuffers.Table) int { // field nodes in the subtree
+		n := 1
+		co := flatbuffers.UOffsetT(f.Offset(14))
+		cvec := f.Vector(co)
+		for i := range f.VectorLen(co) {
+			c := &flatbuffers.Table{Bytes: f.Bytes}
+			c.Pos = f.Indirect(cvec + flatbuffers.UOffsetT(i*4))
+			n += count(c)
+		}
+		return n
+	}
+	for i := range st.VectorLen(o) {
+		f := &flatbuffers.Table{Bytes: st.Bytes}
+		f.Pos = st.Indirect(vec + flatbuffers.UOffsetT(i*4))
+		names = append(names, f.String(f.Pos+flatbuffers.UOffsetT(f.Offset(4))))
+		pos := nvec + flatbuffers.UOffsetT(ni*16)
+		nodes = append(nodes, [2]int64{rb.GetInt64(pos), rb.GetInt64(pos + 8)})
+		ni += count(f)
+	}
+	return names, nodes
+}
+
+func genRows(start, n int) []trow {
+	rows := make([]trow, n)
+	for i := range rows {
+		k := start + i
+		rows[i] = trow{
+			id:    int64(k) * 1000,
+			score: float64(k) / 3,
+			nulls: k%4 == 1,
+			flag:  k%3 == 0,
+			ts:    1_700_000_000_000_000 + int64(k),
+			day:   int32(19_000 + k),
+		}
+		if k%3 != 2 {
+			rows[i].name = fmt.Sprintf("name-%d", k%5) // (dictionary-friendly)
+		}
+	}
+	return rows
+}
+
+//
+// validate Arrow IPC stream
+//
+
+func checkStream(t *testing.T, b []byte, rows []trow) {
+	msgs, err := scanIPC(bytes.NewReader(b), int64(len(b)))
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(msgs) == 2 && msgs[0].typ == mhSchema && msgs[1].typ == mhRecordBatch, "invalid stream")
+	tassert.Fatalf(t, msgs[1].nrows == int64(len(rows)), "expected %d rows, got %d", len(rows), msgs[1].nrows)
+	tassert.Fatalf(t, msgs[1].off+msgs[1].metaLen+msgs[1].bodyLen+int64(len(eos)) == int64(len(b)), "invalid length")
+
+	// schema
+	type tfield struct {
+		name     string
+		typ      byte
+		nullable bool
+	}
+	expected := []tfield{{"id", atInt, false}, {"name", atUtf8, true}, {"score", atFloat, true},
+		{"flag", atBool, false}, {"ts", atTimestamp, false}, {"day", atDate, false}}
+	st := header(b, msgs[0])
+	o := flatbuffers.UOffsetT(st.Offset(6))
+	tassert.Fatalf(t, st.VectorLen(o) == len(expected), "expected %d fields", len(expected))
+	vec := st.Vector(o)
+	for i, exp := range expected {
+		f := &flatbuffers.Table{Bytes: st.Bytes}
+		f.Pos = st.Indirect(vec + flatbuffers.UOffsetT(i*4))
+		name := f.String(f.Pos + flatbuffers.UOffsetT(f.Offset(4)))
+		got := tfield{name, f.GetByteSlot(8, 0), f.GetBoolSlot(6, false)}
+		tassert.Errorf(t, got == exp, "field %d: expected %+v, got %+v", i, exp, got)
+	}
+
+	// record batch
+	var (
+		rb    = header(b, msgs[1])
+		body  = b[msgs[1].off+msgs[1].metaLen : msgs[1].off+msgs[1].metaLen+msgs[1].bodyLen]
+		nodes = rb.Vector(flatbuffers.UOffsetT(rb.Offset(6)))
+		bufs  = rb.Vector(flatbuffers.UOffsetT(rb.Offset(8)))
+		bi    int
+	)
+	buffer := func() []byte {
+		pos := bufs + flatbuffers.UOffsetT(bi*16)
+		bi++
+		off, l := rb.GetInt64(pos), rb.GetInt64(pos+8)
+		tassert.Fatalf(t, off%arrowAlign == 0 && off+l <= int64(len(body)), "invalid buffer (%d, %d)", off, l)
+		return body[off : off+l]
+	}
+	nulls := func(col int) int64 { return rb.GetInt64(nodes + flatbuffers.UOffsetT(col*16) + 8) }
+	isSet := func(bm []byte, i int) bool { return bm[i>>3]&(1<<(i&7)) != 0 }
+	isValid := func(bm []byte, i int) bool { return len(bm) == 0 || isSet(bm, i) } // (no nulls => no validity bitmap)
+
+	// id
+	_, ids := buffer(), buffer()
+	// name
+	nameValid, nameOffs, nameData := buffer(), buffer(), buffer()
+	// score
+	scoreValid, scores := buffer(), buffer()
+	// flag
+	_, flags := buffer(), buffer()
+	// ts, day
+	_, tss := buffer(), buffer()
+	_, days := buffer(), buffer()
+
+	var nnames, nscores int64
+	for i, row := range rows {
+		tassert.Errorf(t, int64(binary.LittleEndian.Uint64(ids[i*8:])) == row.id, "row %d: id", i)
+		if row.name == "" {
+			nnames++
+			tassert.Errorf(t, !isValid(nameValid, i), "row %d: expected null name", i)
+		} else {
+			tassert.Errorf(t, isValid(nameValid, i), "row %d: unexpected null name", i)
+			s, e := binary.LittleEndian.Uint32(nameOffs[i*4:]), binary.LittleEndian.Uint32(nameOffs[i*4+4:])
+			tassert.Errorf(t, string(nameData[s:e]) == row.name, "row %d: expected %q, got %q", i, row.name, nameData[s:e])
+		}
+		if row.nulls {
+			nscores++
+			tassert.Errorf(t, !isValid(scoreValid, i), "row %d: expected null score", i)
+		} else {
+			v := math.Float64frombits(binary.LittleEndian.Uint64(scores[i*8:]))
+			tassert.Errorf(t, isValid(scoreValid, i) && v == row.score, "row %d: expected %f, got %f", i, row.score, v)
+		}
+		tassert.Errorf(t, isSet(flags, i) == row.flag, "row %d: flag", i)
+		tassert.Errorf(t, int64(binary.LittleEndian.Uint64(tss[i*8:])) == row.ts, "row %d: ts", i)
+		tassert.Errorf(t, int32(binary.LittleEndian.Uint32(days[i*4:])) == row.day, "row %d: day", i)
+	}
+	tassert.Errorf(t, nulls(0) == 0 && nulls(1) == nnames && nulls(2) == nscores, "null counts")
+}
+
+func checkNested(t *testing.T, b []byte) {
+	msgs, err := scanIPC(bytes.NewReader(b), int64(len(b)))
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(msgs) == 2 && msgs[1].nrows == 4, "invalid stream")
+
+	// schema: top-level fields and their children (depth-first)
+	type tfield struct {
+		name     string
+		typ      byte
+		nullable bool
+		children int
+	}
+	var (
+		got      []tfield
+		expected = []tfield{{"id", atInt, false, 0},
+			{"tags", atList, true, 1}, {"element", atUtf8, true, 0},
+			{"pt", atStruct, true, 2}, {"x", atInt, false, 0}, {"label", atUtf8, true, 0},
+			{"legacy", atList, false, 1}, {"legacy", atFloat, false, 0},
+			{"m", atMap, true, 1}, {"key_value", atStruct, false, 2}, {"key", atUtf8, false, 0}, {"value", atInt, true, 0}}
+		walk func(tab *flatbuffers.Table, o flatbuffers.UOffsetT)
+	)
+	walk = func(tab *flatbuffers.Table, o flatbuffers.UOffsetT) {
+		vec := tab.Vector(o)
+		for i := range tab.VectorLen(o) {
+			f := &flatbuffers.Table{Bytes: tab.Bytes}
+			f.Pos = tab.Indirect(vec + flatbuffers.UOffsetT(i*4))
+			co := flatbuffers.UOffsetT(f.Offset(14))
+			got = append(got, tfield{f.String(f.Pos + flatbuffers.UOffsetT(f.Offset(4))), f.GetByteSlot(8, 0), f.GetBoolSlot(6, false), f.VectorLen(co)})
+			walk(f, co)
+		}
+	}
+	st := header(b, msgs[0])
+	walk(st, flatbuffers.UOffsetT(st.Offset(6)))
+	tassert.Fatalf(t, len(got) == len(expected), "expected %d fields, got %d", len(expected), len(got))
+	for i := range expected {
+		tassert.Errorf(t, got[i] == expected[i], "field %d: expected %+v, got %+v", i, expected[i], got[i])
+	}
+
+	// record batch: field nodes and buffers (depth-first)
+	var (
+		rb    = header(b, msgs[1])
+		body  = b[msgs[1].off+msgs[1].metaLen : msgs[1].off+msgs[1].metaLen+msgs[1].bodyLen]
+		nodes = rb.Vector(flatbuffers.UOffsetT(rb.Offset(6)))
+		bufs  = rb.Vector(flatbuffers.UOffsetT(rb.Offset(8)))
+		ni    int
+		bi    int
+	)
+	node := func(length, nulls int64) {
+		pos := nodes + flatbuffers.UOffsetT(ni*16)
+		tassert.Errorf(t, rb.GetInt64(pos) == length && rb.GetInt64(pos+8) == nulls, "node %d: expected (%d, %d), got (%d, %d)",
+			ni, length, nulls, rb.GetInt64(pos), rb.GetInt64(pos+8))
+		ni++
+	}
+	buffer := func() []byte {
+		pos := bufs + flatbuffers.UOffsetT(bi*16)
+		bi++
+		off, l := rb.GetInt64(pos), rb.GetInt64(pos+8)
+		tassert.Fatalf(t, off%arrowAlign == 0 && off+l <= int64(len(body)), "invalid buffer (%d, %d)", off, l)
+		return body[off : off+l]
+	}
+	validity := func(exp ...bool) {
+		bm := buffer()
+		for i, v := range exp {
+			tassert.Errorf(t, (len(bm) == 0 || bm[i>>3]&(1<<(i&7)) != 0) == v, "buffer %d: validity(%d) != %t", bi-1, i, v)
+		}
+	}
+	offsets := func(exp ...uint32) {
+		o := buffer()
+		tassert.Fatalf(t, len(o) == 4*len(exp), "buffer %d: expected %d offsets, got %d", bi-1, len(exp), len(o)/4)
+		for i, v := range exp {
+			tassert.Errorf(t, binary.LittleEndian.Uint32(o[i*4:]) == v, "buffer %d: offset(%d) != %d", bi-1, i, v)
+		}
+	}
+	strs := func(exp ...string) {
+		o, data := buffer(), buffer()
+		for i, v := range exp {
+			s, e := binary.LittleEndian.Uint32(o[i*4:]), binary.LittleEndian.Uint32(o[i*4+4:])
+			tassert.Errorf(t, string(data[s:e]) == v, "value %d: expected %q, got %q", i, v, data[s:e])
+		}
+	}
+	ints := func(width int, exp ...int64) {
+		data := buffer()
+		for i, v := range exp {
+			var x int64
+			if width == 4 {
+				x = int64(int32(binary.LittleEndian.Uint32(data[i*4:])))
+			} else {
+				x = int64(binary.LittleEndian.Uint64(data[i*8:]))
+			}
+			tassert.Errorf(t, x == v, "value %d: expected %d, got %d", i, v, x)
+		}
+	}
+
+	// id
+	node(4, 0)
+	validity()
+	ints(8, 1, 3, -7, 100)
+	// tags
+	node(4, 1)
+	validity(true, false, true, true)
+	offsets(0, 2, 2, 2, 4)
+	node(4, 1)
+	validity(true, true, false, true)
+	strs("a", "bb", "", "c")
+	// pt
+	node(4, 1)
+	validity(true, false, true, true)
+	node(4, 1)
+	validity(true, false, true, true)
+	ints(4, 10, 0, -3, 0)
+	node(4, 2)
+	validity(true, false, false, true)
+	strs("alpha", "", "", "alpine")
+	// legacy
+	node(4, 0)
+	validity()
+	offsets(0, 1, 1, 3, 4)
+	node(4, 0)
+	validity()
+	data := buffer()
+	for i, f := range []float32{1.5, 2.5, -1, 0} {
+		v := math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:]))
+		tassert.Errorf(t, v == f, "legacy value %d: expected %f, got %f", i, f, v)
+	}
+	// m
+	node(4, 1)
+	validity(true, false, true, true)
+	offsets(0, 1, 1, 1, 3)
+	node(3, 0)
+	validity()
+	node(3, 0)
+	validity()
+	strs("k", "a", "b")
+	node(3, 1)
+	validity(true, false, true)
+	ints(4, 1, 0, 2)
+
+	tassert.Errorf(t, ni == rb.VectorLen(flatbuffers.UOffsetT(rb.Offset(6))), "nodes: %d", ni)
+	tassert.Errorf(t, bi == rb.VectorLen(flatbuffers.UOffsetT(rb.Offset(8))), "buffers: %d", bi)
+}
+
+func header(b []byte, msg *ipcMsg) *flatbuffers.Table {
+	prefix := msg.metaLen - int64(len(b[msg.off+8:msg.off+msg.metaLen])) // (always 8)
+	fb := b[msg.off+prefix : msg.off+msg.metaLen]
+	m := &flatbuffers.Table{Bytes: fb, Pos: flatbuffers.GetUOffsetT(fb)}
+	h := &flatbuffers.Table{}
+	m.Union(h, flatbuffers.UOffsetT(m.Offset(8)))
+	return h
+}
+
+//
+// minimal Parquet writer
+//
+
+func writeParquet(t *testing.T, groups [][]trow, codec int32) []byte {
+	var (
+		file      = []byte(pqMagic)
+		rgs       [][]*tchunk
+		totalRows int64
+	)
+	for _, rows := range groups {
+		var chunks []*tchunk
+		n := len(rows)
+		totalRows += int64(n)
+
+		// id: required INT64, PLAIN, v1
+		vals := make([]byte, 0, n*8)
+		for _, r := range rows {
+			vals = binary.LittleEndian.AppendUint64(vals, uint64(r.id))
+		}
+		c := &tchunk{ptype: ptInt64, numValues: int64(n), off: int64(len(file))}
+		file = append(file, pageV1(t, codec, vals, n, encPlain)...)
+		chunks = append(chunks, c)
+
+		// name: optional UTF8, dictionary
+		var (
+			dict    []string
+			dictIdx = map[string]uint32{}
+			idx     []uint32
+			defs    = make([]uint32, n)
+		)
+		for i, r := range rows {
+			if r.name == "" {
+				continue
+			}
+			defs[i] = 1
+			k, ok := dictIdx[r.name]
+			if !ok {
+				k = uint32(len(dict))
+				dictIdx[r.name] = k
+				dict = append(dict, r.name)
+			}
+			idx = append(idx, k)
+		}
+		var dvals []byte
+		for _, s := range dict {
+			dvals = binary.LittleEndian.AppendUint32(dvals, uint32(len(s)))
+			dvals = append(dvals, s...)
+		}
+		c = &tchunk{ptype: ptByteArray, numValues: int64(n), dictOff: int64(len(file))}
+		file = append(file, pageDict(t, codec, dvals, len(dict))...)
+		c.off = int64(len(file))
+		bw := max(1, bitLen(uint32(len(dict)-1)))
+		data := levelsV1(defs)
+		data = append(data, byte(bw))
+		data = append(data, hybrid(idx, bw)...)
+		file = append(file, pageV1(t, codec, data, n, encRLEDictionary)...)
+		chunks = append(chunks, c)
+
+		// score: optional DOUBLE, PLAIN, v2
+		vals = vals[:0]
+		for i, r := range rows {
+			defs[i] = 0
+			if !r.nulls {
+				defs[i] = 1
+				vals = binary.LittleEndian.AppendUint64(vals, math.Float64bits(r.score))
+			}
+		}
+		c = &tchunk{ptype: ptDouble, numValues: int64(n), off: int64(len(file))}
+		file = append(file, pageV2(t, codec, nil, hybrid(defs, 1), vals, n, n, encPlain)...)
+		chunks = append(chunks, c)
+
+		// flag: required BOOLEAN, PLAIN (bit-packed), v1
+		vals = make([]byte, (n+7)/8)
+		for i, r := range rows {
+			if r.flag {
+				vals[i>>3] |= 1 << (i & 7)
+			}
+		}
+		c = &tchunk{ptype: ptBoolean, numValues: int64(n), off: int64(len(file))}
+		file = append(file, pageV1(t, codec, vals, n, encPlain)...)
+		chunks = append(chunks, c)
+
+		// ts: required INT64 TIMESTAMP, PLAIN, v2
+		vals = vals[:0]
+		for _, r := range rows {
+			vals = binary.LittleEndian.AppendUint64(vals, uint64(r.ts))
+		}
+		c = &tchunk{ptype: ptInt64, numValues: int64(n), off: int64(len(file))}
+		file = append(file, pageV2(t, codec, nil, nil, vals, n, n, encPlain)...)
+		chunks = append(chunks, c)
+
+		// day: required INT32 DATE, PLAIN, two pages
+		c = &tchunk{ptype: ptInt32, numValues: int64(n), off: int64(len(file))}
+		for _, part := range [][]trow{rows[:n/2], rows[n/2:]} {
+			vals = vals[:0]
+			for _, r := range part {
+				vals = binary.LittleEndian.AppendUint32(vals, uint32(r.day))
+			}
+			file = append(file, pageV1(t, codec, vals, len(part), encPlain)...)
+		}
+		chunks = append(chunks, c)
+
+		// chunk sizes
+		for i, c := range chunks {
+			end := int64(len(file))
+			if i < len(chunks)-1 {
+				end = chunks[i+1].start()
+			}
+			c.compressed = end - c.start()
+		}
+		rgs = append(rgs, chunks)
+	}
+
+	footer := footerBytes(rgs, groups, totalRows, codec)
+	file = append(file, footer...)
+	file = binary.LittleEndian.AppendUint32(file, uint32(len(footer)))
+	return append(file, pqMagic...)
+}
+
+func (c *tchunk) start() int64 {
+	if c.dictOff > 0 {
+		return c.dictOff
+	}
+	return c.off
+}
+
+func footerBytes(rgs [][]*tchunk, groups [][]trow, totalRows int64, codec int32) []byte {
+	e := &tenc{last: []int16{0}}
+	e.i32(1, 2) // version
+	e.list(2, ctStruct, 7)
+	{
+		e.begin() // root
+		e.str(4, "schema")
+		e.i32(5, 6)
+		e.end()
+
+		e.begin()
+		e.i32(1, ptInt64)
+		e.i32(3, repRequired)
+		e.str(4, "id")
+		e.end()
+
+		e.begin()
+		e.i32(1, ptByteArray)
+		e.i32(3, repOptional)
+		e.str(4, "name")
+		e.i32(6, cvUTF8)
+		e.field(10, ctStruct) // logicalType: STRING
+		e.begin()
+		e.field(ltString, ctStruct)
+		e.begin()
+		e.end()
+		e.end()
+		e.end()
+
+		e.begin()
+		e.i32(1, ptDouble)
+		e.i32(3, repOptional)
+		e.str(4, "score")
+		e.end()
+
+		e.begin()
+		e.i32(1, ptBoolean)
+		e.i32(3, repRequired)
+		e.str(4, "flag")
+		e.end()
+
+		e.begin()
+		e.i32(1, ptInt64)
+		e.i32(3, repRequired)
+		e.str(4, "ts")
+		e.field(10, ctStruct) // logicalType: TIMESTAMP(isAdjustedToUTC, MICROS)
+		e.begin()
+		e.field(ltTimestamp, ctStruct)
+		e.begin()
+		e.field(1, ctTrue)
+		e.field(2, ctStruct)
+		e.begin()
+		e.field(2, ctStruct)
+		e.begin()
+		e.end()
+		e.end()
+		e.end()
+		e.end()
+		e.end()
+
+		e.begin()
+		e.i32(1, ptInt32)
+		e.i32(3, repRequired)
+		e.str(4, "day")
+		e.i32(6, cvDate)
+		e.end()
+	}
+	e.i64(3, totalRows)
+	nrows := make([]int64, len(groups))
+	for i, rows := range groups {
+		nrows[i] = int64(len(rows))
+	}
+	e.rowGroups(rgs, nrows, codec)
+	e.str(6, "aistore test") // created_by (skipped)
+	e.b = append(e.b, 0)
+	return e.b
+}
+
+func (e *tenc) rowGroups(rgs [][]*tchunk, nrows []int64, codec int32) {
+	e.list(4, ctStruct, len(rgs))
+	for i, chunks := range rgs {
+		e.begin()
+		e.list(1, ctStruct, len(chunks))
+		var total int64
+		for _, c := range chunks {
+			total += c.compressed
+			e.begin()
+			e.i64(2, c.off)
+			e.field(3, ctStruct)
+			e.begin()
+			e.i32(1, c.ptype)
+			e.list(2, ctI32, 2) // encodings
+			e.b = appendZigzag(e.b, encPlain)
+			e.b = appendZigzag(e.b, encRLE)
+			e.list(3, ctBinary, 1) // path_in_schema
+			e.b = binary.AppendUvarint(e.b, 1)
+			e.b = append(e.b, 'x')
+			e.i32(4, codec)
+			e.i64(5, c.numValues)
+			e.i64(6, c.compressed)
+			e.i64(7, c.compressed)
+			e.i64(9, c.off)
+			if c.dictOff > 0 {
+				e.i64(11, c.dictOff)
+			}
+			e.end()
+			e.end()
+		}
+		e.i64(2, total)
+		e.i64(3, nrows[i])
+		e.end()
+	}
+}
+
+func pageV1(t *testing.T, codec int32, data []byte, n int, enc int32) []byte {
+	comp := compress(t, codec, data)
+	e := &tenc{last: []int16{0}}
+	e.i32(1, pageData)
+	e.i32(2, int32(len(data)))
+	e.i32(3, int32(len(comp)))
+	e.field(5, ctStruct)
+	e.begin()
+	e.i32(1, int32(n))
+	e.i32(2, enc)
+	e.i32(3, encRLE)
+	e.i32(4, encRLE)
+	e.end()
+	e.b = append(e.b, 0)
+	return append(e.b, comp...)
+}
+
+func pageDict(t *testing.T, codec int32, data []byte, n int) []byte {
+	comp := compress(t, codec, data)
+	e := &tenc{last: []int16{0}}
+	e.i32(1, pageDictionary)
+	e.i32(2, int32(len(data)))
+	e.i32(3, int32(len(comp)))
+	e.field(7, ctStruct)
+	e.begin()
+	e.i32(1, int32(n))
+	e.i32(2, encPlainDict)
+	e.end()
+	e.b = append(e.b, 0)
+	return append(e.b, comp...)
+}
+
+func pageV2(t *testing.T, codec int32, reps, defs, vals []byte, n, nrows int, enc int32) []byte {
+	comp := compress(t, codec, vals)
+	e := &tenc{last: []int16{0}}
+	e.i32(1, pageDataV2)
+	e.i32(2, int32(len(reps)+len(defs)+len(vals)))
+	e.i32(3, int32(len(reps)+len(defs)+len(comp)))
+	e.field(8, ctStruct)
+	e.begin()
+	e.i32(1, int32(n))
+	e.i32(3, int32(nrows))
+	e.i32(4, enc)
+	e.i32(5, int32(len(defs)))
+	e.i32(6, int32(len(reps)))
+	e.field(7, ctTrue)
+	e.end()
+	e.b = append(e.b, 0)
+	e.b = append(e.b, reps...)
+	e.b = append(e.b, defs...)
+	return append(e.b, comp...)
+}
+
+// DELTA_BINARY_PACKED: blocks of 128 values, 4 miniblocks each
+func deltaEnc(vals []int64) []byte {
+	const (
+		blockSize = 128
+		perMini   = 32
+	)
+	var first int64
+	if len(vals) > 0 {
+		first = vals[0]
+	}
+	b := binary.AppendUvarint(nil, blockSize)
+	b = binary.AppendUvarint(b, blockSize/perMini)
+	b = binary.AppendUvarint(b, uint64(len(vals)))
+	b = appendZigzag(b, first)
+	for start := 1; start < len(vals); start += blockSize {
+		deltas := make([]uint64, 0, blockSize)
+		for i := start; i < min(start+blockSize, len(vals)); i++ {
+			deltas = append(deltas, uint64(vals[i])-uint64(vals[i-1]))
+		}
+		minDelta := int64(deltas[0])
+		for _, d := range deltas {
+			minDelta = min(minDelta, int64(d))
+		}
+		b = appendZigzag(b, minDelta)
+		var (
+			widths [blockSize / perMini]byte
+			minis  = (len(deltas) + perMini - 1) / perMini
+		)
+		for m := range minis {
+			for _, d := range deltas[m*perMini : min((m+1)*perMini, len(deltas))] {
+				widths[m] = max(widths[m], byte(bits.Len64(d-uint64(minDelta))))
+			}
+		}
+		b = append(b, widths[:]...)
+		for m := range minis {
+			packed := make([]byte, perMini*int(widths[m])/8)
+			for i, d := range deltas[m*perMini : min((m+1)*perMini, len(deltas))] {
+				v := d - uint64(minDelta)
+				for j := range int(widths[m]) {
+					if v&(1<<j) != 0 {
+						bit := i*int(widths[m]) + j
+						packed[bit>>3] |= 1 << (bit & 7)
+					}
+				}
+			}
+			b = append(b, packed...)
+		}
+	}
+	return b
+}
+
+// DELTA_LENGTH_BYTE_ARRAY
+func deltaLengthEnc(vals ...string) []byte {
+	lens := make([]int64, len(vals))
+	for i, v := range vals {
+		lens[i] = int64(len(v))
+	}
+	b := deltaEnc(lens)
+	for _, v := range vals {
+		b = append(b, v...)
+	}
+	return b
+}
+
+func (e *tenc) elem(name string, ptype, rep, converted, children int32) {
+	e.begin()
+	if ptype >= 0 {
+		e.i32(1, ptype)
+	}
+	e.i32(3, rep)
+	e.str(4, name)
+	if children > 0 {
+		e.i32(5, children)
+	}
+	if converted >= 0 {
+		e.i32(6, converted)
+	}
+	e.end()
+}
+
+// v1 definition levels: 4-byte length followed by RLE/bit-packed hybrid
+func levelsV1(defs []uint32) []byte {
+	h := hybrid(defs, 1)
+	return append(binary.LittleEndian.AppendUint32(nil, uint32(len(h))), h...)
+}
+
+// all-equal values => single RLE run; otherwise, a single bit-packed run
+func hybrid(vals []uint32, bw int) []byte {
+	var b []byte
+	same := true
+	for _, v := range vals {
+		same = same && v == vals[0]
+	}
+	if same && len(vals) > 0 {
+		b = binary.AppendUvarint(b, uint64(len(vals))<<1)
+		for i := range (bw + 7) / 8 {
+			b = append(b, byte(vals[0]>>(8*i)))
+		}
+		return b
+	}
+	groups := (len(vals) + 7) / 8
+	b = binary.AppendUvarint(b, uint64(groups)<<1|1)
+	packed := make([]byte, groups*bw)
+	for i, v := range vals {
+		for j := range bw {
+			if v&(1<<j) != 0 {
+				bit := i*bw + j
+				packed[bit>>3] |= 1 << (bit & 7)
+			}
+		}
+	}
+	return append(b, packed...)
+}
+
+func bitLen(v uint32) (n int) {
+	for ; v > 0; v >>= 1 {
+		n++
+	}
+	return n
+}
+
+func compress(t *testing.T, codec int32, data []byte) []byte {
+	switch codec {
+	case codecSnappy:
+		return snappy.Encode(nil, data)
+	case codecGzip:
+		var buf bytes.Buffer
+		gzw := gzip.NewWriter(&buf)
+		_, err := gzw.Write(data)
+		tassert.CheckFatal(t, err)
+		tassert.CheckFatal(t, gzw.Close())
+		return buf.Bytes()
+	case codecZstd:
+		zw, err := zstd.NewWriter(nil)
+		tassert.CheckFatal(t, err)
+		defer zw.Close()
+		return zw.EncodeAll(data, nil)
+	case codecLz4Raw:
+		dst := make([]byte, lz4.CompressBlockBound(len(data)))
+		n, err := lz4.CompressBlock(data, dst, nil)
+		tassert.CheckFatal(t, err)
+		return dst[:n]
+	default:
+		return data
+	}
+}
+
+//
+// thrift compact encoder
+//
+
+func (e *tenc) field(id int16, typ byte) {
+	last := &e.last[len(e.last)-1]
+	if d := id - *last; d > 0 && d <= 15 {
+		e.b = append(e.b, byte(d)<<4|typ)
+	} else {
+		e.b = append(e.b, typ)
+		e.b = appendZigzag(e.b, int64(id))
+	}
+	*last = id
+}
+
+func (e *tenc) begin() { e.last = append(e.last, 0) }
+
+func (e *tenc) end() {
+	e.b = append(e.b, ctStop)
+	e.last = e.last[:len(e.last)-1]
+}
+
+func (e *tenc) i32(id int16, v int32) { e.field(id, ctI32); e.b = appendZigzag(e.b, int64(v)) }
+func (e *tenc) i64(id int16, v int64) { e.field(id, ctI64); e.b = appendZigzag(e.b, v) }
+
+func (e *tenc) str(id int16, s string) {
+	e.field(id, ctBinary)
+	e.b = binary.AppendUvarint(e.b, uint64(len(s)))
+	e.b = append(e.b, s...)
+}
+
+func (e *tenc) list(id int16, etyp byte, n int) {
+	e.field(id, ctList)
+	if n < 15 {
+		e.b = append(e.b, byte(n)<<4|etyp)
+		return
+	}
+	e.b = append(e.b, 0xf0|etyp)
+	e.b = binary.AppendUvarint(e.b, uint64(n))
+}
+
+func appendZigzag(b []byte, v int64) []byte {
+	return binary.AppendUvarint(b, uint64(v<<1^(v>>63)))
+}
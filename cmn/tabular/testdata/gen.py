@@ -0,0 +1,108 @@
+#!/usr/bin/env python3
+"""
+Generate golden Parquet and Arrow IPC fixtures (and their expectations) for cmn/tabular.
+
+Usage (from this directory):
+    pip install pyarrow
+    python3 gen.py
+
+For each fixture, writes <name> and <name>.json with row counts and per-column
+null counts of each row group (record batch) - see TestGolden.
+"""
+
+import json
+from datetime import date, datetime, timezone
+
+import pyarrow as pa
+import pyarrow.ipc as ipc
+import pyarrow.parquet as pq
+
+ROWS_PER_GROUP = 4
+
+
+def flat(n):
+    return pa.table(
+        {
+            "id": pa.array(range(n), pa.int64()),
+            "name": pa.array([None if i % 3 == 2 else f"name-{i % 5}" for i in range(n)], pa.string()),
+            "score": pa.array([None if i % 4 == 1 else i / 3 for i in range(n)], pa.float64()),
+            "flag": pa.array([i % 3 == 0 for i in range(n)], pa.bool_()),
+            "ts": pa.array(
+                [int(datetime(2024, 1, 1, tzinfo=timezone.utc).timestamp()) * 1_000_000 + i for i in range(n)],
+                pa.timestamp("us", tz="UTC"),
+            ),
+            "day": pa.array([date(2024, 1, 1 + i % 28) for i in range(n)], pa.date32()),
+            "fixed": pa.array([bytes([i % 256]) * 4 for i in range(n)], pa.binary(4)),
+        }
+    )
+
+
+def nested(n):
+    return pa.table(
+        {
+            "id": pa.array([i * 1000 - 7 for i in range(n)], pa.int64()),
+            "tags": pa.array(
+                [None if i % 5 == 1 else [None if j == 1 else f"t{i}-{j}" for j in range(i % 4)] for i in range(n)],
+                pa.list_(pa.string()),
+            ),
+            "pt": pa.array(
+                [None if i % 6 == 2 else {"x": i, "label": None if i % 2 else f"label-{i}"} for i in range(n)],
+                pa.struct([pa.field("x", pa.int32(), nullable=False), pa.field("label", pa.string())]),
+            ),
+            "m": pa.array(
+                [None if i % 7 == 3 else [(f"k{j}", None if j == 2 else j) for j in range(i % 3)] for i in range(n)],
+                pa.map_(pa.string(), pa.int32()),
+            ),
+            "nums": pa.array([[float(j) for j in range(i % 3)] for i in range(n)], pa.list_(pa.float32())),
+        }
+    )
+
+
+def expect(fname, batches):
+    exp = {
+        "fields": [f.name for f in batches[0].schema],
+        "row_groups": [{"num_rows": b.num_rows, "null_counts": [c.null_count for c in b.columns]} for b in batches],
+    }
+    with open(fname + ".json", "w") as f:
+        json.dump(exp, f, indent=1)
+        f.write("\n")
+
+
+def parquet(fname, table, **kwargs):
+    pq.write_table(table, fname, row_group_size=ROWS_PER_GROUP, **kwargs)
+    pf = pq.ParquetFile(fname)
+    expect(fname, [pf.read_row_group(i).combine_chunks().to_batches()[0] for i in range(pf.num_row_groups)])
+
+
+def main():
+    parquet("flat-snappy.parquet", flat(10), compression="snappy")
+    parquet("flat-zstd-v2.parquet", flat(10), compression="zstd", data_page_version="2.0")
+    parquet(
+        "flat-delta.parquet",
+        flat(10),
+        compression="none",
+        use_dictionary=False,
+        column_encoding={
+            "id": "DELTA_BINARY_PACKED",
+            "name": "DELTA_BYTE_ARRAY",
+            "score": "BYTE_STREAM_SPLIT",
+        },
+    )
+    parquet("nested-gzip.parquet", nested(11), compression="gzip")
+    parquet(
+        "nested-delta.parquet",
+        nested(11),
+        compression="lz4",
+        data_page_version="2.0",
+        use_dictionary=False,
+        column_encoding={"id": "DELTA_BINARY_PACKED", "tags.list.element": "DELTA_LENGTH_BYTE_ARRAY"},
+    )
+
+    table = flat(10)
+    with pa.OSFile("flat.arrow", "wb") as sink, ipc.new_file(sink, table.schema) as w:
+        w.write_table(table, max_chunksize=ROWS_PER_GROUP)
+    expect("flat.arrow", table.to_batches(max_chunksize=ROWS_PER_GROUP))
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,179 @@
+// Package tabular: Parquet and Arrow IPC row-group (record-batch) access
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tabular
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// minimal Thrift compact protocol decoder - just enough to parse Parquet footers and page headers
+// see https://github.com/apache/thrift/blob/master/doc/specs/thrift-compact-protocol.md
+
+// compact protocol types
+const (
+	ctStop     = 0
+	ctTrue     = 1
+	ctFalse    = 2
+	ctByte     = 3
+	ctI16      = 4
+	ctI32      = 5
+	ctI64      = 6
+	ctDouble   = 7
+	ctBinary   = 8
+	ctList     = 9
+	ctSet      = 10
+	ctMap      = 11
+	ctStruct   = 12
+	maxTdepth  = 64
+	maxTlength = math.MaxInt32
+)
+
+var errThrift = errors.New("tabular: malformed thrift (compact) data")
+
+type tcompact struct {
+	b     []byte
+	off   int
+	depth int
+	err   error
+}
+
+func (d *tcompact) fail() {
+	if d.err == nil {
+		d.err = errThrift
+	}
+	d.off = len(d.b)
+}
+
+func (d *tcompact) byte1() byte {
+	if d.off >= len(d.b) {
+		d.fail()
+		return 0
+	}
+	c := d.b[d.off]
+	d.off++
+	return c
+}
+
+func (d *tcompact) uvarint() uint64 {
+	v, n := binary.Uvarint(d.b[d.off:])
+	if n <= 0 {
+		d.fail()
+		return 0
+	}
+	d.off += n
+	return v
+}
+
+func (d *tcompact) varint() int64 {
+	u := d.uvarint()
+	return int64(u>>1) ^ -int64(u&1) // zigzag
+}
+
+func (d *tcompact) i32() int32 { return int32(d.varint()) }
+func (d *tcompact) i64() int64 { return d.varint() }
+
+func (d *tcompact) binary() []byte {
+	l := d.uvarint()
+	if l > maxTlength || int(l) > len(d.b)-d.off {
+		d.fail()
+		return nil
+	}
+	v := d.b[d.off : d.off+int(l)]
+	d.off += int(l)
+	return v
+}
+
+func (d *tcompact) str() string { return string(d.binary()) }
+
+// (bool struct fields carry their values in the type nibble)
+func (*tcompact) bool(typ byte) bool { return typ == ctTrue }
+
+// returns element type and number of elements
+func (d *tcompact) list() (byte, int) {
+	c := d.byte1()
+	n := int(c >> 4)
+	if n == 15 {
+		l := d.uvarint()
+		if l > maxTlength || int(l) > len(d.b)-d.off { // (at least one byte per element)
+			d.fail()
+			return 0, 0
+		}
+		n = int(l)
+	}
+	return c & 0x0f, n
+}
+
+// iterate struct fields; the callback must consume (or skip) the field's value
+func (d *tcompact) fields(cb func(id int16, typ byte)) {
+	if d.depth++; d.depth > maxTdepth {
+		d.fail()
+		return
+	}
+	var last int16
+	for d.err == nil {
+		c := d.byte1()
+		typ := c & 0x0f
+		if typ == ctStop {
+			break
+		}
+		if delta := int16(c >> 4); delta != 0 {
+			last += delta
+		} else {
+			last = int16(d.varint())
+		}
+		cb(last, typ)
+	}
+	d.depth--
+}
+
+func (d *tcompact) skip(typ byte) {
+	switch typ {
+	case ctTrue, ctFalse:
+	case ctByte:
+		d.byte1()
+	case ctI16, ctI32, ctI64:
+		d.uvarint()
+	case ctDouble:
+		if d.off += 8; d.off > len(d.b) {
+			d.fail()
+		}
+	case ctBinary:
+		d.binary()
+	case ctList, ctSet:
+		etyp, n := d.list()
+		for i := 0; i < n && d.err == nil; i++ {
+			d.skipElem(etyp)
+		}
+	case ctMap:
+		n := d.uvarint()
+		if n == 0 {
+			break
+		}
+		if n > maxTlength {
+			d.fail()
+			break
+		}
+		kv := d.byte1()
+		for i := uint64(0); i < n && d.err == nil; i++ {
+			d.skipElem(kv >> 4)
+			d.skipElem(kv & 0x0f)
+		}
+	case ctStruct:
+		d.fields(func(_ int16, typ byte) { d.skip(typ) })
+	default:
+		d.fail()
+	}
+}
+
+// collection elements: booleans are encoded as a single byte
+func (d *tcompact) skipElem(typ byte) {
+	if typ == ctTrue || typ == ctFalse {
+		d.byte1()
+		return
+	}
+	d.skip(typ)
+}
@@ -5,8 +5,11 @@
 package tests_test
 
 import (
+	"time"
+
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -141,6 +144,60 @@ var _ = Describe("API", func() {
 					},
 				},
 			),
+			Entry("per-bucket secret",
+				cmn.Bprops{
+					Provider: apc.Azure,
+				},
+				cmn.BpropsToSet{
+					Extra: &cmn.ExtraToSet{
+						Azure: &cmn.ExtraPropsAzureToSet{
+							Secret: apc.Ptr("team-a"),
+						},
+					},
+				},
+				cmn.Bprops{
+					Provider: apc.Azure,
+					Extra: cmn.ExtraProps{
+						Azure: cmn.ExtraPropsAzure{
+							Secret: "team-a",
+						},
+					},
+				},
+			),
+			Entry("nested provider-specific extra fields",
+				cmn.Bprops{
+					Provider: apc.AWS,
+					Extra: cmn.ExtraProps{
+						AWS: cmn.ExtraPropsAWS{
+							Endpoint: "http://minio:9000",
+							Dialect:  cmn.S3Dialect{Store: cmn.S3StoreMinIO},
+						},
+					},
+				},
+				cmn.BpropsToSet{
+					Extra: &cmn.ExtraToSet{
+						AWS: &cmn.ExtraPropsAWSToSet{
+							Dialect: &cmn.S3DialectToSet{
+								ListV1:   apc.Ptr(true),
+								MaxParts: apc.Ptr[int32](1000),
+							},
+						},
+					},
+				},
+				cmn.Bprops{
+					Provider: apc.AWS,
+					Extra: cmn.ExtraProps{
+						AWS: cmn.ExtraPropsAWS{
+							Endpoint: "http://minio:9000",
+							Dialect: cmn.S3Dialect{
+								Store:    cmn.S3StoreMinIO,
+								ListV1:   true,
+								MaxParts: 1000,
+							},
+						},
+					},
+				},
+			),
 			Entry("all fields",
 				cmn.Bprops{},
 				cmn.BpropsToSet{
@@ -209,4 +266,154 @@ var _ = Describe("API", func() {
 			),
 		)
 	})
+
+	Describe("S3Dialect", func() {
+		DescribeTable("should resolve preset defaults",
+			func(d cmn.S3Dialect, expect cmn.S3Dialect) {
+				Expect(d.Resolve()).To(Equal(expect))
+			},
+			Entry("amazon s3",
+				cmn.S3Dialect{},
+				cmn.S3Dialect{MaxParts: cmn.S3MaxParts},
+			),
+			Entry("preset",
+				cmn.S3Dialect{Store: cmn.S3StoreGeneric},
+				cmn.S3Dialect{
+					Store:       cmn.S3StoreGeneric,
+					Addressing:  cmn.S3AddrPath,
+					Checksum:    cmn.S3ChecksumWhenRequired,
+					ListV1:      true,
+					MaxParts:    cmn.S3MaxParts,
+					MissingETag: cmn.S3MissingETagCompute,
+				},
+			),
+			Entry("preset with overrides",
+				cmn.S3Dialect{Store: cmn.S3StoreMinIO, Addressing: cmn.S3AddrVirtual, MaxParts: 100, MissingETag: cmn.S3MissingETagError},
+				cmn.S3Dialect{
+					Store:       cmn.S3StoreMinIO,
+					Addressing:  cmn.S3AddrVirtual,
+					Checksum:    cmn.S3ChecksumWhenRequired,
+					MaxParts:    100,
+					MissingETag: cmn.S3MissingETagError,
+				},
+			),
+		)
+		DescribeTable("should validate",
+			func(d cmn.S3Dialect, valid bool) {
+				extra := cmn.ExtraProps{AWS: cmn.ExtraPropsAWS{Dialect: d}}
+				err := extra.ValidateAsProps(apc.AWS)
+				if valid {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(HaveOccurred())
+				}
+			},
+			Entry("empty", cmn.S3Dialect{}, true),
+			Entry("preset", cmn.S3Dialect{Store: cmn.S3StoreCeph, ListV1: true}, true),
+			Entry("unknown store", cmn.S3Dialect{Store: "r2"}, false),
+			Entry("invalid addressing", cmn.S3Dialect{Addressing: "vhost"}, false),
+			Entry("invalid checksum", cmn.S3Dialect{Checksum: "always"}, false),
+			Entry("too many parts", cmn.S3Dialect{MaxParts: cmn.S3MaxParts + 1}, false),
+			Entry("invalid missing_etag", cmn.S3Dialect{MissingETag: "ignore"}, false),
+		)
+	})
+
+	Describe("HedgeConf", func() {
+		DescribeTable("should validate",
+			func(c cmn.HedgeConf, provider string, valid bool) {
+				err := c.ValidateAsProps(provider)
+				if valid {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(HaveOccurred())
+				}
+			},
+			Entry("disabled", cmn.HedgeConf{Percentile: 200}, apc.GCP, true),
+			Entry("hedge with self", cmn.HedgeConf{Enabled: true, Percentile: 95}, apc.GCP, true),
+			Entry("failover only",
+				cmn.HedgeConf{Enabled: true, Endpoints: []cmn.BackendEndpoint{{Region: "us-west-2"}}}, apc.AWS, true),
+			Entry("endpoints",
+				cmn.HedgeConf{Enabled: true, Percentile: 90, Endpoints: []cmn.BackendEndpoint{
+					{Bucket: "replica", Region: "eu-west-1"}, {Endpoint: "http://localhost:9000"},
+				}}, apc.AWS, true),
+			Entry("nothing to do", cmn.HedgeConf{Enabled: true}, apc.AWS, false),
+			Entry("invalid percentile", cmn.HedgeConf{Enabled: true, Percentile: 100}, apc.AWS, false),
+			Entry("endpoints not supported",
+				cmn.HedgeConf{Enabled: true, Endpoints: []cmn.BackendEndpoint{{Region: "us-east1"}}}, apc.GCP, false),
+			Entry("empty endpoint",
+				cmn.HedgeConf{Enabled: true, Endpoints: []cmn.BackendEndpoint{{}}}, apc.AWS, false),
+			Entry("invalid endpoint URL",
+				cmn.HedgeConf{Enabled: true, Endpoints: []cmn.BackendEndpoint{{Endpoint: "s3.amazonaws.com"}}}, apc.AWS, false),
+			Entry("invalid bucket name",
+				cmn.HedgeConf{Enabled: true, Endpoints: []cmn.BackendEndpoint{{Bucket: "a/b"}}}, apc.AWS, false),
+		)
+	})
+
+	Describe("ReplConf", func() {
+		remais := cmn.Bck{Name: "dst", Provider: apc.AIS, Ns: cmn.Ns{UUID: "remais"}}
+
+		DescribeTable("should validate",
+			func(c cmn.ReplConf, provider string, hasBackend, valid bool) {
+				err := c.ValidateAsProps(provider, hasBackend)
+				if valid {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(HaveOccurred())
+				}
+			},
+			Entry("disabled", cmn.ReplConf{Conflict: "whatever"}, apc.AWS, false, true),
+			Entry("default conflict resolution", cmn.ReplConf{Enabled: true, Dst: remais}, apc.AIS, false, true),
+			Entry("last writer wins",
+				cmn.ReplConf{Enabled: true, Dst: remais, Conflict: cmn.ReplConflictMtime, Workers: 64}, apc.AIS, false, true),
+			Entry("remote source", cmn.ReplConf{Enabled: true, Dst: remais}, apc.AWS, false, false),
+			Entry("source with backend", cmn.ReplConf{Enabled: true, Dst: remais}, apc.AIS, true, false),
+			Entry("missing destination", cmn.ReplConf{Enabled: true}, apc.AIS, false, false),
+			Entry("local destination",
+				cmn.ReplConf{Enabled: true, Dst: cmn.Bck{Name: "dst", Provider: apc.AIS}}, apc.AIS, false, false),
+			Entry("cloud destination",
+				cmn.ReplConf{Enabled: true, Dst: cmn.Bck{Name: "dst", Provider: apc.AWS}}, apc.AIS, false, false),
+			Entry("invalid conflict resolution",
+				cmn.ReplConf{Enabled: true, Dst: remais, Conflict: "size"}, apc.AIS, false, false),
+			Entry("too many workers", cmn.ReplConf{Enabled: true, Dst: remais, Workers: 1000}, apc.AIS, false, false),
+		)
+	})
+
+	Describe("LifecycleConf", func() {
+		var (
+			expire = cmn.LifecycleRule{ExpirationDays: 7, Enabled: true}
+			abort  = cmn.LifecycleRule{AbortMptDays: 7, Enabled: true}
+		)
+		DescribeTable("should validate",
+			func(c cmn.LifecycleConf, provider string, hasBackend, valid bool) {
+				err := c.ValidateAsProps(provider, hasBackend)
+				if valid {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(HaveOccurred())
+				}
+			},
+			Entry("empty", cmn.LifecycleConf{}, apc.AWS, false, true),
+			Entry("expiration", cmn.LifecycleConf{Rules: []cmn.LifecycleRule{expire}}, apc.AIS, false, true),
+			Entry("remote bucket: abort stale uploads", cmn.LifecycleConf{Rules: []cmn.LifecycleRule{abort}}, apc.AWS, false, true),
+			Entry("remote bucket: expiration", cmn.LifecycleConf{Rules: []cmn.LifecycleRule{abort, expire}}, apc.AWS, false, false),
+			Entry("bucket with backend: expiration", cmn.LifecycleConf{Rules: []cmn.LifecycleRule{expire}}, apc.AIS, true, false),
+		)
+	})
+
+	Describe("LsoCacheConf", func() {
+		DescribeTable("should validate",
+			func(c cmn.LsoCacheConf, valid bool) {
+				err := c.ValidateAsProps()
+				if valid {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(HaveOccurred())
+				}
+			},
+			Entry("default ttl", cmn.LsoCacheConf{Enabled: true}, true),
+			Entry("custom ttl", cmn.LsoCacheConf{Enabled: true, TTL: cos.Duration(time.Hour)}, true),
+			Entry("negative ttl", cmn.LsoCacheConf{Enabled: true, TTL: cos.Duration(-time.Second)}, false),
+			Entry("ttl too large", cmn.LsoCacheConf{Enabled: true, TTL: cos.Duration(30 * 24 * time.Hour)}, false),
+		)
+	})
 })
@@ -393,3 +393,33 @@ func TestLocalNetConfigValidate_OverlappingDataHostAndPort(t *testing.T) {
 		})
 	}
 }
+
+func TestBackendConfFile(t *testing.T) {
+	var conf cmn.BackendConf
+	tassert.CheckFatal(t, conf.UnmarshalJSON([]byte(`{"file": {"root": "/mnt/nfs/data/"}}`)))
+	tassert.CheckFatal(t, conf.Validate())
+	fileConf, ok := conf.Get(apc.File).(cmn.BackendConfFile)
+	tassert.Fatalf(t, ok && fileConf.Root == "/mnt/nfs/data", "unexpected %+v", conf.Get(apc.File))
+	_, ok = conf.Providers[apc.File]
+	tassert.Errorf(t, ok, "expecting %q provider", apc.File)
+
+	for _, s := range []string{`{"file": {}}`, `{"file": {"root": "relative/path"}}`} {
+		var conf cmn.BackendConf
+		tassert.CheckFatal(t, conf.UnmarshalJSON([]byte(s)))
+		tassert.Errorf(t, conf.Validate() != nil, "expecting %s to fail", s)
+	}
+}
+
+func TestBackendConfHDFS(t *testing.T) {
+	var conf cmn.BackendConf
+	tassert.CheckFatal(t, conf.UnmarshalJSON([]byte(`{"hdfs": {"url": "http://namenode:9870/", "user": "hadoop"}}`)))
+	tassert.CheckFatal(t, conf.Validate())
+	hdfsConf, ok := conf.Get(apc.HDFS).(cmn.BackendConfHDFS)
+	tassert.Fatalf(t, ok && hdfsConf.URL == "http://namenode:9870" && hdfsConf.Root == "/", "unexpected %+v", conf.Get(apc.HDFS))
+
+	for _, s := range []string{`{"hdfs": {}}`, `{"hdfs": {"url": "namenode:9870"}}`, `{"hdfs": {"url": "http://nn:9870", "root": "data"}}`} {
+		var conf cmn.BackendConf
+		tassert.CheckFatal(t, conf.UnmarshalJSON([]byte(s)))
+		tassert.Errorf(t, conf.Validate() != nil, "expecting %s to fail", s)
+	}
+}
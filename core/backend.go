@@ -52,4 +52,14 @@ type (
 		CompleteMpt(lom *LOM, r *http.Request, uploadID string, body []byte, parts apc.MptCompletedParts) (version, etag string, ecode int, err error)
 		AbortMpt(lom *LOM, r *http.Request, uploadID string) (ecode int, err error)
 	}
+
+	// optional: backend-native (server-side) rename and copy, e.g. Azure storage accounts
+	// with hierarchical namespace (ADLS Gen2);
+	// http.StatusNotImplemented means "not applicable" - fall back to the generic flow, if any
+	BackendRenamer interface {
+		RenameObj(ctx context.Context, lom *LOM, objNameTo string) (ecode int, err error)
+	}
+	BackendCopier interface {
+		CopyObj(ctx context.Context, lom *LOM, bckTo *meta.Bck, objNameTo string) (size int64, ecode int, err error)
+	}
 )
@@ -189,8 +189,11 @@ func (ct *CT) init(extras ...string) error {
 	case fs.ChunkMetaCT:
 		debug.Assert(len(extras) <= 1, "ChunkMetaCT takes optional uploadID")
 		ct.fqn = ct.GenFQN("", extras...)
+	case fs.VersionCT:
+		debug.Assert(len(extras) == 1 || len(extras) == 2, "VersionCT requires version (and optional delete marker)")
+		ct.fqn = ct.GenFQN("", extras...)
 	default:
-		// NOTE: `extras` are only meaningful for Work/Chunk content-types, others ignore them
+		// NOTE: `extras` are only meaningful for Work/Chunk/Version content-types, others ignore them
 		// (may consider asserting though after adding an `if` into tools.PrepareObjects)
 		ct.fqn = ct.GenFQN("")
 	}
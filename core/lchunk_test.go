@@ -437,6 +437,28 @@ var _ = Describe("Ufest Core Functionality", func() {
 			Expect(clone.Completed()).To(BeFalse())
 		})
 
+		It("should persist upload metadata with partial manifest", func() {
+			chunk, err := manifest.NewChunk(1, lom)
+			Expect(err).NotTo(HaveOccurred())
+			createTestFile(chunk.Path(), cos.KiB)
+			err = manifest.Add(chunk, cos.KiB, 1)
+			Expect(err).NotTo(HaveOccurred())
+
+			md := cos.StrKVs{"sse.alg": "AES256", "sse.key": "c2VjcmV0"}
+			manifest.SetMeta(md)
+			err = manifest.StorePartial(lom, false /*locked*/)
+			Expect(err).NotTo(HaveOccurred())
+
+			clone, err := core.NewUfest(manifest.ID(), lom, true)
+			Expect(err).NotTo(HaveOccurred())
+			lom.Lock(false)
+			defer lom.Unlock(false)
+			err = clone.LoadPartial(lom)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clone.Meta()).To(Equal(md))
+			Expect(clone.Count()).To(Equal(1))
+		})
+
 		It("should fail to load non-existent manifest", func() {
 			var err error
 			lom.Lock(false)
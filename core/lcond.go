@@ -0,0 +1,78 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+)
+
+// Conditional writes: If-Match and If-None-Match.
+// Evaluated against the current (in-cluster) object while holding its write lock,
+// which makes the check atomic relative to concurrent writes of the same object.
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/conditional-writes.html
+
+type WriteCond struct {
+	IfMatch     string // comma-separated ETags or "*" (any)
+	IfNoneMatch string // ditto
+}
+
+func (c *WriteCond) IsEmpty() bool { return c == nil || (c.IfMatch == "" && c.IfNoneMatch == "") }
+
+// returns:
+// - cos.ErrNotFound when If-Match is specified and the object does not exist
+// - cmn.ErrPrecondFailed when either condition is not satisfied
+func (lom *LOM) CheckWriteCond(cond *WriteCond) error {
+	debug.Assert(lom.IsLocked() == apc.LockWrite, lom.Cname())
+	if cond.IsEmpty() {
+		return nil
+	}
+	// scratch LOM: lom.md may already carry new attributes (see also PrewriteWORM)
+	cur := AllocLOM(lom.ObjName)
+	defer FreeLOM(cur)
+	if err := cur.InitBck(lom.Bck()); err != nil {
+		return err
+	}
+	var (
+		etag   string
+		_, err = cur.lmfs(true)
+		exists = err == nil
+	)
+	if exists {
+		_, mtime := cur.LastModifiedStr()
+		etag = cur.ETag(mtime, true /*allow syscall*/)
+	}
+	if cond.IfMatch != "" {
+		if !exists {
+			return cos.NewErrNotFound(T, lom.Cname())
+		}
+		if !matchETag(cond.IfMatch, etag) {
+			return cmn.NewErrPrecondFailed(lom.Cname(), "If-Match "+cond.IfMatch)
+		}
+	}
+	if cond.IfNoneMatch != "" && exists && matchETag(cond.IfNoneMatch, etag) {
+		return cmn.NewErrPrecondFailed(lom.Cname(), "If-None-Match "+cond.IfNoneMatch)
+	}
+	return nil
+}
+
+// (weak and strong ETags compare equal)
+func matchETag(list, etag string) bool {
+	for tag := range strings.SplitSeq(list, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return true
+		}
+		tag = strings.TrimPrefix(tag, "W/")
+		if etag != "" && cmn.UnquoteCEV(tag) == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -105,6 +105,11 @@ func (lom *LOM) GetROC(latestVer, sync bool) (resp ReadResp) {
 			}
 		}
 
+		if err := lom.CheckEncrypted(); err != nil {
+			lom.Unlock(false)
+			resp.Err, resp.Ecode = err, http.StatusBadRequest
+			return resp
+		}
 		resp.R, resp.Err = lom.NewDeferROC(true) // keeping lock, reading local
 		resp.OAH = lom
 		return resp
@@ -21,6 +21,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/cmn/tabular"
 	"github.com/NVIDIA/aistore/fs"
 )
 
@@ -352,14 +353,25 @@ func (lom *LOM) RenameFinalize(wfqn string) error {
 	return err
 }
 
-// extract a single file from a (.tar, .tgz or .tar.gz, .zip, .tar.lz4) shard
+// extract a single file from a (.tar, .tgz or .tar.gz, .zip, .tar.lz4, .tar.zst) shard
 // uses the provided `mime` or lom.ObjName to detect formatting (empty = auto-detect)
+// - .parquet and .arrow objects: extract a single row group ("rowgroup/<index>") as Arrow IPC stream
 func (lom *LOM) NewArchpathReader(lh cos.LomReader, archpath, mime string) (csl cos.ReadCloseSizer, err error) {
 	debug.Assert(lom.IsLocked() > apc.LockNone, lom.Cname(), " is not locked")
 	debug.Assert(archpath != "")
 	if err := cos.ValidateArchpath(archpath); err != nil {
 		return nil, err
 	}
+	if err := lom.CheckEncrypted(); err != nil {
+		return nil, err
+	}
+	if tabular.HasExt(lom.ObjName) {
+		csl, err = tabular.NewRowGroupReader(lh, lom.Lsize(), lom.ObjName, archpath)
+		if err == nil && csl == nil {
+			err = cos.NewErrNotFound(T, archpath+" in "+lom.Cname())
+		}
+		return csl, err
+	}
 	mime, err = archive.MimeFile(lh, T.ByteMM(), mime, lom.ObjName)
 	if err != nil {
 		return nil, err
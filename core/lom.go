@@ -410,6 +410,15 @@ func (lom *LOM) GetCustomKey(key string) (string, bool) { return lom.md.GetCusto
 func (lom *LOM) SetCustomKey(key, value string)         { lom.md.SetCustomKey(key, value) }
 func (lom *LOM) DelCustomKey(key string)                { lom.md.DelCustomKey(key) }
 
+// S3 server-side encrypted content is ciphertext - can only be read (and decrypted) via S3 API;
+// all other readers (archpath, get-batch, dsort, copy/transform) must refuse it
+func (lom *LOM) CheckEncrypted() error {
+	if _, ok := lom.GetCustomKey(cmn.SSEObjMD); ok {
+		return fmt.Errorf("%s is encrypted at rest and can only be read via S3 API", lom.Cname())
+	}
+	return nil
+}
+
 // assorted _convenient_ accessors
 func (lom *LOM) Bck() *meta.Bck                 { return &lom.bck }
 func (lom *LOM) Bprops() *cmn.Bprops            { return lom.bck.Props }
@@ -33,6 +33,7 @@ var _ = Describe("LOM", func() {
 		bucketLocalA = "LOM_TEST_Local_A"
 		bucketLocalB = "LOM_TEST_Local_B"
 		bucketLocalC = "LOM_TEST_Local_C"
+		bucketLocalW = "LOM_TEST_Local_WORM"
 
 		bucketCloudA = "LOM_TEST_Cloud_A"
 		bucketCloudB = "LOM_TEST_Cloud_B"
@@ -43,6 +44,7 @@ var _ = Describe("LOM", func() {
 	var (
 		localBckA = cmn.Bck{Name: bucketLocalA, Provider: apc.AIS, Ns: cmn.NsGlobal}
 		localBckB = cmn.Bck{Name: bucketLocalB, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		localBckW = cmn.Bck{Name: bucketLocalW, Provider: apc.AIS, Ns: cmn.NsGlobal}
 		cloudBckA = cmn.Bck{Name: bucketCloudA, Provider: apc.AWS, Ns: cmn.NsGlobal}
 	)
 
@@ -91,6 +93,7 @@ var _ = Describe("LOM", func() {
 		meta.NewBck(bucketCloudA, apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 5}),
 		meta.NewBck(bucketCloudB, apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 6}),
 		meta.NewBck(sameBucketName, apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 7}),
+		meta.NewBck(bucketLocalW, apc.AIS, cmn.NsGlobal, &cmn.Bprops{ObjLock: cmn.ObjLockConf{Enabled: true}, BID: 8}),
 	)
 
 	BeforeEach(func() {
@@ -615,6 +618,13 @@ var _ = Describe("LOM", func() {
 				_, exists = lom.GetCustomKey("unknown")
 				Expect(exists).To(BeFalse())
 			})
+
+			It("should refuse to read encrypted content", func() {
+				lom := filePut(localFQN, 0)
+				Expect(lom.CheckEncrypted()).NotTo(HaveOccurred())
+				lom.SetCustomKey(cmn.SSEObjMD, "SSE-S3")
+				Expect(lom.CheckEncrypted()).To(HaveOccurred())
+			})
 		})
 	})
 
@@ -1099,6 +1109,193 @@ var _ = Describe("LOM", func() {
 		})
 	})
 
+	Describe("object lock", func() {
+		var (
+			lom    *core.LOM
+			future = time.Now().Add(time.Hour)
+		)
+		BeforeEach(func() {
+			lom = &core.LOM{ObjName: "foldr/worm-obj.ext"}
+			Expect(lom.InitCmnBck(&localBckA)).NotTo(HaveOccurred())
+		})
+
+		It("should enforce retention", func() {
+			Expect(lom.CheckWORM(false)).NotTo(HaveOccurred())
+
+			lom.SetRetention(cmn.ObjLockGovernance, future)
+			Expect(cmn.IsErrObjLocked(lom.CheckWORM(false))).To(BeTrue())
+			Expect(lom.CheckWORM(true /*bypass*/)).NotTo(HaveOccurred())
+
+			lom.SetRetention(cmn.ObjLockCompliance, future)
+			Expect(cmn.IsErrObjLocked(lom.CheckWORM(true /*bypass*/))).To(BeTrue())
+
+			lom.SetRetention(cmn.ObjLockCompliance, time.Now().Add(-time.Second))
+			Expect(lom.CheckWORM(false)).NotTo(HaveOccurred())
+
+			lom.SetRetention("", time.Time{})
+			mode, _ := lom.Retention()
+			Expect(mode).To(BeEmpty())
+		})
+
+		It("should enforce legal hold", func() {
+			lom.SetLegalHold(true)
+			Expect(cmn.IsErrObjLocked(lom.CheckWORM(true /*bypass*/))).To(BeTrue())
+			lom.SetLegalHold(false)
+			Expect(lom.CheckWORM(false)).NotTo(HaveOccurred())
+		})
+
+		It("should not relax retention", func() {
+			lom.SetRetention(cmn.ObjLockCompliance, future)
+			Expect(lom.CheckRetention(cmn.ObjLockCompliance, future.Add(time.Hour), false)).NotTo(HaveOccurred())
+			Expect(lom.CheckRetention(cmn.ObjLockCompliance, future.Add(-time.Minute), true)).To(HaveOccurred())
+			Expect(lom.CheckRetention(cmn.ObjLockGovernance, future.Add(time.Hour), true)).To(HaveOccurred())
+			Expect(lom.CheckRetention("", time.Time{}, true)).To(HaveOccurred())
+
+			lom.SetRetention(cmn.ObjLockGovernance, future)
+			Expect(lom.CheckRetention(cmn.ObjLockCompliance, future, false)).NotTo(HaveOccurred())
+			Expect(lom.CheckRetention("", time.Time{}, false)).To(HaveOccurred())
+			Expect(lom.CheckRetention("", time.Time{}, true /*bypass*/)).NotTo(HaveOccurred())
+		})
+
+		It("should refuse to destroy bucket with locked objects", func() {
+			wlom := &core.LOM{ObjName: "foldr/worm-bck.ext"}
+			Expect(wlom.InitCmnBck(&localBckW)).NotTo(HaveOccurred())
+			Expect(core.CheckBckWORM(wlom.Bck())).NotTo(HaveOccurred())
+
+			cur := filePut(wlom.FQN, 16)
+			Expect(core.CheckBckWORM(wlom.Bck())).NotTo(HaveOccurred())
+
+			cur.SetRetention(cmn.ObjLockCompliance, future)
+			Expect(persist(cur)).NotTo(HaveOccurred())
+			Expect(cmn.IsErrObjLocked(core.CheckBckWORM(wlom.Bck()))).To(BeTrue())
+
+			// (bucket without object lock)
+			Expect(core.CheckBckWORM(lom.Bck())).NotTo(HaveOccurred())
+			Expect(os.Remove(wlom.FQN)).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("conditional write", func() {
+		const (
+			objName = "foldr/cond-obj.ext"
+			md5     = "8b1a9953c4611296a827abf8c47804d7"
+		)
+
+		It("should evaluate If-None-Match and If-Match", func() {
+			lom := &core.LOM{ObjName: objName}
+			Expect(lom.InitCmnBck(&localBckA)).NotTo(HaveOccurred())
+			lom.Lock(true)
+			defer lom.Unlock(true)
+
+			// does not exist
+			Expect(lom.CheckWriteCond(nil)).NotTo(HaveOccurred())
+			Expect(lom.CheckWriteCond(&core.WriteCond{IfNoneMatch: "*"})).NotTo(HaveOccurred())
+			Expect(cos.IsNotExist(lom.CheckWriteCond(&core.WriteCond{IfMatch: "*"}))).To(BeTrue())
+
+			// exists
+			cur := filePut(lom.FQN, 128)
+			cur.SetCksum(cos.NewCksum(cos.ChecksumMD5, md5))
+			Expect(persist(cur)).NotTo(HaveOccurred())
+
+			Expect(cmn.IsErrPrecondFailed(lom.CheckWriteCond(&core.WriteCond{IfNoneMatch: "*"}))).To(BeTrue())
+			Expect(lom.CheckWriteCond(&core.WriteCond{IfMatch: `"` + md5 + `"`})).NotTo(HaveOccurred())
+			Expect(lom.CheckWriteCond(&core.WriteCond{IfMatch: `"abc", "` + md5 + `"`})).NotTo(HaveOccurred())
+			Expect(cmn.IsErrPrecondFailed(lom.CheckWriteCond(&core.WriteCond{IfMatch: `"abc"`}))).To(BeTrue())
+			Expect(cmn.IsErrPrecondFailed(lom.CheckWriteCond(&core.WriteCond{IfNoneMatch: md5}))).To(BeTrue())
+		})
+	})
+
+	Describe("retained versions", func() {
+		It("should index and list retained versions and delete markers", func() {
+			lom := &core.LOM{ObjName: "foldr/ver-obj.ext"}
+			Expect(lom.InitCmnBck(&localBckA)).NotTo(HaveOccurred())
+			lom.Lock(true)
+			defer lom.Unlock(true)
+
+			ver, _, err := lom.LatestRetained()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ver).To(BeEmpty())
+
+			filePut(lom.FQN, 16) // version 1
+			latest, err := lom.RetainVersion()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(latest).To(Equal("1"))
+
+			cur := filePut(lom.FQN, 32) // (new inode)
+			cur.SetVersion("2")
+			Expect(persist(cur)).NotTo(HaveOccurred())
+			ver, err = lom.AddDeleteMarker()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ver).To(Equal("3"))
+			Expect(lom.RemoveObj()).NotTo(HaveOccurred())
+
+			ovs, err := lom.ListVersions()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ovs).To(HaveLen(3))
+			Expect(ovs[0].Version).To(Equal("3"))
+			Expect(ovs[0].DeleteMarker).To(BeTrue())
+			Expect(ovs[1].Size).To(BeEquivalentTo(32))
+			Expect(ovs[2].Size).To(BeEquivalentTo(16))
+
+			ver, dm, err := lom.LatestRetained()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ver).To(Equal("3"))
+			Expect(dm).To(BeTrue())
+
+			// deleting the marker restores version 2
+			_, err = lom.DelVersion("3")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+			Expect(lom.Version()).To(Equal("2"))
+			ovs, err = lom.ListVersions()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ovs).To(HaveLen(1))
+			Expect(ovs[0].Version).To(Equal("1"))
+		})
+
+		It("should not restart version numbering after a delete marker", func() {
+			lom := &core.LOM{ObjName: "foldr/ver-restart.ext"}
+			Expect(lom.InitCmnBck(&localBckA)).NotTo(HaveOccurred())
+			lom.Lock(true)
+			defer lom.Unlock(true)
+
+			filePut(lom.FQN, 16) // version 1
+			ver, err := lom.AddDeleteMarker()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ver).To(Equal("2"))
+			Expect(lom.RemoveObj()).NotTo(HaveOccurred())
+
+			// new object
+			lom.SetVersion("")
+			Expect(lom.RetainIncVersion()).NotTo(HaveOccurred())
+			Expect(lom.Version()).To(Equal("3"))
+		})
+
+		It("should refuse to retain over a different existing version", func() {
+			lom := &core.LOM{ObjName: "foldr/ver-collide.ext"}
+			Expect(lom.InitCmnBck(&localBckA)).NotTo(HaveOccurred())
+			lom.Lock(true)
+			defer lom.Unlock(true)
+
+			filePut(lom.FQN, 16) // version 1
+			latest, err := lom.RetainVersion()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(latest).To(Equal("1"))
+
+			// (idempotent)
+			_, err = lom.RetainVersion()
+			Expect(err).NotTo(HaveOccurred())
+
+			filePut(lom.FQN, 32) // (new inode, same version 1)
+			_, err = lom.RetainVersion()
+			Expect(err).To(HaveOccurred())
+			ovs, err := lom.ListVersions()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ovs).To(HaveLen(1))
+			Expect(ovs[0].Size).To(BeEquivalentTo(16))
+		})
+	})
+
 	Describe("local and cloud bucket with the same name", func() {
 		It("should have different fqn", func() {
 			testObject := "foldr/test-obj.ext"
@@ -0,0 +1,462 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+//
+// Retained (prior) object versions - ais:// buckets only
+//
+// When enabled (see RetainsVersions), overwriting or deleting an object preserves
+// its current content as fs.VersionCT - a hard link named "<object>.<version>" that
+// shares the inode (and, therefore, xattr-stored metadata) with the overwritten object.
+// Deleting an object also leaves behind an empty "<object>.<version>.dm" delete marker.
+//
+// Each object with retained versions also has a small "<object>.vi" version index:
+// one line per retained version (or delete marker), in ascending order. The index is
+// what makes version lookups per-object (no directory scans); it is updated under the
+// object's write lock.
+//
+// Limitations:
+// - chunked objects and objects with extra-long names are not retained;
+// - retained versions stay on the target (and mountpath) where they were created
+//   and are not migrated by global rebalance or resilver.
+//
+
+type ObjVersion struct {
+	Mtime        time.Time
+	FQN          string
+	Version      string
+	ETag         string
+	Size         int64
+	DeleteMarker bool
+}
+
+var errNoVersion = errors.New("no such version")
+
+func (lom *LOM) RetainsVersions() bool {
+	conf := lom.VersionConf()
+	return lom.Bck().IsAIS() && conf.Enabled && conf.RetainPrior
+}
+
+// Preserve the current (on-disk) object as fs.VersionCT and return the most recent
+// version number (current or retained, including delete markers) - to be incremented
+// by the caller.
+// - expecting the object to be write-locked;
+// - note that lom.md may already carry new (being written) attributes, hence scratch LOM.
+func (lom *LOM) RetainVersion() (latest string, _ error) {
+	debug.Assert(lom.IsLocked() == apc.LockWrite, lom.Cname())
+	if fs.IsFntl(lom.ObjName) {
+		return "", nil
+	}
+	cur := AllocLOM(lom.ObjName)
+	defer FreeLOM(cur)
+	if err := cur.InitBck(lom.Bck()); err != nil {
+		return "", err
+	}
+	_, err := cur.lmfs(true)
+	switch {
+	case err == nil:
+		latest = cur.md.Version()
+		if latest == "" || cur.IsChunked() {
+			return latest, nil
+		}
+		return latest, cur.retain(latest)
+	case cos.IsNotExist(err):
+		latest, _, err = lom.LatestRetained()
+		return latest, err
+	default:
+		return "", err
+	}
+}
+
+// Retain the current version (when configured) and increment it - in such a way that
+// the new (being written) object's version never repeats any of the retained ones
+// (including delete markers).
+// Expecting the object to be write-locked.
+func (lom *LOM) RetainIncVersion() error {
+	var (
+		latest string
+		err    error
+	)
+	switch {
+	case lom.RetainsVersions():
+		latest, err = lom.RetainVersion()
+	case lom.Version() == "" && !fs.IsFntl(lom.ObjName):
+		// (retention may have been disabled since)
+		latest, _, err = lom.LatestRetained()
+	}
+	if err != nil {
+		return fmt.Errorf("%s: failed to retain prior version: %w", lom.Cname(), err)
+	}
+	if latest != "" {
+		lom.SetVersion(latest)
+	}
+	if err := lom.IncVersion(); err != nil {
+		nlog.Errorln(err) // (unlikely)
+	}
+	return nil
+}
+
+func (lom *LOM) retain(ver string) error {
+	vfqn := lom.GenFQN(fs.VersionCT, ver)
+	if err := cos.CreateDir(filepath.Dir(vfqn)); err != nil {
+		return err
+	}
+	if err := os.Link(lom.FQN, vfqn); err != nil {
+		if !os.IsExist(err) {
+			return err
+		}
+		// already retained (e.g., retry) - must be the very same content
+		same, errS := _sameFile(lom.FQN, vfqn)
+		if errS != nil {
+			return errS
+		}
+		if !same {
+			return fmt.Errorf("%s: version %s already exists (%s)", lom.Cname(), ver, vfqn)
+		}
+	}
+	return lom.addVidx(ver)
+}
+
+func _sameFile(a, b string) (bool, error) {
+	fa, err := os.Stat(a)
+	if err != nil {
+		return false, err
+	}
+	fb, err := os.Stat(b)
+	if err != nil {
+		return false, err
+	}
+	return os.SameFile(fa, fb), nil
+}
+
+// The most recent retained version (or delete marker), if any
+func (lom *LOM) LatestRetained() (ver string, deleteMarker bool, _ error) {
+	if fs.IsFntl(lom.ObjName) {
+		return "", false, nil
+	}
+	entries, err := lom.loadVidx()
+	if err != nil || len(entries) == 0 {
+		return "", false, err
+	}
+	ver, deleteMarker = _parseVidx(entries[len(entries)-1])
+	return ver, deleteMarker, nil
+}
+
+// Whether the object's version index lists any of the given entries
+// ("<version>" or "<version>.dm"); an empty list checks for any existing
+// retained version (space cleanup - orphans)
+func (lom *LOM) HasRetained(entries ...string) (bool, error) {
+	indexed, err := lom.loadVidx()
+	if err != nil {
+		return false, err
+	}
+	if len(entries) > 0 {
+		for _, entry := range entries {
+			if slices.Contains(indexed, entry) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	for _, entry := range indexed {
+		ver, dm := _parseVidx(entry)
+		fqn := lom.GenFQN(fs.VersionCT, ver)
+		if dm {
+			fqn = lom.GenFQN(fs.VersionCT, ver, fs.VersionDeleteMarker)
+		}
+		if cos.Stat(fqn) == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+//
+// version index
+//
+
+func _parseVidx(entry string) (ver string, deleteMarker bool) {
+	ver, deleteMarker = strings.CutSuffix(entry, "."+fs.VersionDeleteMarker)
+	return ver, deleteMarker
+}
+
+func _vidxEntry(ov *ObjVersion) string {
+	if ov.DeleteMarker {
+		return ov.Version + "." + fs.VersionDeleteMarker
+	}
+	return ov.Version
+}
+
+func (lom *LOM) vidxFQN() string { return lom.GenFQN(fs.VersionCT, fs.VersionIndex) }
+
+func (lom *LOM) loadVidx() ([]string, error) {
+	b, err := os.ReadFile(lom.vidxFQN())
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return nil, err
+	}
+	return strings.Fields(string(b)), nil
+}
+
+// write (or, when empty, remove) the index
+func (lom *LOM) storeVidx(entries []string) error {
+	fqn := lom.vidxFQN()
+	if len(entries) == 0 {
+		if err := os.Remove(fqn); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		si, _ := _parseVidx(entries[i])
+		sj, _ := _parseVidx(entries[j])
+		vi, _ := strconv.ParseUint(si, 10, 64)
+		vj, _ := strconv.ParseUint(sj, 10, 64)
+		return vi < vj
+	})
+	tmp := fqn + ".tmp"
+	fh, err := cos.CreateFile(tmp)
+	if err != nil {
+		return err
+	}
+	_, err = fh.WriteString(strings.Join(entries, "\n") + "\n")
+	if erc := cos.FlushClose(fh); err == nil {
+		err = erc
+	}
+	if err == nil {
+		err = cos.Rename(tmp, fqn)
+	}
+	if err != nil {
+		cos.RemoveFile(tmp)
+	}
+	return err
+}
+
+func (lom *LOM) addVidx(entry string) error {
+	entries, err := lom.loadVidx()
+	if err != nil {
+		return err
+	}
+	if slices.Contains(entries, entry) {
+		return nil
+	}
+	return lom.storeVidx(append(entries, entry))
+}
+
+func (lom *LOM) delVidx(entry string) error {
+	entries, err := lom.loadVidx()
+	if err != nil {
+		return err
+	}
+	if i := slices.Index(entries, entry); i >= 0 {
+		return lom.storeVidx(slices.Delete(entries, i, i+1))
+	}
+	return nil
+}
+
+// Retain the current object (if exists) and add delete marker; return the latter's version.
+// The caller is expected to write-lock the object and remove it afterwards.
+func (lom *LOM) AddDeleteMarker() (string, error) {
+	latest, err := lom.RetainVersion()
+	if err != nil {
+		return "", err
+	}
+	ver := "1"
+	if latest != "" {
+		n, err := strconv.ParseUint(latest, 10, 64)
+		if err != nil {
+			return "", err
+		}
+		ver = strconv.FormatUint(n+1, 10)
+	}
+	fh, err := cos.CreateFile(lom.GenFQN(fs.VersionCT, ver, fs.VersionDeleteMarker))
+	if err != nil {
+		return "", err
+	}
+	if err := fh.Close(); err != nil {
+		return "", err
+	}
+	return ver, lom.addVidx(ver + "." + fs.VersionDeleteMarker)
+}
+
+// List retained versions (and delete markers) of the object, the most recent first.
+// The current version of the object, if exists, is not included.
+func (lom *LOM) ListVersions() ([]*ObjVersion, error) {
+	if fs.IsFntl(lom.ObjName) {
+		return nil, nil
+	}
+	entries, err := lom.loadVidx()
+	if err != nil || len(entries) == 0 {
+		return nil, err
+	}
+	ovs := make([]*ObjVersion, 0, len(entries))
+	for _, entry := range entries {
+		var (
+			fqn     string
+			ver, dm = _parseVidx(entry)
+		)
+		if dm {
+			fqn = lom.GenFQN(fs.VersionCT, ver, fs.VersionDeleteMarker)
+		} else {
+			fqn = lom.GenFQN(fs.VersionCT, ver)
+		}
+		ov, err := lom.StatVersion(fqn)
+		if err != nil {
+			if cos.IsNotExist(err) {
+				continue // (race)
+			}
+			return nil, err
+		}
+		ovs = append(ovs, ov)
+	}
+	SortVersions(ovs)
+	return ovs, nil
+}
+
+// most recent first
+func SortVersions(ovs []*ObjVersion) {
+	sort.Slice(ovs, func(i, j int) bool {
+		vi, _ := strconv.ParseUint(ovs[i].Version, 10, 64)
+		vj, _ := strconv.ParseUint(ovs[j].Version, 10, 64)
+		return vi > vj
+	})
+}
+
+// Given retained version's FQN, return its properties (see also: InitVersion)
+func (lom *LOM) StatVersion(fqn string) (*ObjVersion, error) {
+	ci := fs.CSM.ParseUbase(filepath.Base(fqn), fs.VersionCT)
+	if !ci.Ok {
+		return nil, cos.NewErrNotFound(T, lom.Cname()+" version ("+filepath.Base(fqn)+")")
+	}
+	finfo, err := os.Stat(fqn)
+	if err != nil {
+		return nil, err
+	}
+	ov := &ObjVersion{
+		Mtime:        finfo.ModTime().UTC(),
+		FQN:          fqn,
+		Version:      ci.Extras[0],
+		DeleteMarker: len(ci.Extras) > 1,
+	}
+	if ov.DeleteMarker {
+		return ov, nil
+	}
+	vlom := AllocLOM(lom.ObjName)
+	defer FreeLOM(vlom)
+	if err := vlom.InitBck(lom.Bck()); err != nil {
+		return nil, err
+	}
+	if err := vlom.InitVersion(ov); err != nil {
+		return nil, err
+	}
+	ov.Size = vlom.Lsize()
+	ov.ETag = vlom.ETag(ov.Mtime, false)
+	return ov, nil
+}
+
+// Find a given retained version or delete marker
+func (lom *LOM) GetVersion(ver string) (*ObjVersion, error) {
+	if _, err := strconv.ParseUint(ver, 10, 64); err != nil || fs.IsFntl(lom.ObjName) {
+		return nil, cos.NewErrNotFound(T, lom.Cname()+" version "+ver)
+	}
+	ov, err := lom.StatVersion(lom.GenFQN(fs.VersionCT, ver))
+	if err == nil {
+		return ov, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+	ov, err = lom.StatVersion(lom.GenFQN(fs.VersionCT, ver, fs.VersionDeleteMarker))
+	if err != nil && os.IsNotExist(err) {
+		err = cos.NewErrNotFound(T, lom.Cname()+" version "+ver)
+	}
+	return ov, err
+}
+
+// Point this (scratch, not cached) LOM at a retained version and load the latter's metadata;
+// the LOM can then be used to read the version's content and attributes.
+func (lom *LOM) InitVersion(ov *ObjVersion) error {
+	if ov.DeleteMarker {
+		return errNoVersion
+	}
+	lom.FQN = ov.FQN
+	if _, err := lom.lmfs(true); err != nil {
+		return err
+	}
+	lom.setHRW(false)
+	lom.md.Atime = ov.Mtime.UnixNano()
+	return nil
+}
+
+// Permanently delete a given version (current or retained) of the write-locked object.
+// If the object ends up with no current version, the most recent retained one (unless
+// it is a delete marker) is restored - as per S3.
+func (lom *LOM) DelVersion(ver string) (*ObjVersion, error) {
+	debug.Assert(lom.IsLocked() == apc.LockWrite, lom.Cname())
+	exists := lom.Load(false /*cache it*/, true /*locked*/) == nil
+	if exists && lom.Version() == ver {
+		ov := &ObjVersion{Version: ver, FQN: lom.FQN, Size: lom.Lsize()}
+		if err := lom.RemoveObj(); err != nil {
+			return nil, err
+		}
+		return ov, lom.restoreLatest()
+	}
+	ov, err := lom.GetVersion(ver)
+	if err != nil {
+		return nil, err
+	}
+	if err := cos.RemoveFile(ov.FQN); err != nil {
+		return nil, err
+	}
+	if err := lom.delVidx(_vidxEntry(ov)); err != nil {
+		return nil, err
+	}
+	if exists {
+		return ov, nil
+	}
+	return ov, lom.restoreLatest()
+}
+
+func (lom *LOM) restoreLatest() error {
+	ovs, err := lom.ListVersions()
+	if err != nil || len(ovs) == 0 || ovs[0].DeleteMarker {
+		return err
+	}
+	lom.UncacheDel()
+	if err := cos.Rename(ovs[0].FQN, lom.FQN); err != nil {
+		return err
+	}
+	if err := lom.delVidx(_vidxEntry(ovs[0])); err != nil {
+		return err
+	}
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	if len(lom.md.copies) == 0 {
+		return nil
+	}
+	lom.md.copies = nil // (removed when overwritten)
+	return lom.PersistMain(false /*isChunked*/)
+}
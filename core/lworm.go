@@ -0,0 +1,217 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+//
+// Object Lock (WORM) - ais:// buckets only (see cmn/objlock)
+//
+// Retention and legal hold are stored in the object's custom metadata and
+// are enforced by all paths that overwrite, delete, evict, or rename objects.
+// When the bucket retains prior versions (see core/lversion), overwriting or deleting
+// the current version is permitted - the locked content stays immutable as
+// a retained version.
+//
+
+// returns retention mode and retain-until date (or empty mode if none)
+func (lom *LOM) Retention() (mode string, until time.Time) {
+	mode, ok := lom.GetCustomKey(cmn.ObjLockModeMD)
+	if !ok || mode == "" {
+		return "", time.Time{}
+	}
+	v, _ := lom.GetCustomKey(cmn.ObjLockUntilMD)
+	until, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		debug.AssertNoErr(err)
+		return "", time.Time{}
+	}
+	return mode, until
+}
+
+func (lom *LOM) SetRetention(mode string, until time.Time) {
+	if mode == "" {
+		lom.DelCustomKey(cmn.ObjLockModeMD)
+		lom.DelCustomKey(cmn.ObjLockUntilMD)
+		return
+	}
+	lom.SetCustomKey(cmn.ObjLockModeMD, mode)
+	lom.SetCustomKey(cmn.ObjLockUntilMD, until.UTC().Format(time.RFC3339))
+}
+
+func (lom *LOM) LegalHold() bool {
+	v, ok := lom.GetCustomKey(cmn.ObjLegalHoldMD)
+	return ok && v == cmn.LegalHoldOn
+}
+
+func (lom *LOM) SetLegalHold(on bool) {
+	if on {
+		lom.SetCustomKey(cmn.ObjLegalHoldMD, cmn.LegalHoldOn)
+	} else {
+		lom.DelCustomKey(cmn.ObjLegalHoldMD)
+	}
+}
+
+// returns cmn.ErrObjLocked if the (loaded) object cannot be modified or deleted;
+// only GOVERNANCE retention can be bypassed
+func (lom *LOM) CheckWORM(bypassGovernance bool) error {
+	if lom.LegalHold() {
+		return cmn.NewErrObjLocked(lom.Cname(), "legal hold", time.Time{})
+	}
+	mode, until := lom.Retention()
+	if mode == "" || !time.Now().Before(until) {
+		return nil
+	}
+	if mode == cmn.ObjLockGovernance && bypassGovernance {
+		return nil
+	}
+	return cmn.NewErrObjLocked(lom.Cname(), mode, until)
+}
+
+// Check that new retention (empty mode: none) does not relax the current one while the latter
+// is in effect: COMPLIANCE cannot be shortened, removed, or downgraded; GOVERNANCE - only
+// with bypass
+func (lom *LOM) CheckRetention(mode string, until time.Time, bypassGovernance bool) error {
+	cur, curUntil := lom.Retention()
+	if cur == "" || !time.Now().Before(curUntil) {
+		return nil
+	}
+	relaxes := mode == "" || until.Before(curUntil) || (cur == cmn.ObjLockCompliance && mode != cmn.ObjLockCompliance)
+	if !relaxes || (cur == cmn.ObjLockGovernance && bypassGovernance) {
+		return nil
+	}
+	return cmn.NewErrObjLocked(lom.Cname(), cur, curUntil)
+}
+
+// deleting (or overwriting) the current version of a given loaded object
+// will preserve the latter as a retained version
+func (lom *LOM) willRetain() bool {
+	return lom.RetainsVersions() && !lom.IsChunked() && !fs.IsFntl(lom.ObjName)
+}
+
+// Check the (loaded) object prior to deleting it
+func (lom *LOM) CheckDelWORM(bypassGovernance bool) error {
+	if lom.willRetain() {
+		return nil
+	}
+	return lom.CheckWORM(bypassGovernance)
+}
+
+// Prior to writing a new object (or a new version of the existing one):
+// - refuse to overwrite the current object if it's locked;
+// - apply the bucket's default retention unless specified explicitly.
+// Expecting the object to be write-locked; lom.md may already carry new attributes
+// (hence, scratch LOM).
+func (lom *LOM) PrewriteWORM() error {
+	debug.Assert(lom.IsLocked() == apc.LockWrite, lom.Cname())
+	conf := &lom.Bprops().ObjLock
+	if !conf.Enabled || !lom.Bck().IsAIS() {
+		return nil
+	}
+	cur := AllocLOM(lom.ObjName)
+	defer FreeLOM(cur)
+	if err := cur.InitBck(lom.Bck()); err != nil {
+		return err
+	}
+	if _, err := cur.lmfs(true); err == nil && !cur.willRetain() {
+		if err := cur.CheckWORM(false /*bypass*/); err != nil {
+			return err
+		}
+	}
+	if mode, _ := lom.Retention(); mode == "" {
+		if mode, until := conf.DefaultRetention(time.Now()); mode != "" {
+			lom.SetRetention(mode, until)
+		}
+	}
+	return nil
+}
+
+// Check a given version (current or retained) of the write-locked object
+// prior to permanently deleting it
+func (lom *LOM) CheckVersionWORM(ver string, bypassGovernance bool) error {
+	debug.Assert(lom.IsLocked() == apc.LockWrite, lom.Cname())
+	if lom.Load(false /*cache it*/, true /*locked*/) == nil && lom.Version() == ver {
+		return lom.CheckWORM(bypassGovernance)
+	}
+	ov, err := lom.GetVersion(ver)
+	if err != nil || ov.DeleteMarker {
+		return nil // (not found and such is handled by the caller)
+	}
+	vlom := AllocLOM(lom.ObjName)
+	defer FreeLOM(vlom)
+	if err := vlom.InitBck(lom.Bck()); err != nil {
+		return err
+	}
+	if err := vlom.InitVersion(ov); err != nil {
+		return err
+	}
+	return vlom.CheckWORM(bypassGovernance)
+}
+
+// Prior to destroying ais:// bucket with object lock enabled: check all objects and
+// retained versions stored on this target; return the first active lock, if any.
+// (the check does not stop concurrent writes - nor does it need to, as the bucket
+// stays locked for the duration of the destroy transaction)
+func CheckBckWORM(bck *meta.Bck) error {
+	if !bck.IsAIS() || bck.Props == nil || !bck.Props.ObjLock.Enabled {
+		return nil
+	}
+	for _, mi := range fs.GetAvail() {
+		for _, ct := range []string{fs.ObjCT, fs.VersionCT} {
+			root := mi.MakePathCT(bck.Bucket(), ct)
+			err := filepath.WalkDir(root, func(fqn string, de os.DirEntry, err error) error {
+				if err != nil {
+					if os.IsNotExist(err) {
+						return nil
+					}
+					return err
+				}
+				if de.IsDir() {
+					return nil
+				}
+				return _checkWORM(bck, root, fqn, ct)
+			})
+			if err != nil {
+				return fmt.Errorf("cannot destroy %s: %w", bck.Cname(""), err)
+			}
+		}
+	}
+	return nil
+}
+
+func _checkWORM(bck *meta.Bck, root, fqn, ct string) error {
+	objName, err := filepath.Rel(root, fqn)
+	if err != nil {
+		return err
+	}
+	if ct == fs.VersionCT {
+		ci := fs.CSM.ParseUbase(objName, ct)
+		if !ci.Ok || len(ci.Extras) > 1 {
+			return nil // (version index, delete marker)
+		}
+		objName = ci.Base
+	}
+	lom := AllocLOM(objName)
+	defer FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		return err
+	}
+	lom.FQN = fqn
+	if _, err := lom.lmfs(true); err != nil {
+		return nil // (not an object)
+	}
+	return lom.CheckWORM(false /*bypass*/)
+}
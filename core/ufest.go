@@ -101,9 +101,9 @@ const (
 	sizeLoad = MaxChunkCount * estPackedChunkSize
 )
 
-// single flag so far
 const (
 	flCompleted uint16 = 1 << 0 // Ufest.flags
+	flMeta      uint16 = 1 << 1 // packed: upload metadata (see SetMeta)
 )
 
 const (
@@ -130,12 +130,13 @@ type (
 		streamCksum     *cos.CksumHash // bucket-configured checksum (nil = abandoned or not configured)
 		id              string         // upload ID/manifest ID
 		chunks          []Uchunk       // all chunks
+		md              cos.StrKVs     // upload metadata to persist with the partial manifest (optional)
 		size            int64          // total
 		mu              sync.Mutex     // to protect state
 		completed       atomic.Bool    // in memory: true when completed, otherwise partial
 		streamInUse     atomic.Bool    // true if a part is currently writing to streamCksum
 		count           uint16         // = len(chunks)
-		flags           uint16         // persistent; currently enum { flCompleted }
+		flags           uint16         // persistent; enum { flCompleted, flMeta }
 		expectedPartNum uint16         // next expected part number (starts at 1)
 	}
 )
@@ -196,6 +197,26 @@ func (u *Ufest) Count() int         { return int(u.count) }
 func (u *Ufest) Created() time.Time { return u.created }
 func (u *Ufest) ID() string         { return u.id }
 
+// upload metadata (e.g., S3 server-side encryption) that must survive
+// restarts and resumed uploads - stored with the manifest
+func (u *Ufest) SetMeta(md cos.StrKVs) {
+	u.mu.Lock()
+	u.md = md
+	if len(md) > 0 {
+		u.flags |= flMeta
+	} else {
+		u.flags &^= flMeta
+	}
+	u.mu.Unlock()
+}
+
+func (u *Ufest) Meta() (md cos.StrKVs) {
+	u.mu.Lock()
+	md = u.md
+	u.mu.Unlock()
+	return
+}
+
 func (u *Ufest) Lock()   { u.mu.Lock() }
 func (u *Ufest) Unlock() { u.mu.Unlock() }
 
@@ -1115,6 +1136,16 @@ func (u *Ufest) pack(w io.Writer) {
 		_packBytes(w, c.MD5)
 		_packStr(w, c.ETag)
 	}
+
+	// upload metadata
+	if u.flags&flMeta != 0 {
+		binary.BigEndian.PutUint16(b16[:], uint16(len(u.md)))
+		w.Write(b16[:])
+		for k, v := range u.md {
+			_packStr(w, k)
+			_packStr(w, v)
+		}
+	}
 }
 
 func _packStr(w io.Writer, s string) {
@@ -1223,6 +1254,26 @@ func (u *Ufest) unpack(r io.Reader) (err error) {
 			return fmt.Errorf("failed to read chunk %d ETag: %w", i, err)
 		}
 	}
+
+	// upload metadata
+	if u.flags&flMeta == 0 {
+		return nil
+	}
+	if _, err = io.ReadFull(r, buf2[:]); err != nil {
+		return fmt.Errorf("failed to read metadata count: %w", err)
+	}
+	n := int(binary.BigEndian.Uint16(buf2[:]))
+	u.md = make(cos.StrKVs, n)
+	for range n {
+		var k, v string
+		if k, err = _unpackStr(r, buf2[:]); err != nil {
+			return fmt.Errorf("failed to read metadata key: %w", err)
+		}
+		if v, err = _unpackStr(r, buf2[:]); err != nil {
+			return fmt.Errorf("failed to read metadata %q: %w", k, err)
+		}
+		u.md[k] = v
+	}
 	return nil
 }
 
@@ -1312,8 +1363,10 @@ func (lom *LOM) CompleteUfest(u *Ufest, locked bool) (err error) {
 	if lom.Bck().IsAIS() && lom.VersionConf().Enabled {
 		if remSrc, ok := lom.GetCustomKey(cmn.SourceObjMD); !ok || remSrc == "" {
 			lom.CopyVersion(prevLom)
-			if err := lom.IncVersion(); err != nil {
-				nlog.Errorln(err)
+			// preserve the current version, if configured (see core/lversion)
+			if err := lom.RetainIncVersion(); err != nil {
+				u.Abort(lom)
+				return err
 			}
 		}
 	}
@@ -38,7 +38,7 @@ run_cmd() {
 # 3. when adding/deleting backends, update the 3 (three) functions that follow below:
 
 set_env_backends() {
-  known_backends=( aws gcp azure oci ht )
+  known_backends=( aws gcp azure oci ht file hdfs )
   if [[ ! -z $TAGS ]]; then
     ## environment var TAGS may contain any/all build tags, including backends
     for b in "${known_backends[@]}"; do
@@ -65,6 +65,8 @@ set_env_backends() {
         gcp)   ;;
         oci)   ;;
         ht)    ;;
+        file)  ;;
+        hdfs)  ;;
         *)     echo "fatal: unknown backend '$b' in 'AIS_BACKEND_PROVIDERS=${AIS_BACKEND_PROVIDERS}'"; exit 1;;
       esac
     done
@@ -128,6 +130,15 @@ make_backend_conf() {
       gcp)   backend_conf+=('"gcp":   {}') ;;
       oci)   backend_conf+=('"oci":   {}') ;;
       ht)    backend_conf+=('"ht":    {}') ;;
+      file)
+        ## shared POSIX directory (e.g., NFS mount) with one sub-directory per file:// bucket
+        local root=${AIS_BACKEND_FILE_ROOT:-/tmp/ais_file_backend}
+        mkdir -p "${root}"
+        backend_conf+=("\"file\":  {\"root\": \"${root}\"}") ;;
+      hdfs)
+        ## WebHDFS (NameNode) endpoint
+        local url=${AIS_BACKEND_HDFS_URL:-http://localhost:9870}
+        backend_conf+=("\"hdfs\":  {\"url\": \"${url}\", \"user\": \"${AIS_BACKEND_HDFS_USER}\"}") ;;
     esac
   done
   echo {$(IFS=$','; echo "${backend_conf[*]}")}
@@ -32,8 +32,17 @@ type Algorithm struct {
 	Decreasing bool `json:"decreasing"`
 
 	// when sort is a random shuffle
+	// (same seed and same input => same output, including the order of samples)
 	Seed string `json:"seed"`
 
+	// usage: all algorithms
+	// rule to group archived files into samples (records) that are sorted, shuffled, and
+	// written together - compare with ishard's `sample_key_pattern`; one of:
+	// - "" or "webdataset" (default): directory and basename up to the first '.'
+	// - regex where the first capture group matches a filename prefix (sample key), e.g. `^(.*)/`
+	// (see also: shard.SampleKey)
+	SampleKeyPattern string `json:"sample_key_pattern"`
+
 	// usage: exclusively for Content sorting
 	// e.g.: ".cls" containing sorting key for each record (sample) - see next
 	// NOTE: not to confuse with shards "input_extension"
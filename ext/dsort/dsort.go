@@ -970,6 +970,9 @@ func (es *extractShard) _do(lom *core.LOM) error {
 		}
 		return err
 	}
+	if err := lom.CheckEncrypted(); err != nil {
+		return err
+	}
 
 	shardRW := m.shardRW
 	if shardRW == nil {
@@ -491,6 +491,10 @@ func (m *Manager) setRW() (err error) {
 	if err != nil {
 		return errors.WithStack(err)
 	}
+	sk, err := shard.NewSampleKey(m.Pars.Algorithm.SampleKeyPattern)
+	if err != nil {
+		return errors.WithStack(err)
+	}
 
 	m.shardRW = shard.RWs[m.Pars.InputExtension]
 	if m.shardRW == nil {
@@ -502,7 +506,7 @@ func (m *Manager) setRW() (err error) {
 		m.shardRW = shard.NopRW(m.shardRW)
 	}
 
-	m.recm = shard.NewRecordManager(m.Pars.InputBck, m.shardRW, ke, m.onDupRecs)
+	m.recm = shard.NewRecordManager(m.Pars.InputBck, m.shardRW, ke, sk, m.onDupRecs)
 	return nil
 }
 
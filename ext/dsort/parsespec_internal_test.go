@@ -481,6 +481,21 @@ var _ = Describe("RequestSpec", func() {
 			Expect(err).Should(HaveOccurred())
 		})
 
+		It("should fail due to invalid sample key pattern", func() {
+			for _, pattern := range []string{`^(.*`, `^.*/`} {
+				rs := RequestSpec{
+					InputBck:        cmn.Bck{Name: "test"},
+					InputExtension:  archive.ExtTar,
+					InputFormat:     newInputFormat("prefix-{0010..0111}-suffix"),
+					OutputFormat:    "prefix-{0010..0111}-suffix",
+					OutputShardSize: "10KB",
+					Algorithm:       Algorithm{Kind: Shuffle, SampleKeyPattern: pattern},
+				}
+				_, err := rs.parse()
+				Expect(err).Should(HaveOccurred(), pattern)
+			}
+		})
+
 		It("should fail when output shard size is empty and output format is %06d", func() {
 			rs := RequestSpec{
 				InputBck:       cmn.Bck{Name: "test"},
@@ -223,6 +223,9 @@ func parseAlgorithm(alg Algorithm) (*Algorithm, error) {
 	} else {
 		alg.ContentKeyType = shard.ContentKeyString
 	}
+	if _, err := shard.NewSampleKey(alg.SampleKeyPattern); err != nil {
+		return nil, err
+	}
 
 	return &alg, nil
 }
@@ -43,7 +43,7 @@ func (c *rcbCtx) Call(_ string, reader cos.ReadCloseSizer, hdr any) (bool /*stop
 	return c.xzip("", reader, hdr)
 }
 
-// handles .tar, .targz, and .tarlz4 - anything and everything that has tar headers
+// handles .tar, .targz, .tarlz4, and .tarzst - anything and everything that has tar headers
 func (c *rcbCtx) xtar(_ string, reader cos.ReadCloseSizer, hdr any) (bool /*stop*/, error) {
 	header, ok := hdr.(*tar.Header)
 	debug.Assert(ok)
@@ -83,7 +83,7 @@ func (c *rcbCtx) xtar(_ string, reader cos.ReadCloseSizer, hdr any) (bool /*stop
 		// tar (and zip - below)
 		args.fileType = fs.ObjCT
 	} else {
-		// tar.gz and tar.lz4
+		// tar.gz, tar.lz4, and tar.zst
 		if err := c.tw.WriteHeader(header); err != nil {
 			return true, err
 		}
@@ -68,6 +68,7 @@ type (
 
 		extractCreator  RW
 		keyExtractor    KeyExtractor
+		sampleKey       *SampleKey // groups archived files into records (nil: default rule)
 		contents        *sync.Map
 		extractionPaths *sync.Map // Keys correspond to all paths to record contents on disk.
 
@@ -82,13 +83,14 @@ type (
 // RecordManager //
 ///////////////////
 
-func NewRecordManager(bck cmn.Bck, extractCreator RW, keyExtractor KeyExtractor, onDupRecs func(string) error) *RecordManager {
+func NewRecordManager(bck cmn.Bck, extractCreator RW, keyExtractor KeyExtractor, sampleKey *SampleKey, onDupRecs func(string) error) *RecordManager {
 	return &RecordManager{
 		Records:             NewRecords(1000),
 		bck:                 bck,
 		onDuplicatedRecords: onDupRecs,
 		extractCreator:      extractCreator,
 		keyExtractor:        keyExtractor,
+		sampleKey:           sampleKey,
 		contents:            &sync.Map{},
 		extractionPaths:     &sync.Map{},
 	}
@@ -100,8 +102,8 @@ func (recm *RecordManager) RecordWithBuffer(args *extractRecordArgs) (size int64
 		contentPath      string
 		fullContentPath  string
 		mdSize           int64
-		ext              = cosExt(args.recordName)
-		recordUniqueName = genRecordUname(args.shardName, args.recordName)
+		sampleKey, ext   = recm.sampleKey.Split(args.recordName)
+		recordUniqueName = args.shardName + recSepa + sampleKey
 	)
 
 	// handle record duplications (see m.react)
@@ -118,7 +120,13 @@ func (recm *RecordManager) RecordWithBuffer(args *extractRecordArgs) (size int64
 
 	debug.Assert(!args.extractMethod.Has(ExtractToWriter) || args.w != nil)
 
-	r, ske, needRead := recm.keyExtractor.PrepareExtractor(args.recordName, args.r, ext)
+	// when grouping by (non-default) sample key, the latter is also the name to sort by;
+	// content key extractor always matches the actual filename extension
+	name := args.recordName
+	if recm.sampleKey != nil {
+		name = sampleKey
+	}
+	r, ske, needRead := recm.keyExtractor.PrepareExtractor(name, args.r, cosExt(args.recordName))
 	switch {
 	case args.extractMethod.Has(ExtractToMem):
 		mdSize = int64(len(args.metadata))
@@ -235,15 +243,13 @@ func (recm *RecordManager) encodeRecordName(storeType, shardName, recordName str
 		// For sgl:
 		//  * contentPath = recordUniqueName with extension (eg. shard_1-record_name.cls)
 		//  * fullContentPath = recordUniqueName with extension (eg. shard_1-record_name.cls)
-		recordExt := cosExt(recordName)
-		contentPath := genRecordUname(shardName, recordName) + recordExt
+		contentPath := shardName + recSepa + recordName
 		return contentPath, contentPath // unique key for record
 	case DiskStoreType:
 		// For disk:
 		//  * contentPath = recordUniqueName with extension  (eg. shard_1-record_name.cls)
 		//  * fullContentPath = fqn to recordUniqueName with extension (eg. <bucket_fqn>/shard_1-record_name.cls)
-		recordExt := cosExt(recordName)
-		contentPath := genRecordUname(shardName, recordName) + recordExt
+		contentPath := shardName + recSepa + recordName
 		ct, err := core.NewDsortCT(&recm.bck, contentPath)
 		debug.AssertNoErr(err)
 		return contentPath, ct.GenFQN(fs.DsortFileCT)
@@ -282,8 +288,9 @@ func (recm *RecordManager) FreeMem(fullContentPath, newStoreType string, value a
 	sgl, ok := value.(*memsys.SGL)
 	debug.Assert(ok)
 
-	recordObjExt := cosExt(fullContentPath)
-	contentPath := strings.TrimSuffix(fullContentPath, recordObjExt)
+	shardName, recordName := parseRecordUname(fullContentPath)
+	sampleKey, recordObjExt := recm.sampleKey.Split(recordName)
+	contentPath := shardName + recSepa + sampleKey
 
 	recm.Records.Lock()
 	defer recm.Records.Unlock()
@@ -389,11 +396,6 @@ func cosExt(path string) (ext string) {
 	return
 }
 
-func genRecordUname(shardName, recordName string) string {
-	recordWithoutExt := strings.TrimSuffix(recordName, cosExt(recordName))
-	return shardName + recSepa + recordWithoutExt
-}
-
 func parseRecordUname(recordUniqueName string) (shardName, recordName string) {
 	splits := strings.SplitN(recordUniqueName, recSepa, 2)
 	return splits[0], splits[1]
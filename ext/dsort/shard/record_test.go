@@ -154,3 +154,36 @@ var _ = Describe("Records", func() {
 		})
 	})
 })
+
+var _ = Describe("SampleKey", func() {
+	split := func(pattern, name string) []string {
+		sk, err := shard.NewSampleKey(pattern)
+		Expect(err).NotTo(HaveOccurred())
+		key, ext := sk.Split(name)
+		Expect(key + ext).To(Equal(name))
+		return []string{key, ext}
+	}
+
+	It("should split by WebDataset convention by default", func() {
+		for _, pattern := range []string{"", shard.SampleKeyWebDataset} {
+			Expect(split(pattern, "a/000123.seg.png")).To(Equal([]string{"a/000123", ".seg.png"}))
+			Expect(split(pattern, "a.b/000123.cls")).To(Equal([]string{"a.b/000123", ".cls"}))
+			Expect(split(pattern, "a/000123")).To(Equal([]string{"a/000123", ""}))
+		}
+	})
+
+	It("should split by custom pattern", func() {
+		Expect(split(`^(.*)/`, "a/b/img.jpg")).To(Equal([]string{"a/b", "/img.jpg"}))
+		Expect(split(`^(.+_\d+)_`, "x_0001_label.txt")).To(Equal([]string{"x_0001", "_label.txt"}))
+		// not a prefix match: default
+		Expect(split(`_(\d+)_`, "x_0001_label.txt")).To(Equal([]string{"x_0001_label", ".txt"}))
+		Expect(split(`^(.*)/`, "img.jpg")).To(Equal([]string{"img", ".jpg"}))
+	})
+
+	It("should reject invalid patterns", func() {
+		for _, pattern := range []string{`^(.*`, `^.*/`} {
+			_, err := shard.NewSampleKey(pattern)
+			Expect(err).To(HaveOccurred())
+		}
+	})
+})
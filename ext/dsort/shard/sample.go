@@ -0,0 +1,61 @@
+//go:build dsort
+
+// Package shard provides Extract(shard), Create(shard), and associated methods
+// across all supported archival formats (see cmn/archive/mime.go)
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package shard
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// Sample key rule: archived files that share a sample key (within a given input shard)
+// constitute a single Record and always travel together - sorted, shuffled, and
+// written into the same output shard.
+//
+// Invariant: sampleKey + objectExtension == archived filename.
+//
+// - "" or "webdataset" (default): WebDataset convention - directory and basename
+//   up to its first '.'; e.g. "a/000123.seg.png" => ("a/000123", ".seg.png");
+// - regex: the first capture group must match a prefix of the filename, e.g.
+//   `^(.*)/` groups files by directory, `^(.+_\d+)_` groups "x_0001_img.jpg"
+//   and "x_0001_label.txt"; filenames that do not match fall back to the default.
+
+const SampleKeyWebDataset = "webdataset"
+
+type SampleKey struct {
+	re *regexp.Regexp
+}
+
+// returns nil for the default rule
+func NewSampleKey(pattern string) (*SampleKey, error) {
+	if pattern == "" || pattern == SampleKeyWebDataset {
+		return nil, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sample key pattern %q: %v", pattern, err)
+	}
+	if re.NumSubexp() < 1 {
+		return nil, fmt.Errorf("invalid sample key pattern %q: %w", pattern, errNoCaptureGroup)
+	}
+	return &SampleKey{re: re}, nil
+}
+
+var errNoCaptureGroup = errors.New("expecting at least one capture group (sample key)")
+
+// (nil-receiver safe)
+func (sk *SampleKey) Split(name string) (key, ext string) {
+	if sk != nil {
+		m := sk.re.FindStringSubmatchIndex(name)
+		if len(m) >= 4 && m[2] == 0 && m[3] > 0 {
+			return name[:m[3]], name[m[3]:]
+		}
+	}
+	ext = cosExt(name)
+	return name[:len(name)-len(ext)], ext
+}
@@ -2,7 +2,7 @@
 
 // Package dsort provides APIs for distributed archive file shuffling.
 /*
- * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2026, NVIDIA CORPORATION. All rights reserved.
  */
 package dsort
 
@@ -47,6 +47,32 @@ func (s *alphaByKey) Less(i, j int) bool {
 	return less
 }
 
+// canonical order: by name and then, for same-name records from different
+// shards, by the target and the source (shard) of the record's first object
+func lessRecord(a, b *shard.Record) bool {
+	if a.Name != b.Name {
+		return a.Name < b.Name
+	}
+	if a.DaemonID != b.DaemonID {
+		return a.DaemonID < b.DaemonID
+	}
+	switch {
+	case len(a.Objects) == 0:
+		return len(b.Objects) > 0
+	case len(b.Objects) == 0:
+		return false
+	}
+	return lessObj(a.Objects[0], b.Objects[0])
+}
+
+// record's objects: by extension and then by source (shard)
+func lessObj(a, b *shard.RecordObj) bool {
+	if a.Extension != b.Extension {
+		return a.Extension < b.Extension
+	}
+	return a.ContentPath < b.ContentPath
+}
+
 // sorts records by each Record.Key in the order determined by the `alg` algorithm.
 func sortRecords(r *shard.Records, alg *Algorithm) (err error) {
 	switch alg.Kind {
@@ -60,6 +86,14 @@ func sortRecords(r *shard.Records, alg *Algorithm) (err error) {
 		if alg.Seed != "" {
 			seed, err = strconv.ParseInt(alg.Seed, 10, 64)
 			debug.AssertNoErr(err)
+			// records arrive (and get merged) in no particular order -
+			// start from the canonical one to make seeded shuffle reproducible
+			recs := r.All()
+			for _, rec := range recs {
+				// same-name records from different shards get merged in the order of arrival
+				sort.SliceStable(rec.Objects, func(i, j int) bool { return lessObj(rec.Objects[i], rec.Objects[j]) })
+			}
+			sort.SliceStable(recs, func(i, j int) bool { return lessRecord(recs[i], recs[j]) })
 		}
 		rnd = rand.New(rand.NewPCG(uint64(seed), 0))
 		for i := range r.Len() { // https://en.wikipedia.org/wiki/Fisher%E2%80%93Yates_shuffle
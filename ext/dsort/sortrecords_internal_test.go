@@ -10,6 +10,8 @@ package dsort
 
 import (
 	"fmt"
+	"math/rand/v2"
+	"slices"
 
 	"github.com/NVIDIA/aistore/ext/dsort/shard"
 
@@ -90,6 +92,46 @@ var _ = Describe("SortRecords", func() {
 		Expect(fm).To(Equal(expected))
 	})
 
+	It("should shuffle records reproducibly regardless of the order they were merged in", func() {
+		alg := &Algorithm{Kind: Shuffle, Seed: "1010102", ContentKeyType: shard.ContentKeyString}
+		expected := createRecords("abc", "def", "ghi", "klm")
+		fm := createRecords("ghi", "klm", "def", "abc")
+		Expect(sortRecords(expected, alg)).ToNot(HaveOccurred())
+		Expect(sortRecords(fm, alg)).ToNot(HaveOccurred())
+		Expect(fm).To(Equal(expected))
+	})
+
+	It("should shuffle same-name records from different shards reproducibly", func() {
+		var (
+			alg = &Algorithm{Kind: Shuffle, Seed: "1010102", ContentKeyType: shard.ContentKeyString}
+			// (shard, name, extension) in the order of arrival
+			recs = func(srcs ...[3]string) *shard.Records {
+				records := shard.NewRecords(len(srcs))
+				for _, src := range srcs {
+					records.Insert(&shard.Record{
+						Key:     src[1],
+						Name:    src[1],
+						Objects: []*shard.RecordObj{{ContentPath: src[0], Extension: src[2]}},
+					})
+				}
+				return records
+			}
+			srcs = [][3]string{
+				{"shard-1", "abc", ".jpg"}, {"shard-2", "abc", ".cls"}, {"shard-1", "def", ".jpg"},
+				{"shard-2", "def", ".jpg"}, {"shard-3", "ghi", ".cls"}, {"shard-1", "ghi", ".cls"},
+			}
+			expected = recs(srcs...)
+		)
+		Expect(sortRecords(expected, alg)).ToNot(HaveOccurred())
+		for range 10 {
+			shuffled := slices.Clone(srcs)
+			rand.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+			fm := recs(shuffled...)
+			Expect(sortRecords(fm, alg)).ToNot(HaveOccurred())
+			Expect(fm.All()).To(Equal(expected.All()))
+		}
+	})
+
 	It("should return error when some keys are missing", func() {
 		fm := createRecords("def", "abc")
 		fm.All()[0].Key = nil
@@ -26,6 +26,7 @@ const (
 	ECMetaCT    = "mt"
 	ChunkCT     = "ch"
 	ChunkMetaCT = "ut"
+	VersionCT   = "vr"
 
 	// ext
 	DsortFileCT = "ds"
@@ -35,6 +36,10 @@ const (
 const (
 	ssepa = "."
 	bsepa = '.'
+
+	// VersionCT: delete marker suffix and per-object version index (see versionCR)
+	VersionDeleteMarker = "dm"
+	VersionIndex        = "vi"
 )
 
 type (
@@ -66,6 +71,7 @@ type (
 	ecMetaCR    struct{}
 	objChunkCR  struct{}
 	chunkMetaCR struct{}
+	versionCR   struct{}
 	dsortCR     struct{}
 )
 
@@ -91,6 +97,7 @@ var (
 	_ contentRes = (*ecMetaCR)(nil)
 	_ contentRes = (*objChunkCR)(nil)
 	_ contentRes = (*chunkMetaCR)(nil)
+	_ contentRes = (*versionCR)(nil)
 )
 
 // register all content types
@@ -110,6 +117,7 @@ func (csm *contentSpecMgr) regAll() {
 	csm._reg(ECMetaCT, &ecMetaCR{})
 	csm._reg(ChunkCT, &objChunkCR{})
 	csm._reg(ChunkMetaCT, &chunkMetaCR{})
+	csm._reg(VersionCT, &versionCR{})
 
 	csm._reg(DsortFileCT, &dsortCR{})
 	csm._reg(DsortWorkCT, &dsortCR{})
@@ -279,6 +287,41 @@ func (*chunkMetaCR) parseUbase(base string) ContentInfo {
 	return ContentInfo{Base: base[:i], Extras: []string{uploadID}, Ok: true} // partial
 }
 
+// versionCR: retained prior versions of objects in versioned ais:// buckets (%vr/ directory)
+// Named "<object>.<version>"; delete markers are (empty) "<object>.<version>.dm";
+// the version index "<object>.vi" is not parseable (not a version)
+
+func (*versionCR) makeUbase(base string, extras ...string) string {
+	debug.Assert(len(extras) == 1 || (len(extras) == 2 && extras[1] == VersionDeleteMarker), extras)
+	if len(extras) == 2 {
+		return base + ssepa + extras[0] + ssepa + extras[1]
+	}
+	return base + ssepa + extras[0]
+}
+
+func (*versionCR) parseUbase(base string) ContentInfo {
+	var (
+		extras []string
+		i      = strings.LastIndexByte(base, bsepa)
+	)
+	if i < 0 {
+		return ContentInfo{}
+	}
+	if base[i+1:] == VersionDeleteMarker {
+		base = base[:i]
+		if i = strings.LastIndexByte(base, bsepa); i < 0 {
+			return ContentInfo{}
+		}
+		extras = []string{base[i+1:], VersionDeleteMarker}
+	} else {
+		extras = []string{base[i+1:]}
+	}
+	if _, err := strconv.ParseUint(extras[0], 10, 64); err != nil || i == 0 {
+		return ContentInfo{}
+	}
+	return ContentInfo{Base: base[:i], Extras: extras, Ok: true}
+}
+
 func (*ecSliceCR) makeUbase(base string, _ ...string) string { return base }
 
 func (*ecSliceCR) parseUbase(base string) ContentInfo {
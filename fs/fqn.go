@@ -207,6 +207,8 @@ func CleanPathErr(err error) {
 			what = "chunk"
 		case ChunkMetaCT:
 			what = "chunk manifest"
+		case VersionCT:
+			what = "object version"
 		default:
 			what = fmt.Sprintf("content type '%s'(?)", parsed.ContentType)
 		}
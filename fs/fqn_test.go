@@ -493,3 +493,42 @@ func BenchmarkParseFQN(b *testing.B) {
 		parsed.Init(fqn)
 	}
 }
+
+func TestVersionCTRoundTrip(t *testing.T) {
+	tmpMpath := t.TempDir()
+
+	mios := mock.NewIOS()
+	fs.TestNew(mios)
+	_, err := fs.Add(tmpMpath, "daeID")
+	tassert.CheckFatal(t, err)
+
+	mpaths := fs.GetAvail()
+	mi := mpaths[tmpMpath]
+	bck := &cmn.Bck{Name: "bucket", Provider: apc.AIS, Ns: cmn.NsGlobal}
+
+	tests := []struct {
+		objName string
+		extras  []string
+	}{
+		{"obj.bin", []string{"1"}},
+		{"dir/obj.tar.gz", []string{"17"}},
+		{"dir/obj.tar.gz", []string{"18", fs.VersionDeleteMarker}},
+	}
+	for _, tc := range tests {
+		fqn := fs.CSM.Gen(tc.objName, fs.VersionCT, bck, mi, tc.extras...)
+
+		var parsed fs.ParsedFQN
+		tassert.CheckFatal(t, parsed.Init(fqn))
+		tassert.Fatalf(t, parsed.ContentType == fs.VersionCT, "unexpected content type %q", parsed.ContentType)
+
+		ci := fs.CSM.ParseUbase(parsed.ObjName, fs.VersionCT)
+		tassert.Fatalf(t, ci.Ok && ci.Base == tc.objName && strings.Join(ci.Extras, ",") == strings.Join(tc.extras, ","),
+			"%s %v: unexpected %+v", tc.objName, tc.extras, ci)
+	}
+
+	// not a version
+	for _, ubase := range []string{"obj", "obj.bin", "obj.dm", ".3", "obj.bin." + fs.VersionIndex} {
+		ci := fs.CSM.ParseUbase(ubase, fs.VersionCT)
+		tassert.Fatalf(t, !ci.Ok, "%q: expected parsing failure, got %+v", ubase, ci)
+	}
+}
@@ -24,6 +24,7 @@ const numMarkers = 1
 // List of AIS metadata files and directories (basenames only)
 var mdFilesDirs = [...]string{
 	fname.MarkersDir,
+	fname.ReplDir,
 	fname.Bmd,
 	fname.BmdPrevious,
 	fname.Vmd,
@@ -15,6 +15,9 @@ const (
 	DelOldIval        = 24 * time.Minute // cleanup old xactions; old transactions
 	Prune2mIval       = 2 * time.Minute  // prune active xactions (from finished); cleanup notifs; remove aged idle SDM recv
 	PruneRateLimiters = 6 * time.Hour    // prune stale rate limiters on the front
+	LifecycleIval     = time.Hour        // execute bucket lifecycle rules (expire objects, abort stale uploads)
+	ReplIval          = time.Minute      // resume bucket replication with pending (journaled) work
+	SecretsIval       = 10 * time.Second // check per-bucket backend secrets for changes (rotation)
 
 	//
 	// when things are getting _old_
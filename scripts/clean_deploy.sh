@@ -65,6 +65,8 @@ OPTIONS:
   --azure             Build with Azure Blob Storage backend
   --oci               Build with OCI Object Storage backend
   --ht                Build with ht:// backend (experimental)
+  --file              Build with file:// backend (shared POSIX filesystem; root: AIS_BACKEND_FILE_ROOT)
+  --hdfs              Build with hdfs:// backend (WebHDFS; endpoint: AIS_BACKEND_HDFS_URL)
   --loopback          Loopback device size, e.g. 10G, 100M (default: 0). Zero size means emulated mountpaths (with no loopback devices).
   --dir               The root directory of the aistore repository
   --https             Use HTTPS (note: X509 certificates may be required)
@@ -106,6 +108,8 @@ while (( "$#" )); do
     --gcp)   AIS_BACKEND_PROVIDERS="${AIS_BACKEND_PROVIDERS} gcp"; shift;;
     --oci)   AIS_BACKEND_PROVIDERS="${AIS_BACKEND_PROVIDERS} oci"; shift;;
     --ht)    AIS_BACKEND_PROVIDERS="${AIS_BACKEND_PROVIDERS} ht"; shift;;
+    --file)  AIS_BACKEND_PROVIDERS="${AIS_BACKEND_PROVIDERS} file"; shift;;
+    --hdfs)  AIS_BACKEND_PROVIDERS="${AIS_BACKEND_PROVIDERS} hdfs"; shift;;
 
     --tracing)
       tracing="y\n${AIS_TRACING_ENDPOINT}\n${AIS_TRACING_AUTH_TOKEN_HEADER}\n${AIS_TRACING_AUTH_TOKEN_FILE}"
@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -488,6 +489,7 @@ func (j *clnJ) jogBcks(bcks []cmn.Bck) {
 			}
 			continue
 		}
+		j.bck.Props = b.Props // (EC and versioning checks below)
 		j._jogBck()
 		if xcln.IsAborted() || j.done() {
 			return
@@ -501,7 +503,7 @@ func (j *clnJ) _jogBck() {
 	opts := &fs.WalkOpts{
 		Mi:       j.mi,
 		Bck:      j.bck,
-		CTs:      []string{fs.WorkCT, fs.ObjCT, fs.ECSliceCT, fs.ECMetaCT, fs.ChunkCT, fs.ChunkMetaCT},
+		CTs:      []string{fs.WorkCT, fs.ObjCT, fs.ECSliceCT, fs.ECMetaCT, fs.ChunkCT, fs.ChunkMetaCT, fs.VersionCT},
 		Callback: j.visit,
 		Sorted:   false,
 	}
@@ -673,6 +675,39 @@ func (j *clnJ) visitCT(parsed *fs.ParsedFQN, fqn string) {
 		j.appendOldWork(fqn)
 		j.rmAnyBatch(flagRmOldWork)
 
+	// retained versions (see core/lversion):
+	// - remove all when the bucket does not retain versions
+	// - otherwise, remove versions (and indexes) that are not referenced
+	case fs.VersionCT:
+		if !j.bck.IsAIS() || !j.bck.Props.Versioning.Enabled || !j.bck.Props.Versioning.RetainPrior {
+			j.appendOldWork(fqn)
+			j.rmAnyBatch(flagRmOldWork)
+			return
+		}
+		var (
+			entries []string
+			base    string
+		)
+		if ci := fs.CSM.ParseUbase(parsed.ObjName, fs.VersionCT); ci.Ok {
+			base, entries = ci.Base, []string{strings.Join(ci.Extras, ".")}
+		} else if b, ok := strings.CutSuffix(parsed.ObjName, "."+fs.VersionIndex); ok && b != "" {
+			base = b // version index
+		} else {
+			j.rmInvalidFQN(fqn, "version", nil)
+			return
+		}
+		lom := core.AllocLOM(base)
+		if j.initCTLOM(lom, fqn) == nil {
+			lom.Lock(false)
+			ok, err := lom.HasRetained(entries...)
+			lom.Unlock(false)
+			if err == nil && !ok {
+				j.appendOldWork(fqn)
+				j.rmAnyBatch(flagRmOldWork)
+			}
+		}
+		core.FreeLOM(lom)
+
 	case fs.ChunkCT:
 		contentInfo := fs.CSM.ParseUbase(parsed.ObjName, fs.ChunkCT)
 		if !contentInfo.Ok {
@@ -31,9 +31,10 @@ import (
 )
 
 const (
-	tmpDir     = "/tmp/cleanup-test"
-	numMpaths  = 3
-	bucketName = "test-bucket"
+	tmpDir        = "/tmp/cleanup-test"
+	numMpaths     = 3
+	bucketName    = "test-bucket"
+	bucketNameVer = "test-bucket-ver"
 )
 
 func TestEvictCleanup(t *testing.T) {
@@ -94,6 +95,15 @@ var _ = Describe("AIStore content cleanup tests", func() {
 					BID:    0xa7b8c1d2,
 				},
 			),
+			meta.NewBck(
+				bucketNameVer, apc.AIS, cmn.NsGlobal,
+				&cmn.Bprops{
+					Cksum:      cmn.CksumConf{Type: cos.ChecksumNone},
+					Versioning: cmn.VersionConf{Enabled: true, RetainPrior: true},
+					Access:     apc.AccessAll,
+					BID:        0xa7b8c1d3,
+				},
+			),
 			sysNBI,
 			sysShardIdx,
 		)
@@ -419,6 +429,46 @@ var _ = Describe("AIStore content cleanup tests", func() {
 		})
 	})
 
+	Describe("Retained versions cleanup", func() {
+		It("should remove versions when the bucket does not retain them", func() {
+			mi := fs.GetAvail()[mpaths[0]]
+			vfqn := filepath.Join(mi.MakePathCT(&bck, fs.VersionCT), "obj.1")
+			createTestFile(vfqn, 128)
+			os.Chtimes(vfqn, now.Add(-3*time.Hour), now.Add(-3*time.Hour))
+
+			space.RunCleanup(ini)
+			Expect(vfqn).NotTo(BeAnExistingFile())
+		})
+
+		It("should keep indexed versions and remove orphans", func() {
+			var (
+				lom   = &core.LOM{ObjName: "obj"}
+				vbck  = cmn.Bck{Name: bucketNameVer, Provider: apc.AIS, Ns: cmn.NsGlobal}
+				old   = now.Add(-3 * time.Hour)
+				kept  = []string{"obj.1", "obj.2.dm", "obj.vi"}
+				orphs = []string{"obj.3", "gone.1", "gone.vi"}
+			)
+			Expect(lom.InitCmnBck(&vbck)).NotTo(HaveOccurred())
+			vdir := filepath.Dir(lom.GenFQN(fs.VersionCT, "1")) // (versions are stored on the object's mountpath)
+			for _, name := range append(kept, orphs...) {
+				createTestFile(filepath.Join(vdir, name), 0)
+			}
+			Expect(os.WriteFile(filepath.Join(vdir, "obj.vi"), []byte("1\n2.dm\n"), 0o644)).NotTo(HaveOccurred())
+			Expect(os.WriteFile(filepath.Join(vdir, "gone.vi"), []byte("7\n"), 0o644)).NotTo(HaveOccurred())
+			for _, name := range append(kept, orphs...) {
+				os.Chtimes(filepath.Join(vdir, name), old, old)
+			}
+
+			space.RunCleanup(ini)
+			for _, name := range kept {
+				Expect(filepath.Join(vdir, name)).To(BeAnExistingFile())
+			}
+			for _, name := range orphs {
+				Expect(filepath.Join(vdir, name)).NotTo(BeAnExistingFile())
+			}
+		})
+	})
+
 	Describe("Deleted content cleanup", func() {
 		It("should aggressively clean deleted content regardless of age", func() {
 			avail := fs.GetAvail()
@@ -360,6 +360,9 @@ func (j *lruJ) _visit(lom *core.LOM, parsedFQN *fs.ParsedFQN) (pushed bool) {
 	if lom.HasCopies() && lom.IsCopy() {
 		return false
 	}
+	if lom.CheckWORM(false /*bypass*/) != nil {
+		return false // object lock (see cmn/objlock)
+	}
 
 	hlen := int64(j.heap.Len())
 	if lom.AtimeUnix() > j.newest {
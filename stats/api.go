@@ -119,6 +119,8 @@ func LatencyToCounter(latName string) string {
 		return RatelimGetRetryCount
 	case RatelimPutRetryLatencyTotal:
 		return RatelimPutRetryCount
+	case ReplLagTotal:
+		return ReplCount
 	case HeadLatencyTotal:
 		return HeadCount
 	case ListLatency:
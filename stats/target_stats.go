@@ -78,6 +78,20 @@ const (
 	RatelimPutRetryCount        = "ratelim.retry.put.n"
 	RatelimPutRetryLatencyTotal = "ratelim.retry.put.ns.total"
 
+	// cold GET hedging and failover (see cmn.HedgeConf)
+	HedgeGetCount    = "hedge.get.n"
+	HedgeWonGetCount = "hedge.won.get.n"
+	FailoverGetCount = "failover.get.n"
+
+	// bucket replication to remote AIS cluster (see cmn.ReplConf)
+	ReplCount      = "repl.n"
+	ReplSize       = "repl.size"
+	ReplDelCount   = "repl.del.n"
+	ReplSkipCount  = "repl.skip.n"
+	ReplRetryCount = "repl.retry.n"
+	ReplLagTotal   = "repl.lag.ns.total"
+	ErrReplCount   = errPrefix + ReplCount
+
 	// compare w/ common `DeleteCount`
 	RemoteDeletedDelCount = core.RemoteDeletedDelCount
 )
@@ -525,6 +539,70 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 		},
 	)
 
+	// hedging and failover
+	r.reg(snode, HedgeGetCount, KindCounter,
+		&Extra{
+			Help:    "GET: number of hedged cold GET requests issued after the configured latency percentile",
+			VarLabs: BckXlabs,
+		},
+	)
+	r.reg(snode, HedgeWonGetCount, KindCounter,
+		&Extra{
+			Help:    "GET: number of times a hedged cold GET request responded first",
+			VarLabs: BckXlabs,
+		},
+	)
+	r.reg(snode, FailoverGetCount, KindCounter,
+		&Extra{
+			Help:    "GET: number of cold GET failovers to the next equivalent endpoint (upon 5xx, 429, or network error)",
+			VarLabs: BckXlabs,
+		},
+	)
+
+	// bucket replication
+	r.reg(snode, ReplCount, KindCounter,
+		&Extra{
+			Help:    "replication: number of objects replicated (PUT) to the destination bucket in remote AIS cluster",
+			VarLabs: BckXlabs,
+		},
+	)
+	r.reg(snode, ReplSize, KindSize,
+		&Extra{
+			Help:    "replication: total cumulative size (bytes) of replicated objects",
+			VarLabs: BckXlabs,
+		},
+	)
+	r.reg(snode, ReplDelCount, KindCounter,
+		&Extra{
+			Help:    "replication: number of deletions replicated to the destination bucket in remote AIS cluster",
+			VarLabs: BckXlabs,
+		},
+	)
+	r.reg(snode, ReplSkipCount, KindCounter,
+		&Extra{
+			Help:    "replication: number of PUTs and deletions skipped upon conflict (destination is newer or already up to date)",
+			VarLabs: BckXlabs,
+		},
+	)
+	r.reg(snode, ReplRetryCount, KindCounter,
+		&Extra{
+			Help:    "replication: number of retries (upon 5xx, 409, 429, or network error)",
+			VarLabs: BckXlabs,
+		},
+	)
+	r.reg(snode, ReplLagTotal, KindTotal,
+		&Extra{
+			Help:    "replication: total lag (nanoseconds) between the original (local) write and its replication",
+			VarLabs: BckXlabs,
+		},
+	)
+	r.reg(snode, ErrReplCount, KindCounter,
+		&Extra{
+			Help:    "replication: number of errors (not retried; the respective PUTs and deletions are dropped)",
+			VarLabs: BckXlabs,
+		},
+	)
+
 	// ETL inline
 	r.reg(snode, ETLInlineCount, KindCounter,
 		&Extra{
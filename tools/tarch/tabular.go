@@ -0,0 +1,191 @@
+// Package archive provides common low-level utilities for testing archives
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tarch
+
+import (
+	"encoding/binary"
+	"strconv"
+)
+
+// minimal (uncompressed, PLAIN-encoded) Parquet file with a given number of rows per row group:
+//   id    INT64 required           (row index)
+//   name  BYTE_ARRAY UTF8 optional ("name-<row index>"; every third is null)
+// see also: cmn/tabular
+
+const pqMagic = "PAR1"
+
+// thrift compact protocol: types
+const (
+	tcI32    = 5
+	tcI64    = 6
+	tcBinary = 8
+	tcList   = 9
+	tcStruct = 12
+)
+
+type (
+	tcEnc struct {
+		b    []byte
+		last []int16
+	}
+	pqChunk struct {
+		ptype, numValues int64
+		off, size        int64
+	}
+)
+
+func CreateParquet(rowGroups ...int) []byte {
+	var (
+		file  = []byte(pqMagic)
+		rgs   [][2]pqChunk
+		total int
+	)
+	for _, n := range rowGroups {
+		var (
+			ids    []byte
+			names  []byte
+			defs   = make([]byte, (n+7)/8) // bit-packed definition levels
+			chunks [2]pqChunk
+		)
+		for i := range n {
+			k := total + i
+			ids = binary.LittleEndian.AppendUint64(ids, uint64(k))
+			if k%3 == 2 {
+				continue
+			}
+			defs[i>>3] |= 1 << (i & 7)
+			s := "name-" + strconv.Itoa(k)
+			names = binary.LittleEndian.AppendUint32(names, uint32(len(s)))
+			names = append(names, s...)
+		}
+		// id
+		chunks[0] = pqChunk{ptype: 2 /*INT64*/, numValues: int64(n), off: int64(len(file))}
+		file = append(file, pqPage(ids, n)...)
+		chunks[0].size = int64(len(file)) - chunks[0].off
+
+		// name: definition levels (4-byte length, bit-packed run) followed by values
+		levels := binary.AppendUvarint(nil, uint64(len(defs))<<1|1)
+		levels = append(levels, defs...)
+		data := binary.LittleEndian.AppendUint32(nil, uint32(len(levels)))
+		data = append(data, levels...)
+		data = append(data, names...)
+		chunks[1] = pqChunk{ptype: 6 /*BYTE_ARRAY*/, numValues: int64(n), off: int64(len(file))}
+		file = append(file, pqPage(data, n)...)
+		chunks[1].size = int64(len(file)) - chunks[1].off
+
+		rgs = append(rgs, chunks)
+		total += n
+	}
+	footer := pqFooter(rgs, rowGroups, total)
+	file = append(file, footer...)
+	file = binary.LittleEndian.AppendUint32(file, uint32(len(footer)))
+	return append(file, pqMagic...)
+}
+
+func pqPage(data []byte, n int) []byte {
+	e := &tcEnc{last: []int16{0}}
+	e.i32(1, 0 /*DATA_PAGE*/)
+	e.i32(2, int32(len(data)))
+	e.i32(3, int32(len(data)))
+	e.field(5, tcStruct)
+	e.begin()
+	e.i32(1, int32(n))
+	e.i32(2, 0 /*PLAIN*/)
+	e.i32(3, 3 /*RLE*/)
+	e.i32(4, 3 /*RLE*/)
+	e.end()
+	e.b = append(e.b, 0)
+	return append(e.b, data...)
+}
+
+func pqFooter(rgs [][2]pqChunk, rowGroups []int, total int) []byte {
+	e := &tcEnc{last: []int16{0}}
+	e.i32(1, 1) // version
+	e.list(2, tcStruct, 3)
+	e.begin() // root
+	e.str(4, "schema")
+	e.i32(5, 2)
+	e.end()
+	e.begin()
+	e.i32(1, 2 /*INT64*/)
+	e.i32(3, 0 /*REQUIRED*/)
+	e.str(4, "id")
+	e.end()
+	e.begin()
+	e.i32(1, 6 /*BYTE_ARRAY*/)
+	e.i32(3, 1 /*OPTIONAL*/)
+	e.str(4, "name")
+	e.i32(6, 0 /*UTF8*/)
+	e.end()
+	e.i64(3, int64(total))
+	e.list(4, tcStruct, len(rgs))
+	for i, chunks := range rgs {
+		e.begin()
+		e.list(1, tcStruct, len(chunks))
+		var size int64
+		for _, c := range chunks {
+			size += c.size
+			e.begin()
+			e.i64(2, c.off)
+			e.field(3, tcStruct) // ColumnMetaData
+			e.begin()
+			e.i32(1, int32(c.ptype))
+			e.list(2, tcI32, 1) // encodings
+			e.b = binary.AppendUvarint(e.b, 0)
+			e.list(3, tcBinary, 0) // path_in_schema
+			e.i32(4, 0)            // UNCOMPRESSED
+			e.i64(5, c.numValues)
+			e.i64(6, c.size)
+			e.i64(7, c.size)
+			e.i64(9, c.off)
+			e.end()
+			e.end()
+		}
+		e.i64(2, size)
+		e.i64(3, int64(rowGroups[i]))
+		e.end()
+	}
+	e.b = append(e.b, 0)
+	return e.b
+}
+
+func (e *tcEnc) field(id int16, typ byte) {
+	last := &e.last[len(e.last)-1]
+	if d := id - *last; d > 0 && d <= 15 {
+		e.b = append(e.b, byte(d)<<4|typ)
+	} else {
+		e.b = append(e.b, typ)
+		e.b = zigzag(e.b, int64(id))
+	}
+	*last = id
+}
+
+func (e *tcEnc) begin() { e.last = append(e.last, 0) }
+
+func (e *tcEnc) end() {
+	e.b = append(e.b, 0)
+	e.last = e.last[:len(e.last)-1]
+}
+
+func (e *tcEnc) i32(id int16, v int32) { e.field(id, tcI32); e.b = zigzag(e.b, int64(v)) }
+func (e *tcEnc) i64(id int16, v int64) { e.field(id, tcI64); e.b = zigzag(e.b, v) }
+
+func (e *tcEnc) str(id int16, s string) {
+	e.field(id, tcBinary)
+	e.b = binary.AppendUvarint(e.b, uint64(len(s)))
+	e.b = append(e.b, s...)
+}
+
+func (e *tcEnc) list(id int16, etyp byte, n int) {
+	e.field(id, tcList)
+	if n < 15 {
+		e.b = append(e.b, byte(n)<<4|etyp)
+		return
+	}
+	e.b = append(e.b, 0xf0|etyp)
+	e.b = binary.AppendUvarint(e.b, uint64(n))
+}
+
+func zigzag(b []byte, v int64) []byte { return binary.AppendUvarint(b, uint64(v<<1^(v>>63))) }
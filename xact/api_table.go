@@ -109,6 +109,9 @@ var Table = map[string]Descriptor{
 	apc.ActLRU:          {DisplayName: "lru-eviction", Scope: ScopeGB, Startable: true, ICMode: ICUponTerm},
 	apc.ActStoreCleanup: {DisplayName: "cleanup", Scope: ScopeGB, Startable: true, ConflictRebRes: true, ICMode: ICUponTerm},
 
+	// periodic (target-initiated) expiration of objects as per bucket lifecycle rules
+	apc.ActLifecycle: {DisplayName: "lifecycle-expiration", Scope: ScopeB, Startable: false, RefreshCap: true, ConflictRebRes: true, AbortByReb: true},
+
 	apc.ActSummaryBck: {
 		DisplayName: "summary",
 		Scope:       ScopeGB,
@@ -140,6 +143,7 @@ var Table = map[string]Descriptor{
 	apc.ActECPut:     {Scope: ScopeB, Startable: false, RefreshCap: true, Idles: true, ExtendedStats: true},
 	apc.ActECRespond: {Scope: ScopeB, Startable: false, Idles: true},
 	apc.ActPutCopies: {Scope: ScopeB, Startable: false, RefreshCap: true, Idles: true},
+	apc.ActReplicate: {Scope: ScopeB, Startable: false, RefreshCap: true, Idles: true},
 
 	//
 	// on-demand multi-object
@@ -288,6 +292,16 @@ var Table = map[string]Descriptor{
 		AbortByReb:     true,
 		ICMode:         ICUponTerm,
 	},
+	apc.ActSyncBck: {
+		DisplayName:    "sync-bucket",
+		Scope:          ScopeB,
+		Access:         apc.AccessRW, // ditto
+		Startable:      false,        // ditto
+		RefreshCap:     true,
+		ConflictRebRes: true,
+		AbortByReb:     true,
+		ICMode:         ICUponTerm,
+	},
 
 	// in re IC: list-objects clients stream pages directly; 'show job' uses snaps; zero WaitForXactionIC callers
 	apc.ActList: {Scope: ScopeB, Access: apc.AceObjLIST, Startable: false, Metasync: false, Idles: true, QuietBrief: true, ICMode: ICNone},
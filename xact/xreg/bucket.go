@@ -93,10 +93,18 @@ func RenewBckShardSumm(bck *meta.Bck, msg *apc.ShardSummMsg) RenewRes {
 	return RenewBucketXact(apc.ActSummaryShard, bck, Args{Custom: msg, UUID: msg.UUID})
 }
 
+func RenewLifecycle(bck *meta.Bck, uuid string) RenewRes {
+	return RenewBucketXact(apc.ActLifecycle, bck, Args{UUID: uuid})
+}
+
 func RenewPutMirror(lom *core.LOM) RenewRes {
 	return RenewBucketXact(apc.ActPutCopies, lom.Bck(), Args{Custom: lom})
 }
 
+func RenewRepl(bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActReplicate, bck, Args{})
+}
+
 func RenewTCB(uuid, kind string, custom *TCBArgs) RenewRes {
 	return RenewBucketXact(
 		kind,
@@ -106,6 +114,15 @@ func RenewTCB(uuid, kind string, custom *TCBArgs) RenewRes {
 	)
 }
 
+func RenewSyncBck(uuid string, custom *SyncBckArgs) RenewRes {
+	return RenewBucketXact(
+		apc.ActSyncBck,
+		custom.BckTo, // (ditto)
+		Args{Custom: custom, UUID: uuid},
+		custom.BckFrom, custom.BckTo,
+	)
+}
+
 func RenewDsort(id string, custom *DsortArgs) RenewRes {
 	return RenewBucketXact(
 		apc.ActDsort,
@@ -27,6 +27,11 @@ type (
 		Msg       *apc.TCOMsg
 		DisableDM bool
 	}
+	SyncBckArgs struct {
+		BckFrom *meta.Bck
+		BckTo   *meta.Bck
+		Msg     *apc.SyncBckMsg
+	}
 	DsortArgs struct {
 		BckFrom *meta.Bck
 		BckTo   *meta.Bck
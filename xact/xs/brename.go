@@ -6,6 +6,7 @@
 package xs
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -49,6 +50,10 @@ func (*bmvFactory) Kind() string     { return apc.ActMoveBck }
 func (p *bmvFactory) Get() core.Xact { return p.xctn }
 
 func (p *bmvFactory) Start() error {
+	// object lock (WORM): renaming implies deleting the source objects
+	if bckFrom := p.cargs.BckFrom; bckFrom.Props != nil && bckFrom.Props.ObjLock.Enabled {
+		return fmt.Errorf("cannot rename %s: object lock is enabled", bckFrom.Cname(""))
+	}
 	xctn, err := newBckRename(p.UUID(), p.Kind(), p.cargs)
 	if err != nil {
 		return err
@@ -215,7 +215,7 @@ func (r *XactNBI) Run(wg *sync.WaitGroup) {
 				r.Abort(err)
 				return
 			}
-			if err := r.filterKeepMine(lst, ubuf, smap); err != nil {
+			if err := filterKeepMine(lst, ubuf, smap); err != nil {
 				r.Abort(err)
 				return
 			}
@@ -352,8 +352,10 @@ func (r *XactNBI) fini(smap *meta.Smap, ntotal int64) error {
 	return nil
 }
 
-// TODO: ref
-func (*XactNBI) filterKeepMine(lst *cmn.LsoRes, ubuf []byte, smap *meta.Smap) error {
+// keep only (sorted) remote entries that map to this target (HRW);
+// `ubuf` is the bucket's uname prefix (see meta.Bck.MakeUname)
+// (used by x-nbi and x-sync-bck)
+func filterKeepMine(lst *cmn.LsoRes, ubuf []byte, smap *meta.Smap) error {
 	j := 0
 	sid := core.T.SID()
 
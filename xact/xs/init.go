@@ -61,6 +61,8 @@ func Tinit(coi COI) {
 	xreg.RegBckXact(&rechunkFactory{kind: apc.ActRechunk})
 	xreg.RegBckXact(&shardSummFactory{})
 	xreg.RegBckXact(&shardIndexFactory{kind: apc.ActIndexShard})
+	xreg.RegBckXact(&lcyFactory{})
+	xreg.RegBckXact(&replFactory{})
 
 	// assign COI singleton
 	gcoi = coi
@@ -68,4 +70,5 @@ func Tinit(coi COI) {
 	xreg.RegBckXact(&tcbFactory{kind: apc.ActETLBck})
 	xreg.RegBckXact(&tcoFactory{streamingF: streamingF{kind: apc.ActETLObjects}})
 	xreg.RegBckXact(&tcoFactory{streamingF: streamingF{kind: apc.ActCopyObjects}})
+	xreg.RegBckXact(&syncBckFactory{})
 }
@@ -0,0 +1,160 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Lifecycle expiration: visit all (locally stored) objects in a given bucket and
+// delete those that match an enabled lifecycle rule and are older than the rule's
+// `ExpirationDays`. Uses the rules in effect at the time the xaction is started.
+// Started periodically by each target (see ais/tgtlifecycle.go) - ais:// buckets only
+// (see cmn.LifecycleConf.ValidateAsProps). Objects under object lock (WORM) are skipped.
+
+type (
+	lcyFactory struct {
+		xctn *XactLifecycle
+		xreg.RenewBase
+	}
+	XactLifecycle struct {
+		now     time.Time
+		conf    cmn.LifecycleConf
+		expired atomic.Int64
+		locked  atomic.Int64 // skipped: write-protected by object lock
+		xact.BckJogRunner
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactLifecycle)(nil)
+	_ xreg.Renewable = (*lcyFactory)(nil)
+)
+
+////////////////
+// lcyFactory //
+////////////////
+
+func (*lcyFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &lcyFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *lcyFactory) Start() error {
+	r := &XactLifecycle{now: time.Now(), conf: p.Bck.Props.Lifecycle}
+	err := r.BckJogRunner.Init(p.UUID(), p.Kind(), p.Bck, xact.BckJogRunnerOpts{
+		CbObj:      r.visit,
+		RW:         true,
+		NumWorkers: xact.NwpDflt,
+	}, cmn.GCO.Get())
+	if err != nil {
+		return err
+	}
+	p.xctn = r
+	return nil
+}
+
+func (*lcyFactory) Kind() string     { return apc.ActLifecycle }
+func (p *lcyFactory) Get() core.Xact { return p.xctn }
+
+// one at a time
+func (*lcyFactory) WhenPrevIsRunning(xprev xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(xprev.Get().String())
+}
+
+///////////////////
+// XactLifecycle //
+///////////////////
+
+func (r *XactLifecycle) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	r.BckJogRunner.Run()
+	if err := r.BckJogRunner.Wait(); err != nil && !r.IsAborted() {
+		r.AddErr(err)
+	}
+	if n, l := r.expired.Load(), r.locked.Load(); n > 0 || l > 0 || cmn.Rom.V(4, cos.ModXs) {
+		nlog.Infoln(r.Name(), "expired:", n, "locked:", l)
+	}
+	r.Finish()
+}
+
+func (r *XactLifecycle) visit(lom *core.LOM, _ []byte) error {
+	rules := r.conf.Match(lom.ObjName)
+	if len(rules) == 0 {
+		return nil
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		return nil // (deleted or moved in the meantime)
+	}
+	if lom.IsCopy() {
+		return nil
+	}
+	mtime, err := lom.LastModified()
+	if err != nil {
+		return nil
+	}
+	var expired bool
+	for _, rule := range rules {
+		if rule.Expired(mtime, r.now) {
+			expired = true
+			break
+		}
+	}
+	if !expired {
+		return nil
+	}
+	if lom.CheckDelWORM(false /*bypass governance*/) != nil {
+		r.locked.Inc()
+		return nil
+	}
+
+	size := lom.Lsize()
+	ecode, err := core.T.DeleteObject(lom, false /*evict*/)
+	switch {
+	case err == nil:
+		r.expired.Inc()
+		r.ObjsAdd(1, size)
+		if cmn.Rom.V(5, cos.ModXs) {
+			nlog.Infoln(r.Name(), "expired", lom.Cname(), mtime)
+		}
+	case cos.IsNotExist(err, ecode) || cmn.IsErrObjNought(err):
+	case cmn.IsErrObjLocked(err):
+		r.locked.Inc() // (locked in the meantime)
+	default:
+		r.AddErr(err, 4, cos.ModXs)
+	}
+	return nil
+}
+
+func (r *XactLifecycle) CtlMsg() string {
+	var sb cos.SB
+	sb.Init(ctlMsgBufSize)
+	sb.WriteString("rules:")
+	sb.WriteString(strconv.Itoa(len(r.conf.Rules)))
+	if n := r.expired.Load(); n > 0 {
+		sb.WriteString(", expired:")
+		sb.WriteString(strconv.FormatInt(n, 10))
+	}
+	if n := r.locked.Load(); n > 0 {
+		sb.WriteString(", locked:")
+		sb.WriteString(strconv.FormatInt(n, 10))
+	}
+	return sb.String()
+}
+
+func (r *XactLifecycle) Snap() *core.Snap { return r.Base.NewSnap(r) }
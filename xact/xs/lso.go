@@ -27,6 +27,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/load"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/cmn/tabular"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
@@ -906,6 +907,11 @@ func (r *LsoXact) cb(fqn string, de fs.DirEntry) error {
 		return nil
 	}
 
+	// ls tabular: row groups
+	if tabular.HasExt(entry.Name) {
+		return r.lsTabular(entry, fqn)
+	}
+
 	// ls arch
 	// looking only at the file extension - not reading ("detecting") file magic (TODO: add lsmsg flag)
 	archList, err := archive.List(fqn)
@@ -935,6 +941,31 @@ func (r *LsoXact) cb(fqn string, de fs.DirEntry) error {
 	return nil
 }
 
+// (compare with archive.List above)
+func (r *LsoXact) lsTabular(entry *cmn.LsoEnt, fqn string) error {
+	rgs, err := tabular.List(fqn)
+	if err != nil {
+		// skip and keep going (malformed or unsupported file must not fail the entire listing)
+		nlog.Warningln(r.Name(), "skipping", entry.Name, "[", err, "]")
+		return nil
+	}
+	entry.Flags |= apc.EntryIsArchive
+	for _, rg := range rgs {
+		e := &cmn.LsoEnt{
+			Name:   path.Join(entry.Name, rg.Name),
+			Size:   rg.Size,
+			Custom: cmn.CustomProps2S(cmn.LsoNumRows, strconv.FormatInt(rg.NumRows, 10)),
+			Flags:  (entry.Flags &^ apc.EntryIsArchive) | apc.EntryInArch,
+		}
+		select {
+		case r.walk.pageCh <- e:
+		case <-r.walk.stopCh.Listen():
+			return errLsoStopped
+		}
+	}
+	return nil
+}
+
 func (r *LsoXact) Snap() *core.Snap { return r.Base.NewSnap(r) }
 
 //
@@ -0,0 +1,198 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Listing cache (see cmn/lsocache)
+//
+// - raw (unfiltered, no local metadata) backend pages keyed by remote bucket,
+//   listing parameters, and continuation token;
+// - a change notification (see InvalidateLsoCache) drops all cached pages of all
+//   listings that may include the changed object - i.e., listings with a matching prefix -
+//   since (re)moving a single object may shift every subsequent page;
+// - pages expire after the bucket's TTL and get removed by housekeeping;
+// - total number of cached entries is capped (lsoCacheMaxEntries).
+
+const (
+	lsoCacheMaxEntries = 16 * 1024 * 1024
+	lsoCacheHkIval     = time.Minute
+)
+
+type (
+	lcPage struct {
+		prefix  string // listed prefix
+		token   string // next continuation token
+		entries cmn.LsoEntries
+		expires int64 // mono
+		flags   uint32
+	}
+	lcBck struct {
+		pages    map[string]*lcPage // [lcKey => page]
+		provider string
+		name     string
+		mu       sync.Mutex
+	}
+	lsoCache struct {
+		bcks  sync.Map // [remote bucket uname => *lcBck]
+		total atomic.Int64
+		once  sync.Once
+	}
+)
+
+var lcache lsoCache
+
+// listing parameters that determine (raw) backend page
+func lcKey(msg *apc.LsoMsg) string {
+	var sb strings.Builder
+	sb.Grow(len(msg.Prefix) + len(msg.ContinuationToken) + len(msg.Props) + 48)
+	sb.WriteString(msg.Prefix)
+	sb.WriteByte(0)
+	sb.WriteString(msg.ContinuationToken)
+	sb.WriteByte(0)
+	sb.WriteString(msg.Props)
+	sb.WriteByte(0)
+	sb.WriteString(strconv.FormatUint(msg.Flags, 16))
+	sb.WriteByte(0)
+	sb.WriteString(strconv.FormatInt(msg.PageSize, 10))
+	return sb.String()
+}
+
+func lcEnabled(bck *meta.Bck, msg *apc.LsoMsg) bool {
+	return bck.Props != nil && bck.Props.LsoCache.Enabled && len(msg.Header) == 0
+}
+
+// (note: remote bucket, to share pages between ais:// bucket with backend and the backend itself)
+func lcUname(bck *meta.Bck) string {
+	return string(bck.RemoteBck().MakeUname(""))
+}
+
+// returns a copy of the cached page, if any
+func (lc *lsoCache) get(bck *meta.Bck, key string, entries cmn.LsoEntries) *cmn.LsoRes {
+	v, ok := lc.bcks.Load(lcUname(bck))
+	if !ok {
+		return nil
+	}
+	b := v.(*lcBck)
+	b.mu.Lock()
+	page, ok := b.pages[key]
+	if !ok {
+		b.mu.Unlock()
+		return nil
+	}
+	if mono.NanoTime() > page.expires {
+		delete(b.pages, key)
+		lc.total.Sub(int64(len(page.entries)))
+		b.mu.Unlock()
+		return nil
+	}
+	lst := &cmn.LsoRes{ContinuationToken: page.token, Flags: page.flags, Entries: _cloneEntries(entries[:0], page.entries)}
+	b.mu.Unlock()
+	return lst
+}
+
+func (lc *lsoCache) put(bck *meta.Bck, key string, msg *apc.LsoMsg, lst *cmn.LsoRes) {
+	num := int64(len(lst.Entries))
+	if lc.total.Load()+num > lsoCacheMaxEntries {
+		return
+	}
+	lc.once.Do(func() {
+		hk.Reg("lso-cache"+hk.NameSuffix, lc.housekeep, lsoCacheHkIval)
+	})
+	var (
+		rbck  = bck.RemoteBck()
+		uname = lcUname(bck)
+		page  = &lcPage{
+			prefix:  msg.Prefix,
+			token:   lst.ContinuationToken,
+			entries: _cloneEntries(make(cmn.LsoEntries, 0, num), lst.Entries),
+			expires: mono.NanoTime() + bck.Props.LsoCache.MaxAge().Nanoseconds(),
+			flags:   lst.Flags,
+		}
+	)
+	v, ok := lc.bcks.Load(uname)
+	if !ok {
+		v, _ = lc.bcks.LoadOrStore(uname, &lcBck{pages: make(map[string]*lcPage, 16), provider: rbck.Provider, name: rbck.Name})
+	}
+	b := v.(*lcBck)
+	b.mu.Lock()
+	if prev, ok := b.pages[key]; ok {
+		lc.total.Sub(int64(len(prev.entries)))
+	}
+	b.pages[key] = page
+	lc.total.Add(num)
+	b.mu.Unlock()
+}
+
+// drop all cached pages that may include a given object (empty objName: entire bucket)
+func (lc *lsoCache) invalidate(provider, bckName, objName string) (n int) {
+	lc.bcks.Range(func(_, v any) bool {
+		b := v.(*lcBck)
+		if b.provider != provider || b.name != bckName {
+			return true
+		}
+		b.mu.Lock()
+		for key, page := range b.pages {
+			if objName == "" || strings.HasPrefix(objName, page.prefix) {
+				delete(b.pages, key)
+				lc.total.Sub(int64(len(page.entries)))
+				n++
+			}
+		}
+		b.mu.Unlock()
+		return true
+	})
+	return n
+}
+
+func (lc *lsoCache) housekeep(now int64) time.Duration {
+	var n int
+	lc.bcks.Range(func(_, v any) bool {
+		b := v.(*lcBck)
+		b.mu.Lock()
+		for key, page := range b.pages {
+			if now > page.expires {
+				delete(b.pages, key)
+				lc.total.Sub(int64(len(page.entries)))
+				n++
+			}
+		}
+		b.mu.Unlock()
+		return true
+	})
+	if n > 0 && cmn.Rom.V(4, cos.ModXs) {
+		nlog.Infoln("lso-cache: expired", n, "pages, total cached entries:", lc.total.Load())
+	}
+	return lsoCacheHkIval
+}
+
+func _cloneEntries(dst, src cmn.LsoEntries) cmn.LsoEntries {
+	for _, en := range src {
+		e := *en
+		dst = append(dst, &e)
+	}
+	return dst
+}
+
+// remote object created, updated, or deleted - via change notification or by the cluster itself
+// (empty objName: any object)
+func InvalidateLsoCache(provider, bckName, objName string) int {
+	return lcache.invalidate(provider, bckName, objName)
+}
@@ -0,0 +1,71 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestLsoCache(t *testing.T) {
+	var (
+		lc    = &lsoCache{}
+		props = &cmn.Bprops{LsoCache: cmn.LsoCacheConf{Enabled: true}}
+		bck   = meta.NewBck("data", apc.AWS, cmn.NsGlobal, props)
+		// ais:// bucket with the same backend shares cached pages
+		aisBck = meta.NewBck("local", apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+			LsoCache:   cmn.LsoCacheConf{Enabled: true},
+			BackendBck: cmn.Bck{Name: "data", Provider: apc.AWS},
+		})
+		msgA  = &apc.LsoMsg{Prefix: "a/", PageSize: 2}
+		msgB  = &apc.LsoMsg{Prefix: "b/", PageSize: 2}
+		pageA = &cmn.LsoRes{ContinuationToken: "a/2", Entries: cmn.LsoEntries{{Name: "a/1", Size: 1}, {Name: "a/2", Size: 2}}}
+		pageB = &cmn.LsoRes{Entries: cmn.LsoEntries{{Name: "b/1", Size: 1}}}
+	)
+	lc.once.Do(func() {}) // no housekeeping
+
+	tassert.Fatalf(t, lcEnabled(bck, msgA), "expecting listing cache enabled")
+	tassert.Fatalf(t, !lcEnabled(bck, &apc.LsoMsg{Header: map[string][]string{"x-amz-request-payer": {"requester"}}}),
+		"not expecting listing cache with custom headers")
+
+	keyA, keyB := lcKey(msgA), lcKey(msgB)
+	lc.put(bck, keyA, msgA, pageA)
+	lc.put(bck, keyB, msgB, pageB)
+	tassert.Errorf(t, lc.total.Load() == 3, "expecting 3 cached entries, got %d", lc.total.Load())
+
+	// hit: a copy
+	lst := lc.get(aisBck, keyA, nil)
+	tassert.Fatalf(t, lst != nil && len(lst.Entries) == 2 && lst.ContinuationToken == "a/2", "expecting cached page, got %+v", lst)
+	lst.Entries[0].Name = "modified"
+	lst = lc.get(bck, keyA, nil)
+	tassert.Errorf(t, lst.Entries[0].Name == "a/1", "cached page must not change, got %q", lst.Entries[0].Name)
+
+	// miss: different continuation token
+	msgA2 := *msgA
+	msgA2.ContinuationToken = "a/2"
+	tassert.Errorf(t, lc.get(bck, lcKey(&msgA2), nil) == nil, "not expecting cached page")
+
+	// invalidate: other bucket, other prefix, matching prefix
+	tassert.Errorf(t, lc.invalidate(apc.GCP, "data", "a/3") == 0, "wrong provider")
+	tassert.Errorf(t, lc.invalidate(apc.AWS, "data", "c/3") == 0, "wrong prefix")
+	tassert.Errorf(t, lc.invalidate(apc.AWS, "data", "a/3") == 1, "expecting one page invalidated")
+	tassert.Errorf(t, lc.get(bck, keyA, nil) == nil && lc.get(bck, keyB, nil) != nil, "expecting only a/ invalidated")
+
+	// entire bucket
+	lc.put(bck, keyA, msgA, pageA)
+	tassert.Errorf(t, lc.invalidate(apc.AWS, "data", "") == 2, "expecting all pages invalidated")
+	tassert.Errorf(t, lc.total.Load() == 0, "expecting no cached entries, got %d", lc.total.Load())
+
+	// expiration
+	lc.put(bck, keyA, msgA, pageA)
+	lc.housekeep(mono.NanoTime() + props.LsoCache.MaxAge().Nanoseconds() + 1)
+	tassert.Errorf(t, lc.get(bck, keyA, nil) == nil && lc.total.Load() == 0, "expecting expired page removed")
+}
@@ -823,6 +823,9 @@ func (r *XactMoss) _sendreg(tsi *meta.Snode, lom *core.LOM, wid, nameInArch stri
 
 	err := lom.Load(false /*cache it*/, true)
 	if err == nil {
+		if err = lom.CheckEncrypted(); err != nil {
+			return false, err
+		}
 		if lh, err = lom.Open(); err != nil {
 			return false, fmt.Errorf("%s: failed to open already loaded %s under r-lock: %w", r.Name(), lom.Cname(), err)
 		}
@@ -1795,6 +1798,9 @@ func (wi *basewi) _write(lom *core.LOM, in *apc.MossIn, out *apc.MossOut, nameIn
 		}
 		return nil, nil, 0, err
 	}
+	if err := lom.CheckEncrypted(); err != nil {
+		return nil, nil, 0, err
+	}
 
 	lh, err := lom.Open()
 	if err != nil {
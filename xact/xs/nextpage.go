@@ -102,11 +102,25 @@ func (npg *npgCtx) cb(fqn string, de fs.DirEntry) error {
 // returns next page from the remote bucket's "list-objects" result set
 func (npg *npgCtx) nextPageR(entries cmn.LsoEntries) (*cmn.LsoRes, error) {
 	debug.Assert(!npg.wi.msg.IsFlagSet(apc.LsCached))
-	lst := &cmn.LsoRes{Entries: entries}
+	var (
+		key    string
+		cached = lcEnabled(npg.bck, npg.wi.msg)
+	)
+	if cached {
+		key = lcKey(npg.wi.msg)
+		if lst := lcache.get(npg.bck, key, entries); lst != nil {
+			lst.UUID = npg.wi.msg.UUID
+			return lst, nil
+		}
+	}
 
+	lst := &cmn.LsoRes{Entries: entries}
 	if _, err := npg.bp.ListObjects(npg.bck, npg.wi.msg, lst); err != nil {
 		return nil, err
 	}
+	if cached {
+		lcache.put(npg.bck, key, npg.wi.msg, lst)
+	}
 	debug.Assert(lst.UUID == "" || lst.UUID == npg.wi.msg.UUID)
 	lst.UUID = npg.wi.msg.UUID
 	return lst, nil
@@ -101,8 +101,8 @@ func (p *prfFactory) Start() (err error) {
 	if err := b.Init(core.T.Bowner()); err != nil {
 		return err
 	}
-	if !b.IsCloud() && !b.IsRemoteAIS() {
-		return fmt.Errorf("can only prefetch Cloud and remote AIS buckets (have %s)", b.Cname(""))
+	if !b.IsCloud() && !b.IsRemoteAIS() && !b.IsHT() {
+		return fmt.Errorf("can only prefetch Cloud, remote AIS, and HTTP buckets (have %s)", b.Cname(""))
 	}
 	p.xctn, err = newPrefetch(&p.Args, p.Kind(), b, p.msg)
 	return err
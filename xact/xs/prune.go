@@ -38,10 +38,11 @@ type prune struct {
 	lsflags uint64
 	pruned  atomic.Int64
 	same    bool
+	dryRun  bool // count but do not remove (see x-sync-bck)
 }
 
 func (rp *prune) init(config *cmn.Config) {
-	debug.Assert(rp.bckFrom.IsAIS() || rp.bckFrom.HasVersioningMD(), rp.bckFrom.String())
+	debug.Assert(rp.bckFrom.IsAIS() || rp.bckFrom.IsRemote(), rp.bckFrom.String())
 	rmopts := &mpather.JgroupOpts{
 		Parent:   rp.r,
 		CTs:      []string{fs.ObjCT},
@@ -88,6 +89,38 @@ func (rp *prune) do(dst *core.LOM, _ []byte) error {
 		_, local, err := dst.HrwTarget(rp.smap)
 		debug.Assertf(local, "local %t, err: %v", local, err)
 	})
+	gone, err := rp.srcGone(dst)
+	if !gone || err != nil {
+		return err
+	}
+
+	// source does not exist: try to remove the destination (NOTE best effort)
+	if rp.dryRun {
+		rp.pruned.Inc()
+		return nil
+	}
+	if !dst.TryLock(true) {
+		return nil
+	}
+	err = dst.Load(false, true)
+	if err == nil {
+		err = dst.RemoveObj()
+	}
+	dst.Unlock(true)
+
+	if err == nil {
+		rp.pruned.Inc()
+		if cmn.Rom.V(5, cos.ModXs) {
+			nlog.Infoln(rp.r.Name(), dst.Cname())
+		}
+	} else if !cmn.IsErrObjNought(err) && !cmn.IsErrBucketNought(err) {
+		rp.r.AddErr(err, 4, cos.ModXs)
+	}
+	return nil
+}
+
+// whether the source counterpart of a given destination object does not exist
+func (rp *prune) srcGone(dst *core.LOM) (bool, error) {
 	// construct src lom
 	var src *core.LOM
 	if rp.same {
@@ -96,7 +129,7 @@ func (rp *prune) do(dst *core.LOM, _ []byte) error {
 		src = core.AllocLOM(dst.ObjName)
 		defer core.FreeLOM(src)
 		if src.InitBck(rp.bckFrom) != nil {
-			return nil
+			return false, nil
 		}
 	}
 
@@ -105,7 +138,7 @@ func (rp *prune) do(dst *core.LOM, _ []byte) error {
 	bname := cos.UnsafeBptr(uname)
 	if rp.filter != nil && rp.filter.Lookup(*bname) { // TODO -- FIXME: rm filter nil check once x-tco supports prob. filtering
 		rp.filter.Delete(*bname)
-		return nil
+		return false, nil
 	}
 
 	// check whether src lom exists
@@ -116,7 +149,7 @@ func (rp *prune) do(dst *core.LOM, _ []byte) error {
 	if src.Bck().IsAIS() {
 		tsi, errV := rp.smap.HrwHash2T(src.Digest())
 		if errV != nil {
-			return fmt.Errorf("prune %s: fatal err: %w", rp.r.Name(), errV)
+			return false, fmt.Errorf("prune %s: fatal err: %w", rp.r.Name(), errV)
 		}
 		if tsi.ID() == core.T.SID() {
 			err = src.Load(false, false)
@@ -133,26 +166,7 @@ func (rp *prune) do(dst *core.LOM, _ []byte) error {
 	}
 
 	if (err == nil && ecode == 0) || !cos.IsNotExist(err, ecode) /*not complaining*/ {
-		return nil
-	}
-
-	// source does not exist: try to remove the destination (NOTE best effort)
-	if !dst.TryLock(true) {
-		return nil
-	}
-	err = dst.Load(false, true)
-	if err == nil {
-		err = dst.RemoveObj()
-	}
-	dst.Unlock(true)
-
-	if err == nil {
-		rp.pruned.Inc()
-		if cmn.Rom.V(5, cos.ModXs) {
-			nlog.Infoln(rp.r.Name(), dst.Cname())
-		}
-	} else if !cmn.IsErrObjNought(err) && !cmn.IsErrBucketNought(err) {
-		rp.r.AddErr(err, 4, cos.ModXs)
+		return false, nil
 	}
-	return nil
+	return true, nil
 }
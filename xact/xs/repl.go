@@ -0,0 +1,796 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Bucket replication (see cmn.ReplConf): asynchronously push local PUTs and DELETEs
+// to the destination bucket in an attached remote AIS cluster.
+//
+// - on-demand, one per (source) bucket per target; each target replicates its own objects
+// - pending work is coalesced by object name (the most recent PUT or DELETE wins)
+// - durability: every PUT and DELETE gets appended to a per-bucket journal (on the
+//   bucket's HRW mountpath) that's periodically fsync-ed; truncated when there's
+//   nothing pending, compacted when grown too big, and replayed upon restart
+//   (ais/tgtrepl.go resumes buckets with non-empty journals)
+// - retries upon 5xx, 409, 429, and network errors, with exponential backoff
+// - conflicts: HEAD(destination) prior to each PUT or DELETE (see `replNewer` below)
+// - replicated PUTs and DELETEs carry apc.HdrReplSrc - the receiving side does not
+//   replicate them back
+
+const (
+	replTick       = time.Second
+	replMinBackoff = time.Second
+	replMaxBackoff = 5 * time.Minute
+
+	replCompactSize = 32 * cos.MiB
+	replMaxLine     = 64 * cos.KiB
+)
+
+const (
+	replOpPut = "p"
+	replOpDel = "d"
+)
+
+type (
+	replFactory struct {
+		xctn *XactRepl
+		xreg.RenewBase
+	}
+	// pending PUT or DELETE (and journal record)
+	replRec struct {
+		Op   string `json:"o"`
+		Name string `json:"n"`
+		Ts   int64  `json:"t"`           // time of the original write or delete (unix ns)
+		Ver  int64  `json:"v,omitempty"` // (delete) replicated version - see cmn.ReplVerObjMD
+		// runtime
+		next     int64 // mono time of the next attempt (when retrying)
+		tries    int
+		queued   bool
+		inflight bool
+		again    bool // updated while in-flight
+	}
+	replJrnl struct {
+		fh      *os.File
+		fqn     string
+		size    int64
+		compact int64 // size after the last compaction
+		dirty   bool  // not fsync-ed
+	}
+	replMetrics struct {
+		put   bdMetric
+		size  bdMetric
+		del   bdMetric
+		skip  bdMetric
+		retry bdMetric
+		lag   bdMetric
+		err   bdMetric
+	}
+	XactRepl struct {
+		ctx     context.Context
+		cancel  context.CancelFunc
+		dst     *meta.Bck
+		pending map[string]*replRec
+		wakeCh  chan struct{}
+		srcUUID string
+		ready   []*replRec
+		retry   []*replRec
+		jrnl    replJrnl
+		conf    cmn.ReplConf
+		m       replMetrics
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		xact.DemandBase
+		stopping bool
+		drop     bool // replication disabled or bucket destroyed: remove the journal
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactRepl)(nil)
+	_ xreg.Renewable = (*replFactory)(nil)
+)
+
+/////////////////
+// replFactory //
+/////////////////
+
+func (*replFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &replFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *replFactory) Start() error {
+	bck := p.Bck
+	conf := bck.Props.Replication
+	if !conf.Enabled {
+		return fmt.Errorf("%s: replication disabled, nothing to do", bck.String())
+	}
+	dst := meta.CloneBck(&conf.Dst)
+	if err := dst.Init(core.T.Bowner()); err != nil {
+		return fmt.Errorf("%s: replication destination %s: %w", bck.String(), conf.Dst.String(), err)
+	}
+	r := &XactRepl{
+		dst:     dst,
+		conf:    conf,
+		pending: make(map[string]*replRec, 64),
+		wakeCh:  make(chan struct{}, conf.NumWorkers()),
+		srcUUID: core.T.Sowner().Get().UUID,
+	}
+	if err := r.jrnl.open(bck, r.pending); err != nil {
+		return err
+	}
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	r.DemandBase.Init(cos.GenUUID(), p.Kind(), bck, xact.IdleDefault)
+
+	vlabs := map[string]string{stats.VlabBucket: bck.Cname(""), stats.VlabXkind: p.Kind()}
+	r.m = replMetrics{
+		put:   bdMetric{stats.ReplCount, vlabs},
+		size:  bdMetric{stats.ReplSize, vlabs},
+		del:   bdMetric{stats.ReplDelCount, vlabs},
+		skip:  bdMetric{stats.ReplSkipCount, vlabs},
+		retry: bdMetric{stats.ReplRetryCount, vlabs},
+		lag:   bdMetric{stats.ReplLagTotal, vlabs},
+		err:   bdMetric{stats.ErrReplCount, vlabs},
+	}
+
+	// replayed
+	for _, rec := range r.pending {
+		r.IncPending()
+		r.enqueue(rec)
+	}
+	p.xctn = r
+
+	go r.Run(nil)
+	return nil
+}
+
+func (*replFactory) Kind() string     { return apc.ActReplicate }
+func (p *replFactory) Get() core.Xact { return p.xctn }
+
+func (p *replFactory) WhenPrevIsRunning(xprev xreg.Renewable) (xreg.WPR, error) {
+	debug.Assertf(false, "%s vs %s", p.Str(p.Kind()), xprev) // xreg.usePrev() must've returned true
+	return xreg.WprUse, nil
+}
+
+//////////////
+// XactRepl //
+//////////////
+
+func (r *XactRepl) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name(), "=>", r.dst.Cname(""), "pending:", r.Pending())
+	for range r.conf.NumWorkers() {
+		r.wg.Add(1)
+		go r.work()
+	}
+	ticker := time.NewTicker(replTick)
+loop:
+	for {
+		select {
+		case <-ticker.C:
+			if !r.tick() {
+				break loop
+			}
+		case <-r.IdleTimer():
+			break loop
+		case <-r.ChanAbort():
+			break loop
+		}
+	}
+	ticker.Stop()
+
+	r.DemandBase.Stop()
+	r.cancel()
+	r.wg.Wait()
+
+	r.mu.Lock()
+	r.stopping = true
+	n := len(r.pending)
+	if err := r.jrnl.close(n == 0 || r.drop); err != nil {
+		r.AddErr(err)
+	}
+	r.mu.Unlock()
+	if n > 0 {
+		r.SubPending(n)
+		if r.drop {
+			nlog.Warningln(r.Name(), "dropping", n, "pending")
+		} else {
+			nlog.Infoln(r.Name(), "stopping with", n, "pending (journaled)")
+		}
+	}
+	r.Finish()
+}
+
+// main method: PUT or DELETE (by the caller) at a given time
+// (ver: replicated version of the delete)
+func (r *XactRepl) Repl(objName string, del bool, ts, ver int64) {
+	op := replOpPut
+	if del {
+		op = replOpDel
+	}
+	r.mu.Lock()
+	if r.stopping {
+		r.mu.Unlock()
+		// not to lose it (will be resumed - see ais/tgtrepl)
+		if err := replAppend(r.Bck(), &replRec{Op: op, Name: objName, Ts: ts, Ver: ver}); err != nil {
+			nlog.Errorln(r.Name(), err)
+		}
+		return
+	}
+	rec, ok := r.pending[objName]
+	switch {
+	case !ok:
+		rec = &replRec{Op: op, Name: objName, Ts: ts, Ver: ver}
+		r.pending[objName] = rec
+		r.IncPending() // decremented when done (or dropped)
+		r.enqueue(rec)
+	case rec.inflight:
+		rec.Op, rec.Ts, rec.Ver = op, ts, ver
+		rec.again = true
+	default:
+		rec.Op, rec.Ts, rec.Ver = op, ts, ver
+		if !rec.queued {
+			rec.tries, rec.next = 0, 0
+			r.enqueue(rec)
+		}
+	}
+	err := r.jrnl.append(rec)
+	r.mu.Unlock()
+	if err != nil {
+		r.AddErr(err, 4, cos.ModXs)
+	}
+}
+
+// under lock
+func (r *XactRepl) enqueue(rec *replRec) {
+	rec.queued = true
+	r.ready = append(r.ready, rec)
+	select {
+	case r.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+// - reload bucket props: stop when replication disabled or its destination changed
+// - schedule retries
+// - fsync, truncate, and compact the journal
+func (r *XactRepl) tick() bool {
+	props, ok := core.T.Bowner().Get().Get(r.Bck())
+	if !ok || !props.Replication.Enabled {
+		r.drop = true
+		return false
+	}
+	if !props.Replication.Dst.Equal(&r.conf.Dst) || props.Replication.NumWorkers() != r.conf.NumWorkers() {
+		return false // (to resume with the new config)
+	}
+
+	r.mu.Lock()
+	r.conf.Conflict = props.Replication.Conflict
+
+	now := mono.NanoTime()
+	l := 0
+	for _, rec := range r.retry {
+		switch {
+		case rec.next == 0 || rec.queued || rec.inflight: // rescheduled in the meantime
+		case rec.next <= now:
+			rec.next = 0
+			r.enqueue(rec)
+		default:
+			r.retry[l] = rec
+			l++
+		}
+	}
+	clear(r.retry[l:])
+	r.retry = r.retry[:l]
+
+	err := r.jrnl.sync()
+	if err == nil {
+		switch {
+		case len(r.pending) == 0 && r.jrnl.size > 0:
+			err = r.jrnl.truncate()
+		case r.jrnl.size > max(replCompactSize, r.jrnl.compact<<1):
+			err = r.jrnl.rewrite(r.pending)
+		}
+	}
+	r.mu.Unlock()
+
+	if err != nil {
+		r.AddErr(err, 4, cos.ModXs)
+	}
+	return true
+}
+
+func (r *XactRepl) work() {
+	defer r.wg.Done()
+	for {
+		r.mu.Lock()
+		var rec *replRec
+		if len(r.ready) > 0 {
+			rec = r.ready[0]
+			r.ready[0] = nil
+			r.ready = r.ready[1:]
+		}
+		if rec == nil {
+			r.mu.Unlock()
+			select {
+			case <-r.wakeCh:
+				continue
+			case <-r.ctx.Done():
+				return
+			}
+		}
+		rec.queued, rec.inflight = false, true
+		op, ts, ver, conflict := rec.Op, rec.Ts, rec.Ver, r.conf.Conflict
+		r.mu.Unlock()
+
+		retry := r.do(rec.Name, op, ts, ver, conflict)
+
+		r.mu.Lock()
+		rec.inflight = false
+		switch {
+		case rec.again:
+			rec.again, rec.tries = false, 0
+			r.enqueue(rec)
+		case retry:
+			backoff := min(replMinBackoff<<min(rec.tries, 16), replMaxBackoff)
+			rec.tries++
+			rec.next = mono.NanoTime() + int64(backoff)
+			r.retry = append(r.retry, rec)
+		default:
+			delete(r.pending, rec.Name)
+			r.DecPending()
+		}
+		r.mu.Unlock()
+	}
+}
+
+// returns true to retry
+func (r *XactRepl) do(objName, op string, ts, ver int64, conflict string) bool {
+	var (
+		tstats = core.T.StatsUpdater()
+		lom    = core.AllocLOM(objName)
+		skip   bool
+		ecode  int
+		err    error
+	)
+	if err = lom.InitBck(r.Bck()); err == nil {
+		if op == replOpDel {
+			skip, ecode, err = r.del(lom, ts, ver, conflict)
+		} else {
+			skip, ecode, err = r.put(lom, conflict)
+		}
+	}
+	core.FreeLOM(lom)
+
+	switch {
+	case err == nil:
+		if skip {
+			r.m.skip.inc(tstats)
+		}
+	case r.ctx.Err() != nil:
+		return true // stopping
+	case replRetriable(ecode, err):
+		r.m.retry.inc(tstats)
+		if cmn.Rom.V(4, cos.ModXs) {
+			nlog.Warningln(r.Name(), "retrying", objName, "[", err, ecode, "]")
+		}
+		return true
+	default:
+		r.m.err.inc(tstats)
+		r.AddErr(err, 4, cos.ModXs)
+	}
+	return false
+}
+
+func (r *XactRepl) put(lom *core.LOM, conflict string) (skip bool, ecode int, err error) {
+	lom.Lock(false)
+	if err = lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(false)
+		if cos.IsNotExist(err) {
+			return true, 0, nil // deleted in the meantime (and the deletion is pending)
+		}
+		return false, 0, err
+	}
+
+	dlom := core.AllocLOM(lom.ObjName)
+	defer core.FreeLOM(dlom)
+	if err = dlom.InitBck(r.dst); err != nil {
+		lom.Unlock(false)
+		return false, 0, err
+	}
+	bp := core.T.Backend(r.dst)
+	oa, ecode, err := bp.HeadObj(r.ctx, dlom, nil)
+	switch {
+	case err == nil:
+		if !replNewer(lom, oa, conflict) {
+			lom.Unlock(false)
+			return true, 0, nil
+		}
+	case cos.IsNotExist(err, ecode):
+	default:
+		lom.Unlock(false)
+		return false, ecode, err
+	}
+
+	lh, err := lom.NewHandle(true /*loaded*/)
+	if err != nil {
+		lom.Unlock(false)
+		return false, 0, err
+	}
+	dlom.CopyAttrs(lom.ObjAttrs(), false /*skip cksum*/)
+
+	hdr := r.header()
+	for k, v := range lom.GetCustomMD() {
+		hdr.Add(apc.HdrObjCustomMD, k+"="+v)
+	}
+	var (
+		size  = lom.Lsize()
+		ts, _ = replMD(lom)
+	)
+	ecode, err = bp.PutObj(context.WithValue(r.ctx, cos.CtxRemAisHeader, hdr), lh, dlom, nil)
+	lom.Unlock(false)
+	if err != nil {
+		return false, ecode, err
+	}
+
+	tstats := core.T.StatsUpdater()
+	r.m.put.inc(tstats)
+	r.m.size.add(tstats, size)
+	if ts > 0 {
+		r.m.lag.add(tstats, max(time.Now().UnixNano()-ts, 0))
+	}
+	r.ObjsAdd(1, size)
+	return false, 0, nil
+}
+
+func (r *XactRepl) del(lom *core.LOM, ts, ver int64, conflict string) (skip bool, ecode int, err error) {
+	if lom.Load(false, false) == nil {
+		return true, 0, nil // (re)created in the meantime
+	}
+	dlom := core.AllocLOM(lom.ObjName)
+	defer core.FreeLOM(dlom)
+	if err = dlom.InitBck(r.dst); err != nil {
+		return false, 0, err
+	}
+	bp := core.T.Backend(r.dst)
+	oa, ecode, err := bp.HeadObj(r.ctx, dlom, nil)
+	if err != nil {
+		if cos.IsNotExist(err, ecode) {
+			return true, 0, nil
+		}
+		return false, ecode, err
+	}
+	if !replDelNewer(ts, ver, oa, conflict) {
+		return true, 0, nil // e.g., written after the deletion
+	}
+	ecode, err = bp.DeleteObj(context.WithValue(r.ctx, cos.CtxRemAisHeader, r.header()), dlom)
+	if err != nil && !cos.IsNotExist(err, ecode) {
+		return false, ecode, err
+	}
+	r.m.del.inc(core.T.StatsUpdater())
+	r.ObjsAdd(1, 0)
+	return false, 0, nil
+}
+
+func (r *XactRepl) header() http.Header {
+	hdr := make(http.Header, 2)
+	hdr.Set(apc.HdrReplSrc, r.srcUUID)
+	return hdr
+}
+
+// whether the local object (`src`) must overwrite the destination's (`dst`)
+func replNewer(src, dst cos.OAH, conflict string) bool {
+	var (
+		sts, ssrc = replMD(src)
+		dts, dsrc = replMD(dst)
+	)
+	if sts == dts && ssrc == dsrc {
+		return false // same write (e.g., replicated from the destination)
+	}
+	if conflict == cmn.ReplConflictVersion {
+		sv, okS := ReplVer(src)
+		dv, okD := ReplVer(dst)
+		if okS && okD && sv != dv {
+			return sv > dv
+		}
+	}
+	if sts != dts {
+		return sts > dts
+	}
+	return ssrc > dsrc // (deterministic)
+}
+
+// whether the local delete (at `ts`, replicated version `ver`) must remove the destination's object
+func replDelNewer(ts, ver int64, dst cos.OAH, conflict string) bool {
+	if conflict == cmn.ReplConflictVersion && ver > 0 {
+		if dv, ok := ReplVer(dst); ok && dv != ver {
+			return ver > dv
+		}
+	}
+	dts, _ := replMD(dst)
+	return dts <= ts
+}
+
+func (r *XactRepl) CtlMsg() string {
+	var (
+		sb     cos.SB
+		oldest int64
+		now    = time.Now().UnixNano()
+	)
+	r.mu.Lock()
+	n := len(r.pending)
+	for _, rec := range r.pending {
+		oldest = max(oldest, now-rec.Ts)
+	}
+	r.mu.Unlock()
+
+	sb.Init(ctlMsgBufSize)
+	sb.WriteString("dst:")
+	sb.WriteString(r.dst.Cname(""))
+	sb.WriteString(", pending:")
+	sb.WriteString(strconv.Itoa(n))
+	if n > 0 {
+		sb.WriteString(", lag:")
+		sb.WriteString(time.Duration(oldest).Round(time.Millisecond).String())
+	}
+	return sb.String()
+}
+
+func (r *XactRepl) Snap() *core.Snap { return r.Base.NewSnap(r) }
+
+// time and source cluster of the original write
+func replMD(oah cos.OAH) (ts int64, src string) {
+	if v, ok := oah.GetCustomKey(cmn.ReplMtimeObjMD); ok {
+		ts, _ = strconv.ParseInt(v, 10, 64)
+	}
+	src, _ = oah.GetCustomKey(cmn.ReplSrcObjMD)
+	return ts, src
+}
+
+// replicated version (see cmn.ReplVerObjMD)
+func ReplVer(oah cos.OAH) (int64, bool) {
+	v, ok := oah.GetCustomKey(cmn.ReplVerObjMD)
+	if !ok {
+		return 0, false
+	}
+	ver, err := strconv.ParseInt(v, 10, 64)
+	return ver, err == nil
+}
+
+func replRetriable(ecode int, err error) bool {
+	switch {
+	case ecode >= http.StatusInternalServerError, ecode == http.StatusTooManyRequests, ecode == http.StatusConflict:
+		return true
+	case ecode == 0:
+		return !cos.IsNotExist(err) // e.g., connection refused
+	default:
+		return cmn.IsErrTooManyRequests(err)
+	}
+}
+
+//
+// journal
+//
+
+func replFQN(mi *fs.Mountpath, bck *meta.Bck) string {
+	return filepath.Join(mi.Path, fname.ReplDir, strconv.FormatUint(bck.HashUname(apc.ActReplicate), 16))
+}
+
+// ReplPending returns true if a given bucket has pending (journaled) replication
+func ReplPending(bck *meta.Bck) bool {
+	avail := fs.GetAvail()
+	for _, mi := range avail {
+		if finfo, err := os.Stat(replFQN(mi, bck)); err == nil && finfo.Size() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// append a single record when the xaction is not running
+func replAppend(bck *meta.Bck, rec *replRec) error {
+	mi, _, err := fs.Hrw(bck.MakeUname(""))
+	if err != nil {
+		return err
+	}
+	fqn := replFQN(mi, bck)
+	if err := cos.CreateDir(filepath.Dir(fqn)); err != nil {
+		return err
+	}
+	fh, err := os.OpenFile(fqn, os.O_CREATE|os.O_WRONLY|os.O_APPEND, cos.PermRWR)
+	if err != nil {
+		return err
+	}
+	b, err := jsoniter.Marshal(rec)
+	if err == nil {
+		_, err = fh.Write(append(b, '\n'))
+	}
+	if err == nil {
+		err = fh.Sync()
+	}
+	cos.Close(fh)
+	return err
+}
+
+// replay all (current and previous HRW) journals and rewrite into one
+func (j *replJrnl) open(bck *meta.Bck, pending map[string]*replRec) error {
+	mi, _, err := fs.Hrw(bck.MakeUname(""))
+	if err != nil {
+		return err
+	}
+	j.fqn = replFQN(mi, bck)
+	var others []string
+	for _, mi := range fs.GetAvail() {
+		fqn := replFQN(mi, bck)
+		if err := replay(fqn, pending); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		if fqn != j.fqn {
+			others = append(others, fqn)
+		}
+	}
+	if err := cos.CreateDir(filepath.Dir(j.fqn)); err != nil {
+		return err
+	}
+	if err := j.rewrite(pending); err != nil {
+		return err
+	}
+	for _, fqn := range others {
+		if err := cos.RemoveFile(fqn); err != nil {
+			nlog.Warningln("failed to remove replication journal", fqn, err)
+		}
+	}
+	return nil
+}
+
+// within a journal, the last record wins; across journals - the most recent one
+func replay(fqn string, pending map[string]*replRec) error {
+	fh, err := os.Open(fqn)
+	if err != nil {
+		return err
+	}
+	recs := make(map[string]*replRec, 64)
+	scanner := bufio.NewScanner(fh)
+	scanner.Buffer(make([]byte, 0, cos.KiB), replMaxLine)
+	for scanner.Scan() {
+		rec := &replRec{}
+		if jsoniter.Unmarshal(scanner.Bytes(), rec) != nil || rec.Name == "" {
+			continue // e.g., partially written upon crash
+		}
+		recs[rec.Name] = rec
+	}
+	err = scanner.Err()
+	cos.Close(fh)
+	if err != nil {
+		return fmt.Errorf("replication journal %q: %w", fqn, err)
+	}
+	for name, rec := range recs {
+		if prev, ok := pending[name]; !ok || prev.Ts < rec.Ts {
+			pending[name] = rec
+		}
+	}
+	return nil
+}
+
+// write all pending records into a new journal that then replaces the current one
+func (j *replJrnl) rewrite(pending map[string]*replRec) error {
+	tmp := j.fqn + ".tmp"
+	fh, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, cos.PermRWR)
+	if err != nil {
+		return err
+	}
+	var (
+		size int64
+		bw   = bufio.NewWriterSize(fh, 64*cos.KiB)
+	)
+	for _, rec := range pending {
+		b, errM := jsoniter.Marshal(rec)
+		if errM != nil {
+			err = errM
+			break
+		}
+		bw.Write(b)
+		bw.WriteByte('\n')
+		size += int64(len(b)) + 1
+	}
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err == nil {
+		err = fh.Sync()
+	}
+	cos.Close(fh)
+	if err == nil {
+		err = os.Rename(tmp, j.fqn)
+	}
+	if err != nil {
+		cos.RemoveFile(tmp)
+		return err
+	}
+
+	if j.fh != nil {
+		cos.Close(j.fh)
+	}
+	if j.fh, err = os.OpenFile(j.fqn, os.O_WRONLY|os.O_APPEND, cos.PermRWR); err != nil {
+		return err
+	}
+	j.size, j.compact, j.dirty = size, size, false
+	return nil
+}
+
+func (j *replJrnl) append(rec *replRec) error {
+	if j.fh == nil {
+		return errors.New("replication journal " + j.fqn + " is closed")
+	}
+	b, err := jsoniter.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	n, err := j.fh.Write(append(b, '\n'))
+	j.size += int64(n)
+	j.dirty = true
+	return err
+}
+
+func (j *replJrnl) sync() error {
+	if !j.dirty || j.fh == nil {
+		return nil
+	}
+	j.dirty = false
+	return j.fh.Sync()
+}
+
+func (j *replJrnl) truncate() error {
+	j.size, j.compact = 0, 0
+	return j.fh.Truncate(0) // (O_APPEND)
+}
+
+func (j *replJrnl) close(remove bool) (err error) {
+	if j.fh == nil {
+		return nil
+	}
+	if !remove {
+		err = j.sync()
+	}
+	cos.Close(j.fh)
+	j.fh = nil
+	if remove {
+		if errR := cos.RemoveFile(j.fqn); errR != nil && err == nil {
+			err = errR
+		}
+	}
+	return err
+}
@@ -0,0 +1,109 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestReplJournal(t *testing.T) {
+	var (
+		dir = t.TempDir()
+		j   = &replJrnl{fqn: filepath.Join(dir, "jrnl")}
+	)
+	tassert.CheckFatal(t, j.rewrite(map[string]*replRec{"a": {Op: replOpPut, Name: "a", Ts: 1}}))
+	for _, rec := range []*replRec{
+		{Op: replOpPut, Name: "b", Ts: 2},
+		{Op: replOpDel, Name: "a", Ts: 3},
+		{Op: replOpPut, Name: "c", Ts: 4},
+		{Op: replOpDel, Name: "c", Ts: 5},
+	} {
+		tassert.CheckFatal(t, j.append(rec))
+	}
+	tassert.CheckFatal(t, j.close(false))
+
+	// partially written (last) record
+	fh, err := os.OpenFile(j.fqn, os.O_WRONLY|os.O_APPEND, 0o640)
+	tassert.CheckFatal(t, err)
+	_, err = fh.WriteString(`{"o":"p","n":"d","t`)
+	tassert.CheckFatal(t, err)
+	fh.Close()
+
+	// another (e.g., previous HRW) journal: the most recent record wins
+	other := filepath.Join(dir, "other")
+	tassert.CheckFatal(t, os.WriteFile(other, []byte(`{"o":"p","n":"a","t":10}`+"\n"+`{"o":"p","n":"b","t":1}`+"\n"), 0o640))
+
+	pending := make(map[string]*replRec)
+	tassert.CheckFatal(t, replay(j.fqn, pending))
+	tassert.CheckFatal(t, replay(other, pending))
+	tassert.Fatalf(t, len(pending) == 3, "expecting 3 pending, got %d", len(pending))
+	tassert.Errorf(t, pending["a"].Op == replOpPut && pending["a"].Ts == 10, "a: %+v", pending["a"])
+	tassert.Errorf(t, pending["b"].Op == replOpPut && pending["b"].Ts == 2, "b: %+v", pending["b"])
+	tassert.Errorf(t, pending["c"].Op == replOpDel && pending["c"].Ts == 5, "c: %+v", pending["c"])
+
+	// compact
+	tassert.CheckFatal(t, j.rewrite(pending))
+	tassert.CheckFatal(t, j.close(false))
+	compacted := make(map[string]*replRec)
+	tassert.CheckFatal(t, replay(j.fqn, compacted))
+	tassert.Errorf(t, len(compacted) == 3, "expecting 3 pending after compaction, got %d", len(compacted))
+}
+
+func TestReplNewer(t *testing.T) {
+	tests := []struct {
+		src, dst *cmn.ObjAttrs
+		conflict string
+		newer    bool
+	}{
+		{replOA("2", 100, "A"), replOA("2", 100, "A"), "", false},                     // same write
+		{replOA("3", 100, "A"), replOA("2", 200, "B"), "", false},                     // last writer (default)
+		{replOA("3", 100, "A"), replOA("2", 200, "B"), cmn.ReplConflictVersion, true}, // higher version
+		{replOA("3", 100, "A"), replOA("2", 200, "B"), cmn.ReplConflictMtime, false},  // last writer
+		{replOA("2", 200, "A"), replOA("2", 100, "B"), cmn.ReplConflictVersion, true}, // same version: mtime
+		{replOA("2", 100, "A"), replOA("5", 200, "B"), cmn.ReplConflictVersion, false},
+		{replOA("", 200, "A"), replOA("5", 100, "B"), cmn.ReplConflictVersion, true}, // no version
+		{replOA("2", 100, "B"), replOA("2", 100, "A"), "", true},                     // tie
+		{replOA("2", 100, "A"), replOA("2", 100, "B"), "", false},
+	}
+	for i, test := range tests {
+		tassert.Errorf(t, replNewer(test.src, test.dst, test.conflict) == test.newer, "%d: expecting newer=%t", i, test.newer)
+	}
+}
+
+func TestReplDelNewer(t *testing.T) {
+	tests := []struct {
+		ts, ver  int64
+		dst      *cmn.ObjAttrs
+		conflict string
+		del      bool
+	}{
+		{200, 3, replOA("2", 100, "A"), "", true},                      // replica of the deleted object
+		{100, 3, replOA("3", 200, "B"), "", false},                     // written after the deletion
+		{100, 4, replOA("3", 200, "B"), cmn.ReplConflictVersion, true}, // higher version
+		{200, 3, replOA("5", 100, "B"), cmn.ReplConflictVersion, false},
+		{200, 3, replOA("3", 100, "B"), cmn.ReplConflictVersion, true}, // same version: mtime
+		{200, 0, replOA("5", 100, "B"), cmn.ReplConflictVersion, true}, // no version
+	}
+	for i, test := range tests {
+		tassert.Errorf(t, replDelNewer(test.ts, test.ver, test.dst, test.conflict) == test.del, "%d: expecting del=%t", i, test.del)
+	}
+}
+
+func replOA(ver string, ts int64, src string) *cmn.ObjAttrs {
+	a := &cmn.ObjAttrs{}
+	if ver != "" {
+		a.SetCustomKey(cmn.ReplVerObjMD, ver)
+	}
+	a.SetCustomKey(cmn.ReplMtimeObjMD, strconv.FormatInt(ts, 10))
+	a.SetCustomKey(cmn.ReplSrcObjMD, src)
+	return a
+}
@@ -0,0 +1,588 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"maps"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// x-sync-bck: diff-based ("rsync"-like) bucket => bucket synchronization
+//
+// Source and destination can be any two distinct buckets: ais://, remote AIS, Cloud, etc.
+// Each target:
+// 1. enumerates source objects that it owns (HRW):
+//    - in-cluster source: local walk (mountpath joggers);
+//    - remote source:     backend.ListObjects + filterKeepMine (compare w/ x-nbi);
+// 2. looks up destination counterparts - locally, via HEAD(t2t), or HEAD(remote) -
+//    and compares (see syncDiff);
+// 3. copies new and changed objects (COI - same as x-tcb and x-tco);
+// 4. optionally (apc.SyncBckMsg.Delete), removes destination objects that are not present
+//    at the source (see prune):
+//    - in-cluster destination: local walk;
+//    - remote destination:     backend.ListObjects + filterKeepMine.
+// Dry-run executes 1, 2, and (the lookups in) 4 - see CtlMsg for the resulting (per-target) report.
+
+// reasons to copy
+const (
+	syncDiffNone = iota
+	syncDiffNew
+	syncDiffSize
+	syncDiffCksum
+	syncDiffVer
+)
+
+// listing remote source
+const syncLsoProps = apc.GetPropsNameSize + apc.LsPropsSepa + apc.GetPropsChecksum + apc.LsPropsSepa + apc.GetPropsVersion
+
+type (
+	syncBckFactory struct {
+		xctn *XactSyncBck
+		xreg.RenewBase
+	}
+	syncItem struct {
+		lom *core.LOM    // source
+		oa  cmn.ObjAttrs // source attributes: loaded (in-cluster) or listed (remote)
+	}
+	XactSyncBck struct {
+		copier // function: copy
+		args   *xreg.SyncBckArgs
+		config *cmn.Config
+		smap   *meta.Smap
+		workCh chan *syncItem
+		nam    string
+		ctlmsg string
+		tcbmsg apc.TCBMsg // (copier.prepare)
+		prune  prune      // function: delete
+		cnt    struct {
+			new, size, cksum, ver, same, errs atomic.Int64
+			bytes                             atomic.Int64 // new and changed: copied or, when dry-run, to copy
+		}
+		wg         sync.WaitGroup
+		numWorkers int
+		xact.Base
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactSyncBck)(nil)
+	_ xreg.Renewable = (*syncBckFactory)(nil)
+)
+
+////////////////////
+// syncBckFactory //
+////////////////////
+
+func (*syncBckFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &syncBckFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *syncBckFactory) Start() error {
+	r, err := newXactSyncBck(p.UUID(), p.Args.Custom.(*xreg.SyncBckArgs))
+	if err != nil {
+		return err
+	}
+	p.xctn = r
+	return nil
+}
+
+func (*syncBckFactory) Kind() string     { return apc.ActSyncBck }
+func (p *syncBckFactory) Get() core.Xact { return p.xctn }
+
+func (p *syncBckFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (wpr xreg.WPR, err error) {
+	if p.UUID() != prevEntry.UUID() {
+		return wpr, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+	}
+	return xreg.WprUse, nil
+}
+
+/////////////////
+// XactSyncBck //
+/////////////////
+
+func newXactSyncBck(uuid string, args *xreg.SyncBckArgs) (*XactSyncBck, error) {
+	var (
+		smap   = core.T.Sowner().Get()
+		config = cmn.GCO.Get()
+		msg    = args.Msg
+		r      = &XactSyncBck{args: args, config: config, smap: smap}
+	)
+	r.InitBase(uuid, apc.ActSyncBck, args.BckTo)
+	if err := core.InMaintOrDecomm(smap, core.T.Snode(), r); err != nil {
+		return nil, err
+	}
+
+	fromCname, toCname := args.BckFrom.Cname(msg.Prefix), args.BckTo.Cname(msg.Prefix)
+	r.nam = r.Base.Cname() + "-" + fromCname + "=>" + toCname
+	{
+		var sb cos.SB
+		sb.Init(ctlMsgBufSize)
+		msg.Str(&sb, fromCname, toCname)
+		r.ctlmsg = sb.String()
+	}
+
+	numWorkers, err := xact.TuneNumWorkers(r.nam, msg.NumWorkers, fs.NumAvail())
+	if err != nil {
+		return nil, err
+	}
+	r.numWorkers = max(numWorkers, 1) // (xact.NwpNone => serial)
+	r.workCh = make(chan *syncItem, min(r.numWorkers*xact.NwpBurstMult, xact.NwpBurstMax))
+
+	// copy: always (re)read the latest (listed and compared) remote version
+	r.tcbmsg.Prefix = msg.Prefix
+	r.tcbmsg.ContinueOnError = msg.ContinueOnError
+	r.tcbmsg.LatestVer = args.BckFrom.IsRemote()
+
+	r.copier.r = r
+	r.rate.init(args.BckFrom, args.BckTo, smap.CountActiveTs())
+	if bck := args.BckFrom; bck.IsRemote() {
+		r.bp = core.T.Backend(bck)
+	}
+	r.vlabs = map[string]string{
+		stats.VlabBucket: args.BckFrom.Cname(""),
+		stats.VlabXkind:  r.Kind(),
+	}
+
+	// delete
+	if msg.Delete {
+		r.prune.r = r
+		r.prune.smap = smap
+		r.prune.bckFrom = args.BckFrom
+		r.prune.bckTo = args.BckTo
+		r.prune.prefix = msg.Prefix
+		r.prune.dryRun = msg.DryRun
+		r.prune.init(config)
+	}
+	return r, nil
+}
+
+func (r *XactSyncBck) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	nlog.Infoln(core.T.String(), "run:", r.Name(), "workers:", r.numWorkers)
+
+	r.wg.Add(r.numWorkers)
+	for range r.numWorkers {
+		go r.work()
+	}
+
+	// 1. source
+	var err error
+	if r.args.BckFrom.IsRemote() {
+		err = r.listSrc()
+	} else {
+		err = r.walkSrc()
+	}
+	close(r.workCh)
+	r.wg.Wait()
+	if err != nil && !r.IsAborted() {
+		r.Abort(err)
+	}
+
+	// 2. destination
+	if r.args.Msg.Delete && !r.IsAborted() {
+		if r.args.BckTo.IsRemote() {
+			if err := r.listDst(); err != nil && !r.IsAborted() {
+				r.Abort(err)
+			}
+		} else {
+			r.prune.run()
+			r.prune.wait()
+		}
+	}
+
+	if cmn.Rom.V(4, cos.ModXs) {
+		nlog.Infoln(r.Name(), "done:", r.CtlMsg())
+	}
+	r.Finish()
+}
+
+func (r *XactSyncBck) walkSrc() error {
+	opts := &mpather.JgroupOpts{
+		Parent:   r,
+		CTs:      []string{fs.ObjCT},
+		VisitObj: r.visit,
+		Prefix:   r.args.Msg.Prefix,
+	}
+	opts.Bck.Copy(r.args.BckFrom.Bucket())
+	jg := mpather.NewJgroup(opts, r.config, nil)
+	jg.Run()
+	select {
+	case <-r.ChanAbort():
+	case <-jg.ListenFinished():
+	}
+	return jg.Stop()
+}
+
+func (r *XactSyncBck) visit(lom *core.LOM, _ []byte) error {
+	if err := lom.Load(false /*cache*/, false /*locked*/); err != nil {
+		if cos.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if lom.IsCopy() {
+		return nil
+	}
+	if _, local, err := lom.HrwTarget(r.smap); err != nil || !local {
+		return err // (misplaced)
+	}
+	item := &syncItem{lom: lom.Clone(), oa: *lom.ObjAttrs()}
+	item.oa.CustomMD = maps.Clone(item.oa.CustomMD)
+	return r.push(item)
+}
+
+func (r *XactSyncBck) listSrc() error {
+	var (
+		bck   = r.args.BckFrom
+		bp    = core.T.Backend(bck)
+		ubuf  = bck.MakeUname("", true /*with extra cap*/)
+		lsmsg = &apc.LsoMsg{Prefix: r.args.Msg.Prefix, Props: syncLsoProps, PageSize: bck.MaxPageSize()}
+	)
+	lsmsg.SetFlag(apc.LsNoDirs)
+	for !r.IsAborted() {
+		lst := &cmn.LsoRes{}
+		if _, err := bp.ListObjects(bck, lsmsg, lst); err != nil {
+			return err
+		}
+		if err := filterKeepMine(lst, ubuf, r.smap); err != nil {
+			return err
+		}
+		for _, en := range lst.Entries {
+			lom := core.AllocLOM(en.Name)
+			if err := lom.InitBck(bck); err != nil {
+				core.FreeLOM(lom)
+				return err
+			}
+			item := &syncItem{lom: lom}
+			item.oa.Size = en.Size
+			if en.Version != "" {
+				item.oa.SetVersion(en.Version)
+			}
+			if en.Checksum != "" {
+				item.oa.SetCustomKey(cmn.ETag, en.Checksum)
+			}
+			if err := r.push(item); err != nil {
+				return err
+			}
+		}
+		if lsmsg.ContinuationToken = lst.ContinuationToken; lsmsg.ContinuationToken == "" {
+			break
+		}
+	}
+	return nil
+}
+
+func (r *XactSyncBck) push(item *syncItem) error {
+	select {
+	case r.workCh <- item:
+		return nil
+	case <-r.ChanAbort():
+		core.FreeLOM(item.lom)
+		return r.AbortErr()
+	}
+}
+
+func (r *XactSyncBck) work() {
+	buf, slab := core.T.PageMM().Alloc()
+	for item := range r.workCh {
+		if !r.IsAborted() {
+			r.do(item, buf)
+		}
+		core.FreeLOM(item.lom)
+	}
+	slab.Free(buf)
+	r.wg.Done()
+}
+
+func (r *XactSyncBck) do(item *syncItem, buf []byte) {
+	var (
+		lom = item.lom
+		msg = r.args.Msg
+	)
+	if msg.Delete {
+		// source exists (see prune.srcGone)
+		r.prune.filter.Insert(cos.UnsafeB(lom.Uname()))
+	}
+
+	reason := syncDiffNew
+	dst, err := r.dstAttrs(lom.ObjName)
+	switch {
+	case err != nil:
+		r.cnt.errs.Inc()
+		r.onErr(err)
+		return
+	case dst != nil:
+		reason = syncDiff(&item.oa, dst, !r.args.BckTo.IsRemote())
+	}
+	switch reason {
+	case syncDiffNone:
+		r.cnt.same.Inc()
+		return
+	case syncDiffNew:
+		r.cnt.new.Inc()
+	case syncDiffSize:
+		r.cnt.size.Inc()
+	case syncDiffCksum:
+		r.cnt.cksum.Inc()
+	case syncDiffVer:
+		r.cnt.ver.Inc()
+	}
+	if cmn.Rom.V(5, cos.ModXs) {
+		nlog.Infoln(r.Name(), lom.Cname(), "reason:", reason)
+	}
+
+	if msg.DryRun {
+		r.ObjsAdd(1, item.oa.Size)
+		r.cnt.bytes.Add(item.oa.Size)
+		return
+	}
+	a, err := r.copier.prepare(lom, r.args.BckTo, &r.tcbmsg, r.config, buf, cmn.OwtCopy)
+	if err != nil {
+		r.cnt.errs.Inc()
+		r.onErr(err)
+		return
+	}
+	// (copier handles errors other than not-found: source deleted in the meantime)
+	if err := r.copier.do(a, lom, nil /*DM*/); err != nil {
+		r.cnt.errs.Inc()
+		return
+	}
+	r.cnt.bytes.Add(item.oa.Size)
+}
+
+// returns (nil, nil) when the destination does not exist
+func (r *XactSyncBck) dstAttrs(objName string) (*cmn.ObjAttrs, error) {
+	dst := core.AllocLOM(objName)
+	defer core.FreeLOM(dst)
+	if err := dst.InitBck(r.args.BckTo); err != nil {
+		return nil, err
+	}
+
+	// remote: HEAD(remote object)
+	if r.args.BckTo.IsRemote() {
+		oa, ecode, err := core.T.HeadCold(dst, nil /*origReq*/)
+		if err != nil {
+			if cos.IsNotExist(err, ecode) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return oa, nil
+	}
+
+	// in-cluster
+	tsi, local, err := dst.HrwTarget(r.smap)
+	if err != nil {
+		return nil, err
+	}
+	if local {
+		if err := dst.Load(false /*cache*/, false /*locked*/); err != nil {
+			if cos.IsNotExist(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		oa := *dst.ObjAttrs()
+		oa.CustomMD = maps.Clone(oa.CustomMD)
+		return &oa, nil
+	}
+	op, err := core.T.HeadObjT2T(dst, tsi, apc.GetPropsChecksum)
+	if err != nil {
+		if cmn.IsErrHTTPNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &op.ObjAttrs, nil
+}
+
+// remote destination: visit (and compare with the source) the listed objects that map to this target
+func (r *XactSyncBck) listDst() error {
+	var (
+		bck   = r.args.BckTo
+		bp    = core.T.Backend(bck)
+		ubuf  = bck.MakeUname("", true /*with extra cap*/)
+		lsmsg = &apc.LsoMsg{Prefix: r.args.Msg.Prefix, Props: apc.GetPropsName, PageSize: bck.MaxPageSize()}
+	)
+	lsmsg.SetFlag(apc.LsNameOnly | apc.LsNoDirs)
+	for !r.IsAborted() {
+		lst := &cmn.LsoRes{}
+		if _, err := bp.ListObjects(bck, lsmsg, lst); err != nil {
+			return err
+		}
+		if err := filterKeepMine(lst, ubuf, r.smap); err != nil {
+			return err
+		}
+		for _, en := range lst.Entries {
+			dst := core.AllocLOM(en.Name)
+			if err := dst.InitBck(bck); err != nil {
+				core.FreeLOM(dst)
+				return err
+			}
+			r.delExtra(dst)
+			core.FreeLOM(dst)
+		}
+		if lsmsg.ContinuationToken = lst.ContinuationToken; lsmsg.ContinuationToken == "" {
+			break
+		}
+	}
+	return nil
+}
+
+func (r *XactSyncBck) delExtra(dst *core.LOM) {
+	gone, err := r.prune.srcGone(dst)
+	if err != nil {
+		r.onErr(err)
+		return
+	}
+	if !gone {
+		return
+	}
+	if r.args.Msg.DryRun {
+		r.prune.pruned.Inc()
+		return
+	}
+	ecode, err := core.T.DeleteObject(dst, false /*evict*/)
+	switch {
+	case err == nil:
+		r.prune.pruned.Inc()
+		if cmn.Rom.V(5, cos.ModXs) {
+			nlog.Infoln(r.Name(), "deleted", dst.Cname())
+		}
+	case cos.IsNotExist(err, ecode):
+	default:
+		r.cnt.errs.Inc()
+		r.onErr(err)
+	}
+}
+
+func (r *XactSyncBck) onErr(err error) {
+	if r.args.Msg.ContinueOnError {
+		r.AddErr(err, 5, cos.ModXs)
+	} else {
+		r.Abort(err)
+	}
+}
+
+func (r *XactSyncBck) String() string { return r.nam }
+func (r *XactSyncBck) Name() string   { return r.nam }
+
+func (r *XactSyncBck) FromTo() (*meta.Bck, *meta.Bck) {
+	return r.args.BckFrom, r.args.BckTo
+}
+
+// control message followed by this target's diff report, e.g.:
+// "sync s3://src=>gs://dst, flags:dry-run; t[abc]: diff:[ new:10 changed:3 (size:1 checksum:2 version:0) same:100 extra:4 bytes:12.00MiB errors:0]"
+func (r *XactSyncBck) CtlMsg() string {
+	var (
+		sb      cos.SB
+		changed = r.cnt.size.Load() + r.cnt.cksum.Load() + r.cnt.ver.Load()
+	)
+	sb.Init(len(r.ctlmsg) + ctlMsgBufSize)
+	sb.WriteString(r.ctlmsg)
+	sb.WriteString("; ")
+	sb.WriteString(core.T.String())
+	sb.WriteString(": diff:[ new:")
+	sb.WriteString(strconv.FormatInt(r.cnt.new.Load(), 10))
+	sb.WriteString(" changed:")
+	sb.WriteString(strconv.FormatInt(changed, 10))
+	sb.WriteString(" (size:")
+	sb.WriteString(strconv.FormatInt(r.cnt.size.Load(), 10))
+	sb.WriteString(" checksum:")
+	sb.WriteString(strconv.FormatInt(r.cnt.cksum.Load(), 10))
+	sb.WriteString(" version:")
+	sb.WriteString(strconv.FormatInt(r.cnt.ver.Load(), 10))
+	sb.WriteString(") same:")
+	sb.WriteString(strconv.FormatInt(r.cnt.same.Load(), 10))
+	if r.args.Msg.Delete {
+		if r.args.Msg.DryRun {
+			sb.WriteString(" extra:")
+		} else {
+			sb.WriteString(" deleted:")
+		}
+		sb.WriteString(strconv.FormatInt(r.prune.pruned.Load(), 10))
+	}
+	sb.WriteString(" bytes:")
+	sb.WriteString(cos.ToSizeIEC(r.cnt.bytes.Load(), 2))
+	sb.WriteString(" errors:")
+	sb.WriteString(strconv.FormatInt(r.cnt.errs.Load(), 10))
+	sb.WriteUint8(']')
+	return sb.String()
+}
+
+func (r *XactSyncBck) Snap() (snap *core.Snap) {
+	snap = r.Base.NewSnap(r)
+	snap.Pack(0, r.numWorkers, 0)
+
+	f, t := r.FromTo()
+	snap.SrcBck, snap.DstBck = f.Clone(), t.Clone()
+	return
+}
+
+//
+// diff
+//
+
+// Compare source and destination by:
+// - size;
+// - checksum, when both are of the same type (e.g., ais => ais, ais => s3 with AIS-stored checksum);
+// - otherwise, ETag (or MD5) as reported by remote backends, when known on both sides;
+// - otherwise, version - only when the destination is in-cluster (copying preserves source version).
+// Same size and no comparable checksums (ETags, versions) means "same".
+func syncDiff(src, dst cos.OAH, cmpVer bool) int {
+	if src.Lsize() != dst.Lsize() {
+		return syncDiffSize
+	}
+	if a, b := src.Checksum(), dst.Checksum(); !cos.NoneC(a) && !cos.NoneC(b) && a.Ty() == b.Ty() {
+		if !a.Equal(b) {
+			return syncDiffCksum
+		}
+		return syncDiffNone
+	}
+	if a, b := syncETag(src), syncETag(dst); a != "" && b != "" {
+		if a != b {
+			return syncDiffCksum
+		}
+		return syncDiffNone
+	}
+	if cmpVer {
+		if a, b := src.Version(), dst.Version(); a != "" && b != "" && a != b {
+			return syncDiffVer
+		}
+	}
+	return syncDiffNone
+}
+
+func syncETag(oah cos.OAH) (etag string) {
+	if v, ok := oah.GetCustomKey(cmn.ETag); ok && v != "" {
+		etag = strings.Trim(v, "\"")
+	} else if v, ok := oah.GetCustomKey(cmn.MD5ObjMD); ok && v != "" {
+		etag = v
+	} else if ck := oah.Checksum(); !cos.NoneC(ck) && ck.Ty() == cos.ChecksumMD5 {
+		etag = ck.Val()
+	}
+	// multipart ETag is not a content checksum
+	if strings.IndexByte(etag, '-') >= 0 {
+		return ""
+	}
+	return etag
+}
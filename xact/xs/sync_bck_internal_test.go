@@ -0,0 +1,51 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestSyncDiff(t *testing.T) {
+	oa := func(size int64, cksum *cos.Cksum, etag, ver string) *cmn.ObjAttrs {
+		a := &cmn.ObjAttrs{Size: size, Cksum: cksum}
+		if etag != "" {
+			a.SetCustomKey(cmn.ETag, etag)
+		}
+		if ver != "" {
+			a.SetVersion(ver)
+		}
+		return a
+	}
+	var (
+		xx1 = cos.NewCksum(cos.ChecksumOneXxh, "a1")
+		xx2 = cos.NewCksum(cos.ChecksumOneXxh, "a2")
+		md5 = cos.NewCksum(cos.ChecksumMD5, "e1")
+	)
+	tests := []struct {
+		src, dst *cmn.ObjAttrs
+		cmpVer   bool
+		diff     int
+	}{
+		{oa(1, xx1, "", "1"), oa(2, xx1, "", "1"), true, syncDiffSize},
+		{oa(1, xx1, "", "1"), oa(1, xx2, "", "1"), true, syncDiffCksum},
+		{oa(1, xx1, "", "1"), oa(1, xx1, "", "2"), true, syncDiffNone}, // same checksum: version does not matter
+		{oa(1, nil, `"e1"`, ""), oa(1, md5, "", ""), true, syncDiffNone},
+		{oa(1, nil, "e1", ""), oa(1, nil, "e2", ""), false, syncDiffCksum},
+		{oa(1, nil, "e1-2", "1"), oa(1, nil, "e2-3", "1"), false, syncDiffNone}, // multipart
+		{oa(1, nil, "", "1"), oa(1, xx1, "", "2"), true, syncDiffVer},
+		{oa(1, nil, "", "1"), oa(1, xx1, "", "2"), false, syncDiffNone},
+		{oa(1, nil, "", ""), oa(1, nil, "", "2"), true, syncDiffNone},
+	}
+	for i, test := range tests {
+		diff := syncDiff(test.src, test.dst, test.cmpVer)
+		tassert.Errorf(t, diff == test.diff, "%d: expecting %d, got %d", i, test.diff, diff)
+	}
+}